@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 )
 
 type State string
@@ -43,11 +45,108 @@ type TelemetrySpec struct {
 
 	// +optional
 	Metric *MetricSpec `json:"metric,omitempty"`
+	// +optional
+	Log *LogSpec `json:"log,omitempty"`
+
+	// Proxy defines the egress proxy that the gateways and the log agent use to reach backends outside the cluster.
+	// +optional
+	Proxy *ProxySpec `json:"proxy,omitempty"`
+
+	// SelfMonitor tunes the thresholds at which the self monitor reports problems in the telemetry flow of the pipelines.
+	// +optional
+	SelfMonitor *SelfMonitorSpec `json:"selfMonitor,omitempty"`
+}
+
+// ProxySpec defines the cluster-wide egress proxy of the telemetry components. Individual pipeline outputs can override it.
+type ProxySpec struct {
+	// HTTPProxy is the URL of the proxy for plain HTTP requests, for example `http://proxy.example.com:3128`.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy for HTTPS and gRPC requests, for example `http://proxy.example.com:3128`.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy lists additional hosts, domains (for example `.example.com`), IP addresses, or CIDR ranges that are reached without the proxy.
+	// Destinations inside the cluster are always excluded.
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+}
+
+// IsDefined returns whether a proxy URL is configured.
+func (p *ProxySpec) IsDefined() bool {
+	return p != nil && (p.HTTPProxy != "" || p.HTTPSProxy != "")
+}
+
+// SelfMonitorSpec defines the alert thresholds of the self monitor. Unset thresholds keep their defaults.
+type SelfMonitorSpec struct {
+	// QueueAlmostFullPercentage is the fill level of the export queue of a gateway, in percent, from which a pipeline reports that its buffer is filling up. The default is 80.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	QueueAlmostFullPercentage *int32 `json:"queueAlmostFullPercentage,omitempty"`
+
+	// DroppedDataTolerancePercentage is the share of data, in percent, that a pipeline can drop before it reports dropped data. The default is 0, so that any dropped data is reported.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	DroppedDataTolerancePercentage *int32 `json:"droppedDataTolerancePercentage,omitempty"`
+
+	// AlertFor is the time that a problem must persist before a pipeline reports it, for example `5m`. By default, most problems are reported as soon as they are detected.
+	// +optional
+	AlertFor *metav1.Duration `json:"alertFor,omitempty"`
+
+	// AlertTargets are additional receivers of the self-monitor alerts, such as an Alertmanager or a webhook that implements the Alertmanager API.
+	// The Telemetry Manager always receives the alerts.
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	AlertTargets []AlertTarget `json:"alertTargets,omitempty"`
+}
+
+// AlertTarget defines an additional receiver of the self-monitor alerts.
+// +kubebuilder:validation:XValidation:rule="!(has(self.basicAuth) && has(self.bearerToken))", message="Only one authentication method can be defined"
+type AlertTarget struct {
+	// URL of the Alertmanager, for example `https://alertmanager.example.com:9093`. The alerts are sent to the `/api/v2/alerts` path below the URL.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// TLS configures the TLS connection to the target.
+	// +optional
+	TLS *AlertTargetTLS `json:"tls,omitempty"`
+
+	// BasicAuth authenticates the requests with the given user and password.
+	// +optional
+	BasicAuth *telemetryv1alpha1.BasicAuthOptions `json:"basicAuth,omitempty"`
+
+	// BearerToken authenticates the requests with the given token in the `Authorization` header.
+	// +optional
+	BearerToken *telemetryv1alpha1.ValueType `json:"bearerToken,omitempty"`
+}
+
+// AlertTargetTLS defines the TLS settings of an alert target.
+type AlertTargetTLS struct {
+	// Defines whether to skip server certificate verification.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Defines an optional CA certificate for server certificate verification. The certificate must be provided in PEM format.
+	CA *telemetryv1alpha1.ValueType `json:"ca,omitempty"`
+	// Defines a client certificate. The certificate must be provided in PEM format.
+	Cert *telemetryv1alpha1.ValueType `json:"cert,omitempty"`
+	// Defines the client key. The key must be provided in PEM format.
+	Key *telemetryv1alpha1.ValueType `json:"key,omitempty"`
 }
 
 // MetricSpec defines the behavior of the metric gateway
 type MetricSpec struct {
 	Gateway MetricGatewaySpec `json:"gateway,omitempty"`
+
+	// MaxPipelines is the maximum number of MetricPipelines that are active at the same time. The default is 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxPipelines *int32 `json:"maxPipelines,omitempty"`
 }
 
 type MetricGatewaySpec struct {
@@ -57,6 +156,35 @@ type MetricGatewaySpec struct {
 // TraceSpec defines the behavior of the trace gateway
 type TraceSpec struct {
 	Gateway TraceGatewaySpec `json:"gateway,omitempty"`
+
+	// MaxPipelines is the maximum number of TracePipelines that are active at the same time. The default is 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxPipelines *int32 `json:"maxPipelines,omitempty"`
+
+	// MaxPipelinesPerNamespace is the maximum number of NamespacedTracePipelines that are active in each Namespace. The default is 1.
+	// The TracePipelines that run the NamespacedTracePipelines also count toward MaxPipelines.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	MaxPipelinesPerNamespace *int32 `json:"maxPipelinesPerNamespace,omitempty"`
+}
+
+// LogSpec defines the behavior of the log agent
+type LogSpec struct {
+	// MaxPipelines is the maximum number of LogPipelines that are active at the same time. The default is 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxPipelines *int32 `json:"maxPipelines,omitempty"`
+
+	// MaxPipelinesPerNamespace is the maximum number of NamespacedLogPipelines that are active in each Namespace. The default is 1.
+	// The LogPipelines that run the NamespacedLogPipelines also count toward MaxPipelines.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	MaxPipelinesPerNamespace *int32 `json:"maxPipelinesPerNamespace,omitempty"`
 }
 
 type TraceGatewaySpec struct {
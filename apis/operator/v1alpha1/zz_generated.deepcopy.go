@@ -21,10 +21,71 @@ limitations under the License.
 package v1alpha1
 
 import (
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertTarget) DeepCopyInto(out *AlertTarget) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(AlertTargetTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(telemetryv1alpha1.BasicAuthOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(telemetryv1alpha1.ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertTarget.
+func (in *AlertTarget) DeepCopy() *AlertTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertTargetTLS) DeepCopyInto(out *AlertTargetTLS) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(telemetryv1alpha1.ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(telemetryv1alpha1.ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(telemetryv1alpha1.ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertTargetTLS.
+func (in *AlertTargetTLS) DeepCopy() *AlertTargetTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertTargetTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayEndpoints) DeepCopyInto(out *GatewayEndpoints) {
 	*out = *in
@@ -50,6 +111,31 @@ func (in *GatewayEndpoints) DeepCopy() *GatewayEndpoints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSpec) DeepCopyInto(out *LogSpec) {
+	*out = *in
+	if in.MaxPipelines != nil {
+		in, out := &in.MaxPipelines, &out.MaxPipelines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPipelinesPerNamespace != nil {
+		in, out := &in.MaxPipelinesPerNamespace, &out.MaxPipelinesPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSpec.
+func (in *LogSpec) DeepCopy() *LogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricGatewaySpec) DeepCopyInto(out *MetricGatewaySpec) {
 	*out = *in
@@ -70,6 +156,11 @@ func (in *MetricGatewaySpec) DeepCopy() *MetricGatewaySpec {
 func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
 	*out = *in
 	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.MaxPipelines != nil {
+		in, out := &in.MaxPipelines, &out.MaxPipelines
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSpec.
@@ -97,6 +188,26 @@ func (in *OTLPEndpoints) DeepCopy() *OTLPEndpoints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scaling) DeepCopyInto(out *Scaling) {
 	*out = *in
@@ -117,6 +228,43 @@ func (in *Scaling) DeepCopy() *Scaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfMonitorSpec) DeepCopyInto(out *SelfMonitorSpec) {
+	*out = *in
+	if in.QueueAlmostFullPercentage != nil {
+		in, out := &in.QueueAlmostFullPercentage, &out.QueueAlmostFullPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DroppedDataTolerancePercentage != nil {
+		in, out := &in.DroppedDataTolerancePercentage, &out.DroppedDataTolerancePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AlertFor != nil {
+		in, out := &in.AlertFor, &out.AlertFor
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AlertTargets != nil {
+		in, out := &in.AlertTargets, &out.AlertTargets
+		*out = make([]AlertTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfMonitorSpec.
+func (in *SelfMonitorSpec) DeepCopy() *SelfMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticScaling) DeepCopyInto(out *StaticScaling) {
 	*out = *in
@@ -219,6 +367,21 @@ func (in *TelemetrySpec) DeepCopyInto(out *TelemetrySpec) {
 		*out = new(MetricSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(LogSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelfMonitor != nil {
+		in, out := &in.SelfMonitor, &out.SelfMonitor
+		*out = new(SelfMonitorSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetrySpec.
@@ -275,6 +438,16 @@ func (in *TraceGatewaySpec) DeepCopy() *TraceGatewaySpec {
 func (in *TraceSpec) DeepCopyInto(out *TraceSpec) {
 	*out = *in
 	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.MaxPipelines != nil {
+		in, out := &in.MaxPipelines, &out.MaxPipelines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPipelinesPerNamespace != nil {
+		in, out := &in.MaxPipelinesPerNamespace, &out.MaxPipelinesPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceSpec.
@@ -31,9 +31,12 @@ func (lp *LogPipeline) ConvertTo(dstRaw conversion.Hub) error {
 			KeepAnnotations:  srcAppInput.KeepAnnotations,
 			DropLabels:       srcAppInput.DropLabels,
 			KeepOriginalBody: srcAppInput.KeepOriginalBody,
+			Throttle:         v1Alpha1ThrottleToV1Beta1(srcAppInput.Throttle),
 		},
 	}
 
+	dst.Spec.Priority = src.Spec.Priority
+
 	for _, f := range src.Spec.Files {
 		dst.Spec.Files = append(dst.Spec.Files, telemetryv1beta1.LogPipelineFileMount(f))
 	}
@@ -53,9 +56,59 @@ func (lp *LogPipeline) ConvertTo(dstRaw conversion.Hub) error {
 			Format:    srcHTTPOutput.Format,
 			TLSConfig: v1Alpha1TLSToV1Beta1(srcHTTPOutput.TLSConfig),
 			Dedot:     srcHTTPOutput.Dedot,
+			Proxy:     v1Alpha1OutputProxyToV1Beta1(srcHTTPOutput.Proxy),
+		}
+	}
+
+	if srcLokiOutput := src.Spec.Output.Loki; srcLokiOutput != nil {
+		dst.Spec.Output.Loki = &telemetryv1beta1.LogPipelineLokiOutput{
+			URL:       v1Alpha1ValueTypeToV1Beta1(srcLokiOutput.URL),
+			TenantID:  v1Alpha1ValueTypeToV1Beta1(srcLokiOutput.TenantID),
+			Labels:    v1Alpha1LokiLabelsToV1Beta1(srcLokiOutput.Labels),
+			User:      v1Alpha1ValueTypeToV1Beta1(srcLokiOutput.User),
+			Password:  v1Alpha1ValueTypeToV1Beta1(srcLokiOutput.Password),
+			TLSConfig: v1Alpha1TLSToV1Beta1(srcLokiOutput.TLSConfig),
+		}
+	}
+
+	if srcESOutput := src.Spec.Output.Elasticsearch; srcESOutput != nil {
+		dst.Spec.Output.Elasticsearch = &telemetryv1beta1.LogPipelineElasticsearchOutput{
+			Hosts:                append([]string{}, srcESOutput.Hosts...),
+			Index:                srcESOutput.Index,
+			LogstashPrefix:       srcESOutput.LogstashPrefix,
+			LogstashPrefixSource: srcESOutput.LogstashPrefixSource,
+			Pipeline:             srcESOutput.Pipeline,
+			User:                 v1Alpha1ValueTypeToV1Beta1(srcESOutput.User),
+			Password:             v1Alpha1ValueTypeToV1Beta1(srcESOutput.Password),
+			APIKey:               v1Alpha1ValueTypeToV1Beta1(srcESOutput.APIKey),
+			TLSConfig:            v1Alpha1TLSToV1Beta1(srcESOutput.TLSConfig),
+		}
+	}
+
+	if srcSyslogOutput := src.Spec.Output.Syslog; srcSyslogOutput != nil {
+		dst.Spec.Output.Syslog = &telemetryv1beta1.LogPipelineSyslogOutput{
+			Host:      v1Alpha1ValueTypeToV1Beta1(srcSyslogOutput.Host),
+			Port:      srcSyslogOutput.Port,
+			Mode:      telemetryv1beta1.LogPipelineTransportMode(srcSyslogOutput.Mode),
+			Format:    telemetryv1beta1.LogPipelineSyslogFormat(srcSyslogOutput.Format),
+			Facility:  srcSyslogOutput.Facility,
+			TLSConfig: v1Alpha1TLSToV1Beta1(srcSyslogOutput.TLSConfig),
 		}
 	}
 
+	if srcGELFOutput := src.Spec.Output.GELF; srcGELFOutput != nil {
+		dst.Spec.Output.GELF = &telemetryv1beta1.LogPipelineGELFOutput{
+			Host:      v1Alpha1ValueTypeToV1Beta1(srcGELFOutput.Host),
+			Port:      srcGELFOutput.Port,
+			Mode:      telemetryv1beta1.LogPipelineTransportMode(srcGELFOutput.Mode),
+			TLSConfig: v1Alpha1TLSToV1Beta1(srcGELFOutput.TLSConfig),
+		}
+	}
+
+	if srcKafkaOutput := src.Spec.Output.Kafka; srcKafkaOutput != nil {
+		dst.Spec.Output.Kafka = v1Alpha1KafkaToV1Beta1(srcKafkaOutput)
+	}
+
 	if srcOTLPOutput := src.Spec.Output.Otlp; srcOTLPOutput != nil {
 		dst.Spec.Output.OTLP = &telemetryv1beta1.OTLPOutput{
 			Protocol:       telemetryv1beta1.OTLPProtocol(srcOTLPOutput.Protocol),
@@ -64,6 +117,10 @@ func (lp *LogPipeline) ConvertTo(dstRaw conversion.Hub) error {
 			Authentication: v1Alpha1AuthenticationToV1Beta1(srcOTLPOutput.Authentication),
 			Headers:        v1Alpha1HeadersToV1Beta1(srcOTLPOutput.Headers),
 			TLS:            v1Alpha1OtlpTLSToV1Beta1(srcOTLPOutput.TLS),
+			Compression:    telemetryv1beta1.OTLPCompression(srcOTLPOutput.Compression),
+			Timeout:        srcOTLPOutput.Timeout,
+			Retry:          v1Alpha1OtlpRetryToV1Beta1(srcOTLPOutput.Retry),
+			Proxy:          v1Alpha1OutputProxyToV1Beta1(srcOTLPOutput.Proxy),
 		}
 	}
 
@@ -71,11 +128,60 @@ func (lp *LogPipeline) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Output.Custom = srcCustomOutput
 	}
 
-	dst.Status = telemetryv1beta1.LogPipelineStatus(src.Status)
+	dst.Status = telemetryv1beta1.LogPipelineStatus{
+		Conditions:      src.Status.Conditions,
+		UnsupportedMode: src.Status.UnsupportedMode,
+		TestData:        v1Alpha1TestDataStatusToV1Beta1(src.Status.TestData),
+		Statistics:      v1Alpha1StatisticsToV1Beta1(src.Status.Statistics),
+	}
 
 	return nil
 }
 
+func v1Alpha1ThrottleToV1Beta1(throttle *ThrottleConfig) *telemetryv1beta1.LogPipelineThrottle {
+	if throttle == nil {
+		return nil
+	}
+
+	return &telemetryv1beta1.LogPipelineThrottle{
+		RecordsPerSecond: throttle.RecordsPerSecond,
+		Per:              telemetryv1beta1.LogPipelineThrottleScope(throttle.Per),
+	}
+}
+
+func v1Alpha1LokiLabelsToV1Beta1(labels []LokiLabel) []telemetryv1beta1.LogPipelineLokiLabel {
+	var dst []telemetryv1beta1.LogPipelineLokiLabel
+	for _, l := range labels {
+		dst = append(dst, telemetryv1beta1.LogPipelineLokiLabel(l))
+	}
+
+	return dst
+}
+
+func v1Alpha1OtlpRetryToV1Beta1(retry *OtlpRetry) *telemetryv1beta1.OTLPRetry {
+	if retry == nil {
+		return nil
+	}
+
+	return &telemetryv1beta1.OTLPRetry{
+		Enabled:         retry.Enabled,
+		InitialInterval: retry.InitialInterval,
+		MaxInterval:     retry.MaxInterval,
+		MaxElapsedTime:  retry.MaxElapsedTime,
+	}
+}
+
+func v1Alpha1OutputProxyToV1Beta1(proxy *OutputProxy) *telemetryv1beta1.OutputProxy {
+	if proxy == nil {
+		return nil
+	}
+
+	return &telemetryv1beta1.OutputProxy{
+		URL:      proxy.URL,
+		Disabled: proxy.Disabled,
+	}
+}
+
 func v1Alpha1OtlpTLSToV1Beta1(tls *OtlpTLS) *telemetryv1beta1.OutputTLS {
 	if tls == nil {
 		return nil
@@ -84,6 +190,10 @@ func v1Alpha1OtlpTLSToV1Beta1(tls *OtlpTLS) *telemetryv1beta1.OutputTLS {
 	betaTLS := &telemetryv1beta1.OutputTLS{
 		Disabled:                  tls.Insecure,
 		SkipCertificateValidation: tls.InsecureSkipVerify,
+		MinVersion:                telemetryv1beta1.TLSVersion(tls.MinVersion),
+		MaxVersion:                telemetryv1beta1.TLSVersion(tls.MaxVersion),
+		CipherSuites:              tls.CipherSuites,
+		SecretRef:                 v1Alpha1TLSSecretRefToV1Beta1(tls.SecretRef),
 	}
 
 	if tls.CA != nil {
@@ -104,6 +214,29 @@ func v1Alpha1OtlpTLSToV1Beta1(tls *OtlpTLS) *telemetryv1beta1.OutputTLS {
 	return betaTLS
 }
 
+func v1Alpha1KafkaToV1Beta1(kafka *KafkaOutput) *telemetryv1beta1.KafkaOutput {
+	dst := &telemetryv1beta1.KafkaOutput{
+		Brokers:  append([]string{}, kafka.Brokers...),
+		Topic:    kafka.Topic,
+		Encoding: telemetryv1beta1.KafkaEncoding(kafka.Encoding),
+		TLS:      v1Alpha1OtlpTLSToV1Beta1(kafka.TLS),
+	}
+
+	if kafka.Authentication != nil {
+		dst.Authentication = &telemetryv1beta1.KafkaAuthenticationOptions{}
+
+		if sasl := kafka.Authentication.SASL; sasl != nil {
+			dst.Authentication.SASL = &telemetryv1beta1.KafkaSASLOptions{
+				Mechanism: telemetryv1beta1.KafkaSASLMechanism(sasl.Mechanism),
+				User:      v1Alpha1ValueTypeToV1Beta1(sasl.User),
+				Password:  v1Alpha1ValueTypeToV1Beta1(sasl.Password),
+			}
+		}
+	}
+
+	return dst
+}
+
 func v1Alpha1HeadersToV1Beta1(headers []Header) []telemetryv1beta1.Header {
 	var dst []telemetryv1beta1.Header
 	for _, h := range headers {
@@ -127,7 +260,22 @@ func v1Alpha1AuthenticationToV1Beta1(authentication *AuthenticationOptions) *tel
 	}
 
 	return &telemetryv1beta1.AuthenticationOptions{
-		Basic: v1Alpha1BasicAuthOptionsToV1Beta1(authentication.Basic),
+		Basic:  v1Alpha1BasicAuthOptionsToV1Beta1(authentication.Basic),
+		OAuth2: v1Alpha1OAuth2OptionsToV1Beta1(authentication.OAuth2),
+	}
+}
+
+func v1Alpha1OAuth2OptionsToV1Beta1(oauth2 *OAuth2Options) *telemetryv1beta1.OAuth2Options {
+	if oauth2 == nil {
+		return nil
+	}
+
+	return &telemetryv1beta1.OAuth2Options{
+		TokenURL:     v1Alpha1ValueTypeToV1Beta1(oauth2.TokenURL),
+		ClientID:     v1Alpha1ValueTypeToV1Beta1(oauth2.ClientID),
+		ClientSecret: v1Alpha1ValueTypeToV1Beta1(oauth2.ClientSecret),
+		Scopes:       append([]string{}, oauth2.Scopes...),
+		Audience:     oauth2.Audience,
 	}
 }
 
@@ -156,8 +304,8 @@ func v1Alpha1ValueTypeToV1Beta1(src ValueType) telemetryv1beta1.ValueType {
 	}
 }
 
-func v1Alpha1TLSToV1Beta1(src TLSConfig) telemetryv1beta1.OutputTLS {
-	var dst telemetryv1beta1.OutputTLS
+func v1Alpha1TLSToV1Beta1(src TLSConfig) telemetryv1beta1.LogPipelineOutputTLS {
+	var dst telemetryv1beta1.LogPipelineOutputTLS
 
 	if src.CA != nil {
 		ca := v1Alpha1ValueTypeToV1Beta1(*src.CA)
@@ -176,10 +324,23 @@ func v1Alpha1TLSToV1Beta1(src TLSConfig) telemetryv1beta1.OutputTLS {
 
 	dst.Disabled = src.Disabled
 	dst.SkipCertificateValidation = src.SkipCertificateValidation
+	dst.SecretRef = v1Alpha1TLSSecretRefToV1Beta1(src.SecretRef)
 
 	return dst
 }
 
+func v1Alpha1TLSSecretRefToV1Beta1(src *TLSSecretRef) *telemetryv1beta1.TLSSecretRef {
+	if src == nil {
+		return nil
+	}
+
+	return &telemetryv1beta1.TLSSecretRef{
+		Name:      src.Name,
+		Namespace: src.Namespace,
+		UseCA:     src.UseCA,
+	}
+}
+
 // ConvertFrom converts from the Hub version (v1beta1) to this version.
 func (lp *LogPipeline) ConvertFrom(srcRaw conversion.Hub) error {
 	dst := lp
@@ -199,8 +360,11 @@ func (lp *LogPipeline) ConvertFrom(srcRaw conversion.Hub) error {
 		KeepAnnotations:  srcRuntimeInput.KeepAnnotations,
 		DropLabels:       srcRuntimeInput.DropLabels,
 		KeepOriginalBody: srcRuntimeInput.KeepOriginalBody,
+		Throttle:         v1Beta1ThrottleToV1Alpha1(srcRuntimeInput.Throttle),
 	}
 
+	dst.Spec.Priority = src.Spec.Priority
+
 	for _, f := range src.Spec.Files {
 		dst.Spec.Files = append(dst.Spec.Files, FileMount(f))
 	}
@@ -220,9 +384,59 @@ func (lp *LogPipeline) ConvertFrom(srcRaw conversion.Hub) error {
 			Format:    srcHTTPOutput.Format,
 			TLSConfig: v1Beta1TLSToV1Alpha1(srcHTTPOutput.TLSConfig),
 			Dedot:     srcHTTPOutput.Dedot,
+			Proxy:     v1Beta1OutputProxyToV1Alpha1(srcHTTPOutput.Proxy),
+		}
+	}
+
+	if srcLokiOutput := src.Spec.Output.Loki; srcLokiOutput != nil {
+		dst.Spec.Output.Loki = &LokiOutput{
+			URL:       v1Beta1ValueTypeToV1Alpha1(srcLokiOutput.URL),
+			TenantID:  v1Beta1ValueTypeToV1Alpha1(srcLokiOutput.TenantID),
+			Labels:    v1Beta1LokiLabelsToV1Alpha1(srcLokiOutput.Labels),
+			User:      v1Beta1ValueTypeToV1Alpha1(srcLokiOutput.User),
+			Password:  v1Beta1ValueTypeToV1Alpha1(srcLokiOutput.Password),
+			TLSConfig: v1Beta1TLSToV1Alpha1(srcLokiOutput.TLSConfig),
+		}
+	}
+
+	if srcESOutput := src.Spec.Output.Elasticsearch; srcESOutput != nil {
+		dst.Spec.Output.Elasticsearch = &ElasticsearchOutput{
+			Hosts:                append([]string{}, srcESOutput.Hosts...),
+			Index:                srcESOutput.Index,
+			LogstashPrefix:       srcESOutput.LogstashPrefix,
+			LogstashPrefixSource: srcESOutput.LogstashPrefixSource,
+			Pipeline:             srcESOutput.Pipeline,
+			User:                 v1Beta1ValueTypeToV1Alpha1(srcESOutput.User),
+			Password:             v1Beta1ValueTypeToV1Alpha1(srcESOutput.Password),
+			APIKey:               v1Beta1ValueTypeToV1Alpha1(srcESOutput.APIKey),
+			TLSConfig:            v1Beta1TLSToV1Alpha1(srcESOutput.TLSConfig),
+		}
+	}
+
+	if srcSyslogOutput := src.Spec.Output.Syslog; srcSyslogOutput != nil {
+		dst.Spec.Output.Syslog = &SyslogOutput{
+			Host:      v1Beta1ValueTypeToV1Alpha1(srcSyslogOutput.Host),
+			Port:      srcSyslogOutput.Port,
+			Mode:      TransportMode(srcSyslogOutput.Mode),
+			Format:    SyslogFormat(srcSyslogOutput.Format),
+			Facility:  srcSyslogOutput.Facility,
+			TLSConfig: v1Beta1TLSToV1Alpha1(srcSyslogOutput.TLSConfig),
+		}
+	}
+
+	if srcGELFOutput := src.Spec.Output.GELF; srcGELFOutput != nil {
+		dst.Spec.Output.GELF = &GELFOutput{
+			Host:      v1Beta1ValueTypeToV1Alpha1(srcGELFOutput.Host),
+			Port:      srcGELFOutput.Port,
+			Mode:      TransportMode(srcGELFOutput.Mode),
+			TLSConfig: v1Beta1TLSToV1Alpha1(srcGELFOutput.TLSConfig),
 		}
 	}
 
+	if srcKafkaOutput := src.Spec.Output.Kafka; srcKafkaOutput != nil {
+		dst.Spec.Output.Kafka = v1Beta1KafkaToV1Alpha1(srcKafkaOutput)
+	}
+
 	if srcOTLPOutput := src.Spec.Output.OTLP; srcOTLPOutput != nil {
 		dst.Spec.Output.Otlp = &OtlpOutput{
 			Protocol:       (string)(srcOTLPOutput.Protocol),
@@ -231,6 +445,10 @@ func (lp *LogPipeline) ConvertFrom(srcRaw conversion.Hub) error {
 			Authentication: v1Beta1AuthenticationToV1Alpha1(srcOTLPOutput.Authentication),
 			Headers:        v1Beta1HeadersToV1Alpha1(srcOTLPOutput.Headers),
 			TLS:            v1Beta1OtlpTLSToV1Alpha1(srcOTLPOutput.TLS),
+			Compression:    (string)(srcOTLPOutput.Compression),
+			Timeout:        srcOTLPOutput.Timeout,
+			Retry:          v1Beta1OtlpRetryToV1Alpha1(srcOTLPOutput.Retry),
+			Proxy:          v1Beta1OutputProxyToV1Alpha1(srcOTLPOutput.Proxy),
 		}
 	}
 
@@ -238,11 +456,60 @@ func (lp *LogPipeline) ConvertFrom(srcRaw conversion.Hub) error {
 		dst.Spec.Output.Custom = srcCustomOutput
 	}
 
-	dst.Status = LogPipelineStatus(src.Status)
+	dst.Status = LogPipelineStatus{
+		Conditions:      src.Status.Conditions,
+		UnsupportedMode: src.Status.UnsupportedMode,
+		TestData:        v1Beta1TestDataStatusToV1Alpha1(src.Status.TestData),
+		Statistics:      v1Beta1StatisticsToV1Alpha1(src.Status.Statistics),
+	}
 
 	return nil
 }
 
+func v1Beta1ThrottleToV1Alpha1(throttle *telemetryv1beta1.LogPipelineThrottle) *ThrottleConfig {
+	if throttle == nil {
+		return nil
+	}
+
+	return &ThrottleConfig{
+		RecordsPerSecond: throttle.RecordsPerSecond,
+		Per:              ThrottleScope(throttle.Per),
+	}
+}
+
+func v1Beta1LokiLabelsToV1Alpha1(labels []telemetryv1beta1.LogPipelineLokiLabel) []LokiLabel {
+	var dst []LokiLabel
+	for _, l := range labels {
+		dst = append(dst, LokiLabel(l))
+	}
+
+	return dst
+}
+
+func v1Beta1OtlpRetryToV1Alpha1(retry *telemetryv1beta1.OTLPRetry) *OtlpRetry {
+	if retry == nil {
+		return nil
+	}
+
+	return &OtlpRetry{
+		Enabled:         retry.Enabled,
+		InitialInterval: retry.InitialInterval,
+		MaxInterval:     retry.MaxInterval,
+		MaxElapsedTime:  retry.MaxElapsedTime,
+	}
+}
+
+func v1Beta1OutputProxyToV1Alpha1(proxy *telemetryv1beta1.OutputProxy) *OutputProxy {
+	if proxy == nil {
+		return nil
+	}
+
+	return &OutputProxy{
+		URL:      proxy.URL,
+		Disabled: proxy.Disabled,
+	}
+}
+
 func v1Beta1OtlpTLSToV1Alpha1(tls *telemetryv1beta1.OutputTLS) *OtlpTLS {
 	if tls == nil {
 		return nil
@@ -251,6 +518,10 @@ func v1Beta1OtlpTLSToV1Alpha1(tls *telemetryv1beta1.OutputTLS) *OtlpTLS {
 	alphaTLS := &OtlpTLS{
 		Insecure:           tls.Disabled,
 		InsecureSkipVerify: tls.SkipCertificateValidation,
+		MinVersion:         string(tls.MinVersion),
+		MaxVersion:         string(tls.MaxVersion),
+		CipherSuites:       tls.CipherSuites,
+		SecretRef:          v1Beta1TLSSecretRefToV1Alpha1(tls.SecretRef),
 	}
 
 	if tls.CA != nil {
@@ -271,6 +542,29 @@ func v1Beta1OtlpTLSToV1Alpha1(tls *telemetryv1beta1.OutputTLS) *OtlpTLS {
 	return alphaTLS
 }
 
+func v1Beta1KafkaToV1Alpha1(kafka *telemetryv1beta1.KafkaOutput) *KafkaOutput {
+	dst := &KafkaOutput{
+		Brokers:  append([]string{}, kafka.Brokers...),
+		Topic:    kafka.Topic,
+		Encoding: string(kafka.Encoding),
+		TLS:      v1Beta1OtlpTLSToV1Alpha1(kafka.TLS),
+	}
+
+	if kafka.Authentication != nil {
+		dst.Authentication = &KafkaAuthenticationOptions{}
+
+		if sasl := kafka.Authentication.SASL; sasl != nil {
+			dst.Authentication.SASL = &KafkaSASLOptions{
+				Mechanism: string(sasl.Mechanism),
+				User:      v1Beta1ValueTypeToV1Alpha1(sasl.User),
+				Password:  v1Beta1ValueTypeToV1Alpha1(sasl.Password),
+			}
+		}
+	}
+
+	return dst
+}
+
 func v1Beta1HeadersToV1Alpha1(headers []telemetryv1beta1.Header) []Header {
 	var dst []Header
 	for _, h := range headers {
@@ -294,7 +588,22 @@ func v1Beta1AuthenticationToV1Alpha1(authentication *telemetryv1beta1.Authentica
 	}
 
 	return &AuthenticationOptions{
-		Basic: v1Beta1BasicAuthOptionsToV1Alpha1(authentication.Basic),
+		Basic:  v1Beta1BasicAuthOptionsToV1Alpha1(authentication.Basic),
+		OAuth2: v1Beta1OAuth2OptionsToV1Alpha1(authentication.OAuth2),
+	}
+}
+
+func v1Beta1OAuth2OptionsToV1Alpha1(oauth2 *telemetryv1beta1.OAuth2Options) *OAuth2Options {
+	if oauth2 == nil {
+		return nil
+	}
+
+	return &OAuth2Options{
+		TokenURL:     v1Beta1ValueTypeToV1Alpha1(oauth2.TokenURL),
+		ClientID:     v1Beta1ValueTypeToV1Alpha1(oauth2.ClientID),
+		ClientSecret: v1Beta1ValueTypeToV1Alpha1(oauth2.ClientSecret),
+		Scopes:       append([]string{}, oauth2.Scopes...),
+		Audience:     oauth2.Audience,
 	}
 }
 
@@ -309,7 +618,7 @@ func v1Beta1BasicAuthOptionsToV1Alpha1(basic *telemetryv1beta1.BasicAuthOptions)
 	}
 }
 
-func v1Beta1TLSToV1Alpha1(src telemetryv1beta1.OutputTLS) TLSConfig {
+func v1Beta1TLSToV1Alpha1(src telemetryv1beta1.LogPipelineOutputTLS) TLSConfig {
 	var dst TLSConfig
 
 	if src.CA != nil {
@@ -329,10 +638,23 @@ func v1Beta1TLSToV1Alpha1(src telemetryv1beta1.OutputTLS) TLSConfig {
 
 	dst.Disabled = src.Disabled
 	dst.SkipCertificateValidation = src.SkipCertificateValidation
+	dst.SecretRef = v1Beta1TLSSecretRefToV1Alpha1(src.SecretRef)
 
 	return dst
 }
 
+func v1Beta1TLSSecretRefToV1Alpha1(src *telemetryv1beta1.TLSSecretRef) *TLSSecretRef {
+	if src == nil {
+		return nil
+	}
+
+	return &TLSSecretRef{
+		Name:      src.Name,
+		Namespace: src.Namespace,
+		UseCA:     src.UseCA,
+	}
+}
+
 func v1Beta1ValueTypeToV1Alpha1(src telemetryv1beta1.ValueType) ValueType {
 	if src.ValueFrom != nil && src.ValueFrom.SecretKeyRef != nil {
 		return ValueType{
@@ -346,3 +668,55 @@ func v1Beta1ValueTypeToV1Alpha1(src telemetryv1beta1.ValueType) ValueType {
 		Value: src.Value,
 	}
 }
+
+func v1Alpha1TestDataStatusToV1Beta1(testData *TestDataStatus) *telemetryv1beta1.TestDataStatus {
+	if testData == nil {
+		return nil
+	}
+
+	return &telemetryv1beta1.TestDataStatus{
+		Request: testData.Request,
+		SentAt:  testData.SentAt,
+	}
+}
+
+func v1Beta1TestDataStatusToV1Alpha1(testData *telemetryv1beta1.TestDataStatus) *TestDataStatus {
+	if testData == nil {
+		return nil
+	}
+
+	return &TestDataStatus{
+		Request: testData.Request,
+		SentAt:  testData.SentAt,
+	}
+}
+
+func v1Alpha1StatisticsToV1Beta1(statistics *PipelineStatistics) *telemetryv1beta1.PipelineStatistics {
+	if statistics == nil {
+		return nil
+	}
+
+	return &telemetryv1beta1.PipelineStatistics{
+		SentPerSecond:    statistics.SentPerSecond,
+		DroppedPerSecond: statistics.DroppedPerSecond,
+		RefusedPerSecond: statistics.RefusedPerSecond,
+		Queued:           statistics.Queued,
+		LastExportTime:   statistics.LastExportTime,
+		UpdateTime:       statistics.UpdateTime,
+	}
+}
+
+func v1Beta1StatisticsToV1Alpha1(statistics *telemetryv1beta1.PipelineStatistics) *PipelineStatistics {
+	if statistics == nil {
+		return nil
+	}
+
+	return &PipelineStatistics{
+		SentPerSecond:    statistics.SentPerSecond,
+		DroppedPerSecond: statistics.DroppedPerSecond,
+		RefusedPerSecond: statistics.RefusedPerSecond,
+		Queued:           statistics.Queued,
+		LastExportTime:   statistics.LastExportTime,
+		UpdateTime:       statistics.UpdateTime,
+	}
+}
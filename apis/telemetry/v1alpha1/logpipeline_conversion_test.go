@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
@@ -32,8 +33,13 @@ func TestConvertTo(t *testing.T) {
 					KeepAnnotations:  true,
 					DropLabels:       true,
 					KeepOriginalBody: ptr.To(true),
+					Throttle: &ThrottleConfig{
+						RecordsPerSecond: 100,
+						Per:              ThrottleScopeContainer,
+					},
 				},
 			},
+			Priority: 2,
 			Files: []FileMount{
 				{Name: "file1", Content: "file1-content"},
 			},
@@ -75,6 +81,9 @@ func TestConvertTo(t *testing.T) {
 						},
 					},
 					Dedot: true,
+					Proxy: &OutputProxy{
+						URL: "http://proxy.example.com:3128",
+					},
 				},
 				Otlp: &OtlpOutput{
 					Protocol: OtlpProtocolGRPC,
@@ -111,6 +120,8 @@ func TestConvertTo(t *testing.T) {
 					TLS: &OtlpTLS{
 						Insecure:           true,
 						InsecureSkipVerify: true,
+						MinVersion:         TLSVersion12,
+						CipherSuites:       []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
 						CA: &ValueType{
 							Value: "ca",
 						},
@@ -121,6 +132,17 @@ func TestConvertTo(t *testing.T) {
 							Value: "key",
 						},
 					},
+					Compression: OtlpCompressionZstd,
+					Proxy: &OutputProxy{
+						Disabled: true,
+					},
+					Timeout: "10s",
+					Retry: &OtlpRetry{
+						Enabled:         ptr.To(true),
+						InitialInterval: "1s",
+						MaxInterval:     "1m",
+						MaxElapsedTime:  "10m",
+					},
 				},
 			},
 		},
@@ -134,6 +156,16 @@ func TestConvertTo(t *testing.T) {
 				},
 			},
 			UnsupportedMode: ptr.To(true),
+			TestData: &TestDataStatus{
+				Request: "req-1",
+				SentAt:  metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			Statistics: &PipelineStatistics{
+				SentPerSecond:    "12.5",
+				DroppedPerSecond: "0",
+				LastExportTime:   ptr.To(metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))),
+				UpdateTime:       metav1.NewTime(time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)),
+			},
 		},
 	}
 
@@ -171,8 +203,13 @@ func TestConvertFrom(t *testing.T) {
 					KeepAnnotations:  true,
 					DropLabels:       true,
 					KeepOriginalBody: ptr.To(true),
+					Throttle: &telemetryv1beta1.LogPipelineThrottle{
+						RecordsPerSecond: 100,
+						Per:              telemetryv1beta1.LogPipelineThrottleScopeContainer,
+					},
 				},
 			},
+			Priority: 2,
 			Files: []telemetryv1beta1.LogPipelineFileMount{
 				{Name: "file1", Content: "file1-content"},
 			},
@@ -201,7 +238,7 @@ func TestConvertFrom(t *testing.T) {
 					Port:     "8080",
 					Compress: "on",
 					Format:   "json",
-					TLSConfig: telemetryv1beta1.OutputTLS{
+					TLSConfig: telemetryv1beta1.LogPipelineOutputTLS{
 						SkipCertificateValidation: true,
 						CA: &telemetryv1beta1.ValueType{
 							Value: "ca",
@@ -214,6 +251,9 @@ func TestConvertFrom(t *testing.T) {
 						},
 					},
 					Dedot: true,
+					Proxy: &telemetryv1beta1.OutputProxy{
+						Disabled: true,
+					},
 				},
 				OTLP: &telemetryv1beta1.OTLPOutput{
 					Protocol: telemetryv1beta1.OTLPProtocolGRPC,
@@ -247,9 +287,19 @@ func TestConvertFrom(t *testing.T) {
 						Disabled:                  true,
 						SkipCertificateValidation: true,
 						CA:                        &telemetryv1beta1.ValueType{Value: "ca"},
+						MinVersion:                telemetryv1beta1.TLSVersion12,
+						CipherSuites:              []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
 						Cert:                      &telemetryv1beta1.ValueType{Value: "cert"},
 						Key:                       &telemetryv1beta1.ValueType{Value: "key"},
 					},
+					Compression: telemetryv1beta1.OTLPCompressionNone,
+					Proxy: &telemetryv1beta1.OutputProxy{
+						URL: "http://proxy.example.com:3128",
+					},
+					Timeout: "30s",
+					Retry: &telemetryv1beta1.OTLPRetry{
+						Enabled: ptr.To(false),
+					},
 				},
 			},
 		},
@@ -263,6 +313,16 @@ func TestConvertFrom(t *testing.T) {
 				},
 			},
 			UnsupportedMode: ptr.To(true),
+			TestData: &telemetryv1beta1.TestDataStatus{
+				Request: "req-1",
+				SentAt:  metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			Statistics: &telemetryv1beta1.PipelineStatistics{
+				SentPerSecond:    "12.5",
+				DroppedPerSecond: "0",
+				LastExportTime:   ptr.To(metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))),
+				UpdateTime:       metav1.NewTime(time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)),
+			},
 		},
 	}
 
@@ -279,6 +339,254 @@ func TestConvertFrom(t *testing.T) {
 	require.Empty(t, cmp.Diff(src, srcAfterRoundTrip), "expected source be equal to itself after round-trip")
 }
 
+func TestConvertOutputs(t *testing.T) {
+	tests := []struct {
+		name     string
+		v1alpha1 Output
+		v1beta1  telemetryv1beta1.LogPipelineOutput
+	}{
+		{
+			name: "loki",
+			v1alpha1: Output{
+				Loki: &LokiOutput{
+					URL: ValueType{
+						Value: "https://loki.example.com/loki/api/v1/push",
+					},
+					TenantID: ValueType{
+						Value: "tenant",
+					},
+					Labels: []LokiLabel{
+						{Name: "namespace", Source: "namespace_name"},
+					},
+					TLSConfig: TLSConfig{
+						SkipCertificateValidation: true,
+					},
+				},
+			},
+			v1beta1: telemetryv1beta1.LogPipelineOutput{
+				Loki: &telemetryv1beta1.LogPipelineLokiOutput{
+					URL: telemetryv1beta1.ValueType{
+						Value: "https://loki.example.com/loki/api/v1/push",
+					},
+					TenantID: telemetryv1beta1.ValueType{
+						Value: "tenant",
+					},
+					Labels: []telemetryv1beta1.LogPipelineLokiLabel{
+						{Name: "namespace", Source: "namespace_name"},
+					},
+					TLSConfig: telemetryv1beta1.LogPipelineOutputTLS{
+						SkipCertificateValidation: true,
+					},
+				},
+			},
+		},
+		{
+			name: "elasticsearch",
+			v1alpha1: Output{
+				Elasticsearch: &ElasticsearchOutput{
+					Hosts:                []string{"es.example.com:9200"},
+					LogstashPrefix:       "logs",
+					LogstashPrefixSource: "namespace_name",
+					Pipeline:             "ingest",
+					APIKey: ValueType{
+						Value: "key",
+					},
+				},
+			},
+			v1beta1: telemetryv1beta1.LogPipelineOutput{
+				Elasticsearch: &telemetryv1beta1.LogPipelineElasticsearchOutput{
+					Hosts:                []string{"es.example.com:9200"},
+					LogstashPrefix:       "logs",
+					LogstashPrefixSource: "namespace_name",
+					Pipeline:             "ingest",
+					APIKey: telemetryv1beta1.ValueType{
+						Value: "key",
+					},
+				},
+			},
+		},
+		{
+			name: "syslog",
+			v1alpha1: Output{
+				Syslog: &SyslogOutput{
+					Host:     ValueType{Value: "siem.example.com"},
+					Port:     "6514",
+					Mode:     TransportModeTLS,
+					Format:   SyslogFormatRFC5424,
+					Facility: "local0",
+				},
+			},
+			v1beta1: telemetryv1beta1.LogPipelineOutput{
+				Syslog: &telemetryv1beta1.LogPipelineSyslogOutput{
+					Host:     telemetryv1beta1.ValueType{Value: "siem.example.com"},
+					Port:     "6514",
+					Mode:     telemetryv1beta1.LogPipelineTransportModeTLS,
+					Format:   telemetryv1beta1.LogPipelineSyslogFormatRFC5424,
+					Facility: "local0",
+				},
+			},
+		},
+		{
+			name: "gelf",
+			v1alpha1: Output{
+				GELF: &GELFOutput{
+					Host: ValueType{Value: "graylog.example.com"},
+					Mode: TransportModeTCP,
+				},
+			},
+			v1beta1: telemetryv1beta1.LogPipelineOutput{
+				GELF: &telemetryv1beta1.LogPipelineGELFOutput{
+					Host: telemetryv1beta1.ValueType{Value: "graylog.example.com"},
+					Mode: telemetryv1beta1.LogPipelineTransportModeTCP,
+				},
+			},
+		},
+		{
+			name: "kafka",
+			v1alpha1: Output{
+				Kafka: &KafkaOutput{
+					Brokers: []string{"kafka:9092"},
+					Topic:   "logs",
+					Authentication: &KafkaAuthenticationOptions{
+						SASL: &KafkaSASLOptions{
+							Mechanism: KafkaSASLMechanismSCRAMSHA512,
+							User:      ValueType{Value: "user"},
+							Password:  ValueType{Value: "password"},
+						},
+					},
+					TLS: &OtlpTLS{
+						InsecureSkipVerify: true,
+					},
+				},
+			},
+			v1beta1: telemetryv1beta1.LogPipelineOutput{
+				Kafka: &telemetryv1beta1.KafkaOutput{
+					Brokers: []string{"kafka:9092"},
+					Topic:   "logs",
+					Authentication: &telemetryv1beta1.KafkaAuthenticationOptions{
+						SASL: &telemetryv1beta1.KafkaSASLOptions{
+							Mechanism: telemetryv1beta1.KafkaSASLMechanismSCRAMSHA512,
+							User:      telemetryv1beta1.ValueType{Value: "user"},
+							Password:  telemetryv1beta1.ValueType{Value: "password"},
+						},
+					},
+					TLS: &telemetryv1beta1.OutputTLS{
+						SkipCertificateValidation: true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &LogPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "log-pipeline-test",
+				},
+				Spec: LogPipelineSpec{
+					Output: tt.v1alpha1,
+				},
+			}
+
+			dst := &telemetryv1beta1.LogPipeline{}
+
+			err := src.ConvertTo(dst)
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(tt.v1beta1, dst.Spec.Output), "unexpected v1beta1 output")
+
+			srcAfterRoundTrip := &LogPipeline{}
+			err = srcAfterRoundTrip.ConvertFrom(dst)
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(src, srcAfterRoundTrip), "expected source be equal to itself after round-trip")
+		})
+	}
+}
+
+func TestConvertOAuth2Authentication(t *testing.T) {
+	src := &LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "log-pipeline-test",
+		},
+		Spec: LogPipelineSpec{
+			Output: Output{
+				Otlp: &OtlpOutput{
+					Endpoint: ValueType{Value: "https://backend.example.com:4317"},
+					Authentication: &AuthenticationOptions{
+						OAuth2: &OAuth2Options{
+							TokenURL: ValueType{Value: "https://auth.example.com/oauth2/token"},
+							ClientID: ValueType{Value: "client-id"},
+							ClientSecret: ValueType{
+								ValueFrom: &ValueFromSource{
+									SecretKeyRef: &SecretKeyRef{
+										Name:      "oauth2-client",
+										Namespace: "default",
+										Key:       "client-secret",
+									},
+								},
+							},
+							Scopes:   []string{"logs.write"},
+							Audience: "backend",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dst := &telemetryv1beta1.LogPipeline{}
+
+	err := src.ConvertTo(dst)
+	require.NoError(t, err)
+
+	dstOAuth2 := dst.Spec.Output.OTLP.Authentication.OAuth2
+	require.NotNil(t, dstOAuth2)
+	require.Equal(t, "https://auth.example.com/oauth2/token", dstOAuth2.TokenURL.Value)
+	require.Equal(t, "client-id", dstOAuth2.ClientID.Value)
+	require.Equal(t, "oauth2-client", dstOAuth2.ClientSecret.ValueFrom.SecretKeyRef.Name)
+	require.Equal(t, []string{"logs.write"}, dstOAuth2.Scopes)
+	require.Equal(t, "backend", dstOAuth2.Audience)
+
+	srcAfterRoundTrip := &LogPipeline{}
+	err = srcAfterRoundTrip.ConvertFrom(dst)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(src, srcAfterRoundTrip), "expected source be equal to itself after round-trip")
+}
+
+func TestConvertTLSSecretRef(t *testing.T) {
+	src := &LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "log-pipeline-test",
+		},
+		Spec: LogPipelineSpec{
+			Output: Output{
+				HTTP: &HTTPOutput{
+					Host: ValueType{Value: "logs.example.com"},
+					TLSConfig: TLSConfig{
+						SecretRef: &TLSSecretRef{Name: "http-client-cert", Namespace: "default", UseCA: true},
+					},
+				},
+			},
+		},
+	}
+
+	dst := &telemetryv1beta1.LogPipeline{}
+
+	err := src.ConvertTo(dst)
+	require.NoError(t, err)
+
+	dstSecretRef := dst.Spec.Output.HTTP.TLSConfig.SecretRef
+	require.NotNil(t, dstSecretRef)
+	require.Equal(t, "http-client-cert", dstSecretRef.Name)
+	require.Equal(t, "default", dstSecretRef.Namespace)
+	require.True(t, dstSecretRef.UseCA)
+
+	srcAfterRoundTrip := &LogPipeline{}
+	err = srcAfterRoundTrip.ConvertFrom(dst)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(src, srcAfterRoundTrip), "expected source be equal to itself after round-trip")
+}
+
 func requireLogPipelinesEquivalent(t *testing.T, x *LogPipeline, y *telemetryv1beta1.LogPipeline) {
 	require.Equal(t, x.ObjectMeta, y.ObjectMeta)
 
@@ -292,6 +600,12 @@ func requireLogPipelinesEquivalent(t *testing.T, x *LogPipeline, y *telemetryv1b
 	require.Equal(t, xAppInput.KeepAnnotations, yRuntimeInput.KeepAnnotations, "keep annotations mismatch")
 	require.Equal(t, xAppInput.DropLabels, yRuntimeInput.DropLabels, "drop labels mismatch")
 	require.Equal(t, xAppInput.KeepOriginalBody, yRuntimeInput.KeepOriginalBody, "keep original body mismatch")
+	require.NotNil(t, xAppInput.Throttle, "expected throttle")
+	require.NotNil(t, yRuntimeInput.Throttle, "expected throttle")
+	require.Equal(t, xAppInput.Throttle.RecordsPerSecond, yRuntimeInput.Throttle.RecordsPerSecond, "throttle records per second mismatch")
+	require.Equal(t, string(xAppInput.Throttle.Per), string(yRuntimeInput.Throttle.Per), "throttle scope mismatch")
+
+	require.Equal(t, x.Spec.Priority, y.Spec.Priority, "priority mismatch")
 
 	require.Len(t, y.Spec.Files, 1, "expected one file")
 	require.Equal(t, x.Spec.Files[0].Name, y.Spec.Files[0].Name, "file name mismatch")
@@ -316,6 +630,8 @@ func requireLogPipelinesEquivalent(t *testing.T, x *LogPipeline, y *telemetryv1b
 	require.Equal(t, xHTTP.TLSConfig.CA.Value, yHTTP.TLSConfig.CA.Value, "HTTP TLS CA mismatch")
 	require.Equal(t, xHTTP.TLSConfig.Cert.Value, yHTTP.TLSConfig.Cert.Value, "HTTP TLS cert mismatch")
 	require.Equal(t, xHTTP.TLSConfig.Key.Value, yHTTP.TLSConfig.Key.Value, "HTTP TLS key mismatch")
+	require.Equal(t, xHTTP.Proxy.URL, yHTTP.Proxy.URL, "HTTP proxy URL mismatch")
+	require.Equal(t, xHTTP.Proxy.Disabled, yHTTP.Proxy.Disabled, "HTTP proxy disabled mismatch")
 
 	xOTLP := x.Spec.Output.Otlp
 	yOTLP := y.Spec.Output.OTLP
@@ -336,10 +652,26 @@ func requireLogPipelinesEquivalent(t *testing.T, x *LogPipeline, y *telemetryv1b
 	require.Equal(t, xOTLP.Headers[1].Prefix, yOTLP.Headers[1].Prefix, "OTLP header prefix mismatch")
 	require.Equal(t, xOTLP.TLS.Insecure, yOTLP.TLS.Disabled, "OTLP TLS insecure mismatch")
 	require.Equal(t, xOTLP.TLS.InsecureSkipVerify, yOTLP.TLS.SkipCertificateValidation, "OTLP TLS insecure skip verify mismatch")
+	require.Equal(t, xOTLP.TLS.MinVersion, string(yOTLP.TLS.MinVersion), "OTLP TLS min version mismatch")
+	require.Equal(t, xOTLP.TLS.CipherSuites, yOTLP.TLS.CipherSuites, "OTLP TLS cipher suites mismatch")
 	require.Equal(t, xOTLP.TLS.CA.Value, yOTLP.TLS.CA.Value, "OTLP TLS CA mismatch")
 	require.Equal(t, xOTLP.TLS.Cert.Value, yOTLP.TLS.Cert.Value, "OTLP TLS cert mismatch")
 	require.Equal(t, xOTLP.TLS.Key.Value, yOTLP.TLS.Key.Value, "OTLP TLS key mismatch")
+	require.Equal(t, xOTLP.Compression, string(yOTLP.Compression), "OTLP compression mismatch")
+	require.Equal(t, xOTLP.Timeout, yOTLP.Timeout, "OTLP timeout mismatch")
+	require.Equal(t, xOTLP.Retry.Enabled, yOTLP.Retry.Enabled, "OTLP retry enabled mismatch")
+	require.Equal(t, xOTLP.Retry.InitialInterval, yOTLP.Retry.InitialInterval, "OTLP retry initial interval mismatch")
+	require.Equal(t, xOTLP.Retry.MaxInterval, yOTLP.Retry.MaxInterval, "OTLP retry max interval mismatch")
+	require.Equal(t, xOTLP.Retry.MaxElapsedTime, yOTLP.Retry.MaxElapsedTime, "OTLP retry max elapsed time mismatch")
+	require.Equal(t, xOTLP.Proxy.URL, yOTLP.Proxy.URL, "OTLP proxy URL mismatch")
+	require.Equal(t, xOTLP.Proxy.Disabled, yOTLP.Proxy.Disabled, "OTLP proxy disabled mismatch")
 
 	require.Equal(t, x.Status.UnsupportedMode, y.Status.UnsupportedMode, "status unsupported mode mismatch")
 	require.ElementsMatch(t, x.Status.Conditions, y.Status.Conditions, "status conditions mismatch")
+	require.Equal(t, x.Status.TestData.Request, y.Status.TestData.Request, "status test data request mismatch")
+	require.Equal(t, x.Status.TestData.SentAt, y.Status.TestData.SentAt, "status test data sent at mismatch")
+	require.Equal(t, x.Status.Statistics.SentPerSecond, y.Status.Statistics.SentPerSecond, "status statistics sent per second mismatch")
+	require.Equal(t, x.Status.Statistics.DroppedPerSecond, y.Status.Statistics.DroppedPerSecond, "status statistics dropped per second mismatch")
+	require.Equal(t, x.Status.Statistics.LastExportTime, y.Status.Statistics.LastExportTime, "status statistics last export time mismatch")
+	require.Equal(t, x.Status.Statistics.UpdateTime, y.Status.Statistics.UpdateTime, "status statistics update time mismatch")
 }
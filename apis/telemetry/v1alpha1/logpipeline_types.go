@@ -33,6 +33,11 @@ type LogPipelineSpec struct {
 	Files  []FileMount `json:"files,omitempty"`
 	// A list of mappings from Kubernetes Secret keys to environment variables. Mapped keys are mounted as environment variables, so that they are available as [Variables](https://docs.fluentbit.io/manual/administration/configuring-fluent-bit/classic-mode/variables) in the sections.
 	Variables []VariableRef `json:"variables,omitempty"`
+
+	// Priority of the pipeline if more LogPipelines exist than the maximum number of pipelines allows. A pipeline with a higher priority takes the place of the pipeline with the lowest priority, which gets the `MaxPipelinesExceeded` condition. Among pipelines with the same priority, the earlier pipelines keep their place. The default is 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // Input describes a log input for a LogPipeline.
@@ -59,6 +64,31 @@ type ApplicationInput struct {
 	// +optional
 	// +kubebuilder:default=true
 	KeepOriginalBody *bool `json:"keepOriginalBody,omitempty"`
+	// Limits the rate of collected application logs, so that a single workload cannot flood the pipeline. Logs above the limit are dropped. By default, no limit is applied.
+	// +optional
+	Throttle *ThrottleConfig `json:"throttle,omitempty"`
+}
+
+// ThrottleScope defines the granularity at which a ThrottleConfig limit is applied.
+// +kubebuilder:validation:Enum=Namespace;Container
+type ThrottleScope string
+
+const (
+	// ThrottleScopeNamespace applies the limit to each Namespace.
+	ThrottleScopeNamespace ThrottleScope = "Namespace"
+	// ThrottleScopeContainer applies the limit to each container within a Namespace.
+	ThrottleScopeContainer ThrottleScope = "Container"
+)
+
+// ThrottleConfig limits the rate of collected application logs.
+type ThrottleConfig struct {
+	// Maximum number of log records per second that are collected for each Namespace or container, as defined by `per`.
+	// +kubebuilder:validation:Minimum=1
+	RecordsPerSecond int64 `json:"recordsPerSecond"`
+	// Defines whether the limit applies to each Namespace or to each container. The default is `Namespace`.
+	// +optional
+	// +kubebuilder:default=Namespace
+	Per ThrottleScope `json:"per,omitempty"`
 }
 
 // InputNamespaces describes whether application logs from specific Namespaces are selected. The options are mutually exclusive. System Namespaces are excluded by default from the collection.
@@ -105,9 +135,113 @@ type HTTPOutput struct {
 	TLSConfig TLSConfig `json:"tls,omitempty"`
 	// Enables de-dotting of Kubernetes labels and annotations for compatibility with ElasticSearch based backends. Dots (.) will be replaced by underscores (_). Default is `false`.
 	Dedot bool `json:"dedot,omitempty"`
+	// Overrides the cluster-wide proxy settings of the Telemetry resource for this output. Only proxies using plain HTTP are supported.
+	// +kubebuilder:validation:XValidation:rule="!has(self.url) || self.url.startsWith('http://')", message="Only proxies using plain HTTP are supported for this output"
+	Proxy *OutputProxy `json:"proxy,omitempty"`
+}
+
+// TransportMode defines the transport protocol of a Syslog or GELF output.
+// +kubebuilder:validation:Enum=tcp;udp;tls
+type TransportMode string
+
+const (
+	TransportModeTCP TransportMode = "tcp"
+	TransportModeUDP TransportMode = "udp"
+	TransportModeTLS TransportMode = "tls"
+)
+
+// SyslogFormat defines the message format of a Syslog output.
+// +kubebuilder:validation:Enum=rfc5424;rfc3164
+type SyslogFormat string
+
+const (
+	SyslogFormatRFC5424 SyslogFormat = "rfc5424"
+	SyslogFormatRFC3164 SyslogFormat = "rfc3164"
+)
+
+// SyslogOutput configures an output to a Syslog server, compatible with the Fluent Bit Syslog output plugin.
+type SyslogOutput struct {
+	// Defines the host of the Syslog server.
+	Host ValueType `json:"host,omitempty"`
+	// Defines the port of the Syslog server. Default is 6514 for the `tls` mode, and 514 otherwise.
+	Port string `json:"port,omitempty"`
+	// Defines the transport protocol. Default is `tls`.
+	// +kubebuilder:default=tls
+	Mode TransportMode `json:"mode,omitempty"`
+	// Defines the message format. Default is `rfc5424`.
+	// +kubebuilder:default=rfc5424
+	Format SyslogFormat `json:"format,omitempty"`
+	// Defines the facility of the Syslog messages, for example, `user`, `daemon`, or `local0`. Default is `user`.
+	// +kubebuilder:validation:Enum=kern;user;mail;daemon;auth;syslog;lpr;news;uucp;cron;authpriv;ftp;local0;local1;local2;local3;local4;local5;local6;local7
+	// +kubebuilder:default=user
+	Facility string `json:"facility,omitempty"`
+	// Configures TLS for the Syslog server. Only applies to the `tls` mode.
+	TLSConfig TLSConfig `json:"tls,omitempty"`
+}
+
+// GELFOutput configures an output to a Graylog Extended Log Format (GELF) receiver, such as Graylog, compatible with the Fluent Bit GELF output plugin.
+type GELFOutput struct {
+	// Defines the host of the GELF receiver.
+	Host ValueType `json:"host,omitempty"`
+	// Defines the port of the GELF receiver. Default is 12201.
+	Port string `json:"port,omitempty"`
+	// Defines the transport protocol. Default is `tls`.
+	// +kubebuilder:default=tls
+	Mode TransportMode `json:"mode,omitempty"`
+	// Configures TLS for the GELF receiver. Only applies to the `tls` mode.
+	TLSConfig TLSConfig `json:"tls,omitempty"`
+}
+
+// ElasticsearchOutput configures an output to Elasticsearch or OpenSearch, compatible with the Fluent Bit Elasticsearch output plugin.
+// +kubebuilder:validation:XValidation:rule="!(has(self.index) && (has(self.logstashPrefix) || has(self.logstashPrefixSource)))", message="Can define either 'index' or 'logstashPrefix' and 'logstashPrefixSource', but not both"
+type ElasticsearchOutput struct {
+	// Defines the Elasticsearch or OpenSearch nodes in the format `host` or `host:port`. If the port is omitted, 9200 is used. If multiple hosts are defined, the logs are balanced across them.
+	// +kubebuilder:validation:MinItems=1
+	Hosts []string `json:"hosts,omitempty"`
+	// Defines the index to which the logs are written. Default is `fluent-bit`.
+	Index string `json:"index,omitempty"`
+	// Defines the prefix of the daily index in the Logstash format, for example, `logs` for `logs-2024.01.31`. If defined, the Logstash index format is used.
+	LogstashPrefix string `json:"logstashPrefix,omitempty"`
+	// Defines the Kubernetes metadata attribute whose value is used as the prefix of the daily index, for example, `namespace_name` or `labels.app`. If the attribute is missing in a log record, **logstashPrefix** is used. If defined, the Logstash index format is used.
+	LogstashPrefixSource string `json:"logstashPrefixSource,omitempty"`
+	// Defines the name of the ingest pipeline that processes the logs.
+	Pipeline string `json:"pipeline,omitempty"`
+	// Defines the basic auth user.
+	User ValueType `json:"user,omitempty"`
+	// Defines the basic auth password.
+	Password ValueType `json:"password,omitempty"`
+	// Defines the API key. Cannot be combined with basic auth.
+	APIKey ValueType `json:"apiKey,omitempty"`
+	// Configures TLS for the Elasticsearch or OpenSearch nodes.
+	TLSConfig TLSConfig `json:"tls,omitempty"`
+}
+
+// LokiOutput configures an output to Grafana Loki, compatible with the Fluent Bit Loki output plugin.
+type LokiOutput struct {
+	// Defines the URL of the Loki push API, for example, `https://loki.example.com/loki/api/v1/push`. If the port is omitted, the default port of the URL scheme is used. If the path is omitted, `/loki/api/v1/push` is used. TLS is used only for the `https` scheme.
+	URL ValueType `json:"url,omitempty"`
+	// Defines the tenant ID that is sent in the `X-Scope-OrgID` header. Required only for multi-tenant Loki setups.
+	TenantID ValueType `json:"tenantID,omitempty"`
+	// Defines the Loki stream labels and the Kubernetes metadata attributes from which they are taken. If not defined, the `namespace`, `pod`, and `container` labels are used.
+	Labels []LokiLabel `json:"labels,omitempty"`
+	// Defines the basic auth user.
+	User ValueType `json:"user,omitempty"`
+	// Defines the basic auth password.
+	Password ValueType `json:"password,omitempty"`
+	// Configures TLS for the Loki server.
+	TLSConfig TLSConfig `json:"tls,omitempty"`
+}
+
+// LokiLabel maps a Kubernetes metadata attribute of a log record to a Loki stream label.
+type LokiLabel struct {
+	// Defines the name of the Loki label. The name must start with a letter or an underscore, followed by letters, digits, or underscores.
+	Name string `json:"name,omitempty"`
+	// Defines the Kubernetes metadata attribute to take the label value from, for example, `namespace_name`, `pod_name`, `container_name`, `host`, `labels.app`, or `annotations.my-annotation`. For `labels` and `annotations`, the remainder after the first dot is used as the key.
+	Source string `json:"source,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="has(self.cert) == has(self.key)", message="Can define either both 'cert' and 'key', or neither"
+// +kubebuilder:validation:XValidation:rule="!has(self.secretRef) || !(has(self.ca) || has(self.cert) || has(self.key))", message="Can define either 'secretRef' or 'ca', 'cert', and 'key'"
 type TLSConfig struct {
 	// Indicates if TLS is disabled or enabled. Default is `false`.
 	Disabled bool `json:"disabled,omitempty"`
@@ -119,18 +253,74 @@ type TLSConfig struct {
 	Cert *ValueType `json:"cert,omitempty"`
 	// Defines the client key to use when using TLS. The key must be provided in PEM format.
 	Key *ValueType `json:"key,omitempty"`
+	// Refers to a Secret of type `kubernetes.io/tls` that provides the client certificate and client key in the `tls.crt` and `tls.key` keys, as created by cert-manager. Cannot be combined with `ca`, `cert`, or `key`.
+	SecretRef *TLSSecretRef `json:"secretRef,omitempty"`
+}
+
+// GetCA returns the CA certificate. If secretRef is defined, it is taken from the referenced Secret only if useCA is set.
+func (t *TLSConfig) GetCA() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		if !t.SecretRef.UseCA {
+			return nil
+		}
+
+		return t.SecretRef.valueFrom(TLSSecretCAKey)
+	}
+
+	return t.CA
+}
+
+// GetCert returns the client certificate, which is taken from the referenced Secret if secretRef is defined.
+func (t *TLSConfig) GetCert() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		return t.SecretRef.valueFrom(TLSSecretCertKey)
+	}
+
+	return t.Cert
+}
+
+// GetKey returns the client key, which is taken from the referenced Secret if secretRef is defined.
+func (t *TLSConfig) GetKey() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		return t.SecretRef.valueFrom(TLSSecretKeyKey)
+	}
+
+	return t.Key
 }
 
 // Output describes a Fluent Bit output configuration section.
 // +kubebuilder:validation:XValidation:rule="has(self.otlp) == has(oldSelf.otlp)", message="Switching to or away from OTLP output is not supported"
-// +kubebuilder:validation:XValidation:rule="(!has(self.custom) && !has(self.http)) || !(has(self.custom) && has(self.http))", message="Exactly one output must be defined"
-// +kubebuilder:validation:XValidation:rule="(!has(self.custom) && !has(self.otlp)) || ! (has(self.custom) && has(self.otlp))", message="Exactly one output must be defined"
-// +kubebuilder:validation:XValidation:rule="(!has(self.http) && !has(self.otlp)) || ! (has(self.http) && has(self.otlp))", message="Exactly one output must be defined"
+// +kubebuilder:validation:XValidation:rule="[has(self.custom), has(self.http), has(self.loki), has(self.elasticsearch), has(self.syslog), has(self.gelf), has(self.kafka)].filter(x, x).size() <= 1", message="Exactly one output must be defined"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || !(has(self.custom) || has(self.http) || has(self.loki) || has(self.elasticsearch) || has(self.syslog) || has(self.gelf) || has(self.kafka))", message="Exactly one output must be defined"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || !has(self.otlp.authentication) || !has(self.otlp.authentication.oauth2)", message="OAuth2 authentication is not supported for LogPipelines"
+// +kubebuilder:validation:XValidation:rule="!has(self.kafka) || !has(self.kafka.tls) || !(has(self.kafka.tls.minVersion) || has(self.kafka.tls.maxVersion))", message="TLS versions are not supported for the Kafka output of LogPipelines"
 type Output struct {
 	// Defines a custom output in the Fluent Bit syntax. Note: If you use a `custom` output, you put the LogPipeline in unsupported mode.
 	Custom string `json:"custom,omitempty"`
 	// Configures an HTTP-based output compatible with the Fluent Bit HTTP output plugin.
 	HTTP *HTTPOutput `json:"http,omitempty"`
+	// Configures an output to Grafana Loki.
+	Loki *LokiOutput `json:"loki,omitempty"`
+	// Configures an output to Elasticsearch or OpenSearch.
+	Elasticsearch *ElasticsearchOutput `json:"elasticsearch,omitempty"`
+	// Configures an output to a Syslog server.
+	Syslog *SyslogOutput `json:"syslog,omitempty"`
+	// Configures an output to a GELF receiver, such as Graylog.
+	GELF *GELFOutput `json:"gelf,omitempty"`
+	// Configures an output to Apache Kafka.
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
 	// Defines an output using the OpenTelemetry protocol.
 	Otlp *OtlpOutput `json:"otlp,omitempty"`
 }
@@ -147,6 +337,71 @@ func (o *Output) IsHTTPDefined() bool {
 	return o.HTTP != nil && o.HTTP.Host.IsDefined()
 }
 
+func (o *Output) IsLokiDefined() bool {
+	return o.Loki != nil && o.Loki.URL.IsDefined()
+}
+
+func (o *Output) IsElasticsearchDefined() bool {
+	return o.Elasticsearch != nil && len(o.Elasticsearch.Hosts) > 0
+}
+
+func (o *Output) IsSyslogDefined() bool {
+	return o.Syslog != nil && o.Syslog.Host.IsDefined()
+}
+
+func (o *Output) IsGELFDefined() bool {
+	return o.GELF != nil && o.GELF.Host.IsDefined()
+}
+
+func (o *Output) IsKafkaDefined() bool {
+	return o.Kafka != nil && len(o.Kafka.Brokers) > 0
+}
+
+// GetTLSConfig returns the TLS configuration of the defined output, or nil if the output does not support TLS configuration.
+func (o *Output) GetTLSConfig() *TLSConfig {
+	switch {
+	case o.IsHTTPDefined():
+		return &o.HTTP.TLSConfig
+	case o.IsLokiDefined():
+		return &o.Loki.TLSConfig
+	case o.IsElasticsearchDefined():
+		return &o.Elasticsearch.TLSConfig
+	case o.IsSyslogDefined():
+		return &o.Syslog.TLSConfig
+	case o.IsGELFDefined():
+		return &o.GELF.TLSConfig
+	case o.IsKafkaDefined():
+		return kafkaTLSConfig(o.Kafka)
+	}
+
+	return nil
+}
+
+// kafkaTLSConfig maps the OTLP-style TLS options of a Kafka output to the TLS configuration of the other log outputs.
+func kafkaTLSConfig(kafka *KafkaOutput) *TLSConfig {
+	if kafka.TLS == nil {
+		return &TLSConfig{}
+	}
+
+	return &TLSConfig{
+		Disabled:                  kafka.TLS.Insecure,
+		SkipCertificateValidation: kafka.TLS.InsecureSkipVerify,
+		CA:                        kafka.TLS.CA,
+		Cert:                      kafka.TLS.Cert,
+		Key:                       kafka.TLS.Key,
+		SecretRef:                 kafka.TLS.SecretRef,
+	}
+}
+
+// GetTLSCipherSuites returns the TLS cipher suites of the defined output. Only the Kafka output supports restricting the cipher suites.
+func (o *Output) GetTLSCipherSuites() []string {
+	if !o.IsKafkaDefined() || o.Kafka.TLS == nil {
+		return nil
+	}
+
+	return o.Kafka.TLS.CipherSuites
+}
+
 func (o *Output) IsAnyDefined() bool {
 	return o.pluginCount() > 0
 }
@@ -165,6 +420,26 @@ func (o *Output) pluginCount() int {
 		plugins++
 	}
 
+	if o.IsLokiDefined() {
+		plugins++
+	}
+
+	if o.IsElasticsearchDefined() {
+		plugins++
+	}
+
+	if o.IsSyslogDefined() {
+		plugins++
+	}
+
+	if o.IsGELFDefined() {
+		plugins++
+	}
+
+	if o.IsKafkaDefined() {
+		plugins++
+	}
+
 	return plugins
 }
 
@@ -187,6 +462,10 @@ type LogPipelineStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Is active when the LogPipeline uses a `custom` output or filter; see [unsupported mode](https://github.com/kyma-project/telemetry-manager/blob/main/docs/user/02-logs.md#unsupported-mode).
 	UnsupportedMode *bool `json:"unsupportedMode,omitempty"`
+	// The last batch of test data that the pipeline sent; see the `telemetry.kyma-project.io/send-test-data` annotation.
+	TestData *TestDataStatus `json:"testData,omitempty"`
+	// The recent throughput of the pipeline, updated about once a minute.
+	Statistics *PipelineStatistics `json:"statistics,omitempty"`
 }
 
 // +kubebuilder:object:root=true
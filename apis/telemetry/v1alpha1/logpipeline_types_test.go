@@ -82,3 +82,43 @@ func TestLogPipelineContainsCustomPluginWithoutAny(t *testing.T) {
 	result := logPipeline.ContainsCustomPlugin()
 	require.False(t, result)
 }
+
+func TestTLSConfigGetters(t *testing.T) {
+	t.Run("explicit values", func(t *testing.T) {
+		tlsConfig := &TLSConfig{
+			CA:   &ValueType{Value: "ca"},
+			Cert: &ValueType{Value: "cert"},
+			Key:  &ValueType{Value: "key"},
+		}
+
+		require.Equal(t, "ca", tlsConfig.GetCA().Value)
+		require.Equal(t, "cert", tlsConfig.GetCert().Value)
+		require.Equal(t, "key", tlsConfig.GetKey().Value)
+	})
+
+	t.Run("secret reference", func(t *testing.T) {
+		tlsConfig := &TLSConfig{
+			SecretRef: &TLSSecretRef{Name: "client-cert", Namespace: "default"},
+		}
+
+		require.Nil(t, tlsConfig.GetCA())
+		require.Equal(t, &SecretKeyRef{Name: "client-cert", Namespace: "default", Key: "tls.crt"}, tlsConfig.GetCert().ValueFrom.SecretKeyRef)
+		require.Equal(t, &SecretKeyRef{Name: "client-cert", Namespace: "default", Key: "tls.key"}, tlsConfig.GetKey().ValueFrom.SecretKeyRef)
+	})
+
+	t.Run("secret reference with CA", func(t *testing.T) {
+		tlsConfig := &TLSConfig{
+			SecretRef: &TLSSecretRef{Name: "client-cert", Namespace: "default", UseCA: true},
+		}
+
+		require.Equal(t, &SecretKeyRef{Name: "client-cert", Namespace: "default", Key: "ca.crt"}, tlsConfig.GetCA().ValueFrom.SecretKeyRef)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var otlpTLS *OtlpTLS
+
+		require.Nil(t, otlpTLS.GetCA())
+		require.Nil(t, otlpTLS.GetCert())
+		require.Nil(t, otlpTLS.GetKey())
+	})
+}
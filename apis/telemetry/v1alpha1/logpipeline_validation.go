@@ -3,7 +3,10 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/kyma-project/telemetry-manager/internal/fluentbit/config"
@@ -12,6 +15,8 @@ import (
 var (
 	forbiddenFilters             = []string{"kubernetes", "rewrite_tag"}
 	validHostNamePattern         = regexp.MustCompile(`^(([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]*[a-zA-Z0-9])\.)*([A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9\-]*[A-Za-z0-9])$`)
+	validLokiLabelNamePattern    = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	metadataSourcePrefixes       = []string{"labels.", "annotations."}
 	ErrInvalidPipelineDefinition = errors.New("invalid log pipeline definition")
 )
 
@@ -39,6 +44,36 @@ func (lp *LogPipeline) validateOutput() error {
 		}
 	}
 
+	if output.IsLokiDefined() {
+		if err := validateLokiOutput(output.Loki); err != nil {
+			return err
+		}
+	}
+
+	if output.IsElasticsearchDefined() {
+		if err := validateElasticsearchOutput(output.Elasticsearch); err != nil {
+			return err
+		}
+	}
+
+	if output.IsSyslogDefined() {
+		if err := validateSyslogOutput(output.Syslog); err != nil {
+			return err
+		}
+	}
+
+	if output.IsGELFDefined() {
+		if err := validateGELFOutput(output.GELF); err != nil {
+			return err
+		}
+	}
+
+	if output.IsKafkaDefined() {
+		if err := validateKafkaOutput(output.Kafka); err != nil {
+			return err
+		}
+	}
+
 	return validateCustomOutput(output.Custom)
 }
 
@@ -80,6 +115,186 @@ func validateHTTPOutput(httpOutput *HTTPOutput) error {
 	return nil
 }
 
+func validateLokiOutput(lokiOutput *LokiOutput) error {
+	if lokiOutput.URL.Value != "" {
+		u, err := url.Parse(lokiOutput.URL.Value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || !validHostname(u.Hostname()) {
+			return fmt.Errorf("invalid loki url '%s'", lokiOutput.URL.Value)
+		}
+	}
+
+	if secretRefAndValueIsPresent(lokiOutput.URL) {
+		return fmt.Errorf("loki output url must have either a value or secret key reference")
+	}
+
+	if secretRefAndValueIsPresent(lokiOutput.TenantID) {
+		return fmt.Errorf("loki output tenant ID must have either a value or secret key reference")
+	}
+
+	if secretRefAndValueIsPresent(lokiOutput.User) {
+		return fmt.Errorf("loki output user must have either a value or secret key reference")
+	}
+
+	if secretRefAndValueIsPresent(lokiOutput.Password) {
+		return fmt.Errorf("loki output password must have either a value or secret key reference")
+	}
+
+	labelNames := make(map[string]bool)
+
+	for _, label := range lokiOutput.Labels {
+		if !validLokiLabelNamePattern.MatchString(label.Name) {
+			return fmt.Errorf("invalid loki label name '%s'", label.Name)
+		}
+
+		if labelNames[label.Name] {
+			return fmt.Errorf("loki label '%s' is defined more than once", label.Name)
+		}
+
+		labelNames[label.Name] = true
+
+		if !validMetadataSource(label.Source) {
+			return fmt.Errorf("invalid source '%s' of loki label '%s'", label.Source, label.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateElasticsearchOutput(esOutput *ElasticsearchOutput) error {
+	for _, host := range esOutput.Hosts {
+		hostname, port, err := net.SplitHostPort(host)
+		if err != nil {
+			hostname, port = host, ""
+		}
+
+		if !validHostname(hostname) {
+			return fmt.Errorf("invalid elasticsearch host '%s'", host)
+		}
+
+		if port != "" {
+			if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+				return fmt.Errorf("invalid port of elasticsearch host '%s'", host)
+			}
+		}
+	}
+
+	if esOutput.Index != "" && (esOutput.LogstashPrefix != "" || esOutput.LogstashPrefixSource != "") {
+		return fmt.Errorf("elasticsearch output must define either an index or a logstash prefix")
+	}
+
+	if esOutput.LogstashPrefixSource != "" && !validMetadataSource(esOutput.LogstashPrefixSource) {
+		return fmt.Errorf("invalid logstash prefix source '%s'", esOutput.LogstashPrefixSource)
+	}
+
+	if secretRefAndValueIsPresent(esOutput.User) {
+		return fmt.Errorf("elasticsearch output user must have either a value or secret key reference")
+	}
+
+	if secretRefAndValueIsPresent(esOutput.Password) {
+		return fmt.Errorf("elasticsearch output password must have either a value or secret key reference")
+	}
+
+	if secretRefAndValueIsPresent(esOutput.APIKey) {
+		return fmt.Errorf("elasticsearch output API key must have either a value or secret key reference")
+	}
+
+	if esOutput.APIKey.IsDefined() && (esOutput.User.IsDefined() || esOutput.Password.IsDefined()) {
+		return fmt.Errorf("elasticsearch output must use either basic auth or an API key")
+	}
+
+	return nil
+}
+
+func validateSyslogOutput(syslogOutput *SyslogOutput) error {
+	if err := validateHostAndPort("syslog", syslogOutput.Host, syslogOutput.Port); err != nil {
+		return err
+	}
+
+	if syslogOutput.Mode != "" && syslogOutput.Mode != TransportModeTLS && tlsMaterialDefined(syslogOutput.TLSConfig) {
+		return fmt.Errorf("syslog output supports TLS configuration only in tls mode")
+	}
+
+	return nil
+}
+
+func validateGELFOutput(gelfOutput *GELFOutput) error {
+	if err := validateHostAndPort("gelf", gelfOutput.Host, gelfOutput.Port); err != nil {
+		return err
+	}
+
+	if gelfOutput.Mode != "" && gelfOutput.Mode != TransportModeTLS && tlsMaterialDefined(gelfOutput.TLSConfig) {
+		return fmt.Errorf("gelf output supports TLS configuration only in tls mode")
+	}
+
+	return nil
+}
+
+func validateKafkaOutput(kafkaOutput *KafkaOutput) error {
+	for _, broker := range kafkaOutput.Brokers {
+		host, port, err := net.SplitHostPort(broker)
+		if err != nil || !validHostname(host) {
+			return fmt.Errorf("invalid kafka broker '%s'", broker)
+		}
+
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return fmt.Errorf("invalid kafka broker '%s'", broker)
+		}
+	}
+
+	if kafkaOutput.Topic == "" {
+		return fmt.Errorf("kafka output must define a topic")
+	}
+
+	if kafkaOutput.Encoding != "" {
+		return fmt.Errorf("kafka output of a log pipeline does not support an encoding")
+	}
+
+	if sasl := kafkaOutput.Authentication; sasl != nil && sasl.SASL != nil {
+		if secretRefAndValueIsPresent(sasl.SASL.User) {
+			return fmt.Errorf("kafka output user must have either a value or secret key reference")
+		}
+
+		if secretRefAndValueIsPresent(sasl.SASL.Password) {
+			return fmt.Errorf("kafka output password must have either a value or secret key reference")
+		}
+	}
+
+	return nil
+}
+
+func validateHostAndPort(outputType string, host ValueType, port string) error {
+	if host.Value != "" && !validHostname(host.Value) {
+		return fmt.Errorf("invalid hostname '%s'", host.Value)
+	}
+
+	if secretRefAndValueIsPresent(host) {
+		return fmt.Errorf("%s output host must have either a value or secret key reference", outputType)
+	}
+
+	if port != "" {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return fmt.Errorf("invalid port '%s' of %s output", port, outputType)
+		}
+	}
+
+	return nil
+}
+
+func tlsMaterialDefined(tlsConfig TLSConfig) bool {
+	return tlsConfig.GetCA().IsDefined() || tlsConfig.GetCert().IsDefined() || tlsConfig.GetKey().IsDefined()
+}
+
+// validMetadataSource checks that the source is either a top-level attribute of the Kubernetes metadata or a key of the labels or annotations.
+func validMetadataSource(source string) bool {
+	for _, prefix := range metadataSourcePrefixes {
+		if strings.HasPrefix(source, prefix) {
+			return len(source) > len(prefix) && !strings.ContainsAny(source, "'[]")
+		}
+	}
+
+	return source != "" && !strings.ContainsAny(source, ".'[]")
+}
+
 func validHostname(host string) bool {
 	host = strings.Trim(host, " ")
 	return validHostNamePattern.MatchString(host)
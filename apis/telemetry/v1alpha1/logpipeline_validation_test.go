@@ -343,3 +343,322 @@ func TestValidateWithInvalidContainerSelectors(t *testing.T) {
 	err := logPipeline.validateInput()
 	require.Error(t, err)
 }
+
+func TestValidateLokiOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      *LokiOutput
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			output: &LokiOutput{
+				URL: ValueType{Value: "https://loki.example.com/loki/api/v1/push"},
+				Labels: []LokiLabel{
+					{Name: "namespace", Source: "namespace_name"},
+					{Name: "app", Source: "labels.app.kubernetes.io/name"},
+				},
+			},
+		},
+		{
+			name: "url without scheme",
+			output: &LokiOutput{
+				URL: ValueType{Value: "loki.example.com"},
+			},
+			expectedErr: "invalid loki url 'loki.example.com'",
+		},
+		{
+			name: "url with value and secret key reference",
+			output: &LokiOutput{
+				URL: ValueType{
+					Value: "https://loki.example.com",
+					ValueFrom: &ValueFromSource{
+						SecretKeyRef: &SecretKeyRef{Name: "foo", Namespace: "foo-ns", Key: "foo-key"},
+					},
+				},
+			},
+			expectedErr: "loki output url must have either a value or secret key reference",
+		},
+		{
+			name: "invalid label name",
+			output: &LokiOutput{
+				URL:    ValueType{Value: "https://loki.example.com"},
+				Labels: []LokiLabel{{Name: "my-label", Source: "pod_name"}},
+			},
+			expectedErr: "invalid loki label name 'my-label'",
+		},
+		{
+			name: "duplicate label name",
+			output: &LokiOutput{
+				URL: ValueType{Value: "https://loki.example.com"},
+				Labels: []LokiLabel{
+					{Name: "pod", Source: "pod_name"},
+					{Name: "pod", Source: "pod_id"},
+				},
+			},
+			expectedErr: "loki label 'pod' is defined more than once",
+		},
+		{
+			name: "invalid label source",
+			output: &LokiOutput{
+				URL:    ValueType{Value: "https://loki.example.com"},
+				Labels: []LokiLabel{{Name: "app", Source: "labels."}},
+			},
+			expectedErr: "invalid source 'labels.' of loki label 'app'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logPipeline := &LogPipeline{
+				Spec: LogPipelineSpec{
+					Output: Output{
+						Loki: tt.output,
+					},
+				},
+			}
+
+			err := logPipeline.validateOutput()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestValidateElasticsearchOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      *ElasticsearchOutput
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			output: &ElasticsearchOutput{
+				Hosts:                []string{"es-1.example.com", "es-2.example.com:9243"},
+				LogstashPrefix:       "logs",
+				LogstashPrefixSource: "namespace_name",
+				APIKey:               ValueType{Value: "key"},
+			},
+		},
+		{
+			name: "invalid host",
+			output: &ElasticsearchOutput{
+				Hosts: []string{"es_1.example.com"},
+			},
+			expectedErr: "invalid elasticsearch host 'es_1.example.com'",
+		},
+		{
+			name: "invalid port",
+			output: &ElasticsearchOutput{
+				Hosts: []string{"es.example.com:http"},
+			},
+			expectedErr: "invalid port of elasticsearch host 'es.example.com:http'",
+		},
+		{
+			name: "index and logstash prefix",
+			output: &ElasticsearchOutput{
+				Hosts:          []string{"es.example.com"},
+				Index:          "logs",
+				LogstashPrefix: "logs",
+			},
+			expectedErr: "elasticsearch output must define either an index or a logstash prefix",
+		},
+		{
+			name: "invalid logstash prefix source",
+			output: &ElasticsearchOutput{
+				Hosts:                []string{"es.example.com"},
+				LogstashPrefixSource: "labels.",
+			},
+			expectedErr: "invalid logstash prefix source 'labels.'",
+		},
+		{
+			name: "basic auth and api key",
+			output: &ElasticsearchOutput{
+				Hosts:  []string{"es.example.com"},
+				User:   ValueType{Value: "user"},
+				APIKey: ValueType{Value: "key"},
+			},
+			expectedErr: "elasticsearch output must use either basic auth or an API key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logPipeline := &LogPipeline{
+				Spec: LogPipelineSpec{
+					Output: Output{
+						Elasticsearch: tt.output,
+					},
+				},
+			}
+
+			err := logPipeline.validateOutput()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestValidateSyslogAndGELFOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      Output
+		expectedErr string
+	}{
+		{
+			name: "valid syslog",
+			output: Output{
+				Syslog: &SyslogOutput{
+					Host:   ValueType{Value: "siem.example.com"},
+					Port:   "6514",
+					Mode:   TransportModeTLS,
+					Format: SyslogFormatRFC5424,
+					TLSConfig: TLSConfig{
+						CA: &ValueType{Value: "fake-ca-value"},
+					},
+				},
+			},
+		},
+		{
+			name: "syslog with invalid hostname",
+			output: Output{
+				Syslog: &SyslogOutput{
+					Host: ValueType{Value: "siem_example.com"},
+				},
+			},
+			expectedErr: "invalid hostname 'siem_example.com'",
+		},
+		{
+			name: "syslog with invalid port",
+			output: Output{
+				Syslog: &SyslogOutput{
+					Host: ValueType{Value: "siem.example.com"},
+					Port: "syslog",
+				},
+			},
+			expectedErr: "invalid port 'syslog' of syslog output",
+		},
+		{
+			name: "syslog with tls configuration in udp mode",
+			output: Output{
+				Syslog: &SyslogOutput{
+					Host: ValueType{Value: "siem.example.com"},
+					Mode: TransportModeUDP,
+					TLSConfig: TLSConfig{
+						CA: &ValueType{Value: "fake-ca-value"},
+					},
+				},
+			},
+			expectedErr: "syslog output supports TLS configuration only in tls mode",
+		},
+		{
+			name: "valid gelf",
+			output: Output{
+				GELF: &GELFOutput{
+					Host: ValueType{Value: "graylog.example.com"},
+					Mode: TransportModeTCP,
+				},
+			},
+		},
+		{
+			name: "gelf with value and secret key reference",
+			output: Output{
+				GELF: &GELFOutput{
+					Host: ValueType{
+						Value: "graylog.example.com",
+						ValueFrom: &ValueFromSource{
+							SecretKeyRef: &SecretKeyRef{Name: "foo", Namespace: "foo-ns", Key: "foo-key"},
+						},
+					},
+				},
+			},
+			expectedErr: "gelf output host must have either a value or secret key reference",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logPipeline := &LogPipeline{
+				Spec: LogPipelineSpec{
+					Output: tt.output,
+				},
+			}
+
+			err := logPipeline.validateOutput()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestValidateKafkaOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      *KafkaOutput
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			output: &KafkaOutput{
+				Brokers: []string{"kafka-0.kafka:9092", "kafka-1.kafka:9092"},
+				Topic:   "logs",
+			},
+		},
+		{
+			name: "broker without port",
+			output: &KafkaOutput{
+				Brokers: []string{"kafka-0.kafka"},
+				Topic:   "logs",
+			},
+			expectedErr: "invalid kafka broker 'kafka-0.kafka'",
+		},
+		{
+			name: "missing topic",
+			output: &KafkaOutput{
+				Brokers: []string{"kafka-0.kafka:9092"},
+			},
+			expectedErr: "kafka output must define a topic",
+		},
+		{
+			name: "encoding",
+			output: &KafkaOutput{
+				Brokers:  []string{"kafka-0.kafka:9092"},
+				Topic:    "logs",
+				Encoding: KafkaEncodingOTLPJSON,
+			},
+			expectedErr: "kafka output of a log pipeline does not support an encoding",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logPipeline := &LogPipeline{
+				Spec: LogPipelineSpec{
+					Output: Output{
+						Kafka: tt.output,
+					},
+				},
+			}
+
+			err := logPipeline.validateOutput()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
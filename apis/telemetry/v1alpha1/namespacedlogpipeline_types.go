@@ -0,0 +1,90 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,categories={kyma-telemetry,kyma-telemetry-pipelines}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Pipeline",type=string,JSONPath=`.status.pipelineName`
+// +kubebuilder:printcolumn:name="Configuration Generated",type=string,JSONPath=`.status.conditions[?(@.type=="ConfigurationGenerated")].status`
+// +kubebuilder:printcolumn:name="Agent Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="AgentHealthy")].status`
+// +kubebuilder:printcolumn:name="Flow Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="TelemetryFlowHealthy")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NamespacedLogPipeline is the Schema for the namespacedlogpipelines API.
+// It ships the application logs of its own Namespace. The Telemetry Manager runs it as a LogPipeline that only selects logs from the Namespace.
+type NamespacedLogPipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Defines the desired state of NamespacedLogPipeline
+	Spec NamespacedLogPipelineSpec `json:"spec,omitempty"`
+	// Shows the observed state of the NamespacedLogPipeline
+	Status NamespacedPipelineStatus `json:"status,omitempty"`
+}
+
+// NamespacedLogPipelineSpec defines the desired state of NamespacedLogPipeline
+type NamespacedLogPipelineSpec struct {
+	// Configures which application logs of the Namespace are collected.
+	Input NamespacedLogPipelineInput `json:"input,omitempty"`
+	// Defines the destination of the logs. Only one output can be specified. Secrets must be referenced from the Namespace of the pipeline.
+	Output NamespacedLogPipelineOutput `json:"output"`
+}
+
+// NamespacedLogPipelineInput describes which application logs of the Namespace are collected.
+type NamespacedLogPipelineInput struct {
+	// Describes whether application logs from specific containers are selected. The options are mutually exclusive.
+	Containers InputContainers `json:"containers,omitempty"`
+	// Defines whether to keep all Kubernetes annotations. The default is `false`.
+	KeepAnnotations bool `json:"keepAnnotations,omitempty"`
+	// Defines whether to drop all Kubernetes labels. The default is `false`.
+	DropLabels bool `json:"dropLabels,omitempty"`
+}
+
+// NamespacedLogPipelineOutput describes the destination of the logs. Custom outputs are not supported, because they can bypass the Namespace restrictions.
+// +kubebuilder:validation:XValidation:rule="[has(self.http), has(self.loki), has(self.elasticsearch), has(self.syslog), has(self.gelf), has(self.kafka)].filter(x, x).size() == 1", message="Exactly one output must be defined"
+type NamespacedLogPipelineOutput struct {
+	// Configures an HTTP-based output compatible with the Fluent Bit HTTP output plugin.
+	HTTP *HTTPOutput `json:"http,omitempty"`
+	// Configures an output to Grafana Loki.
+	Loki *LokiOutput `json:"loki,omitempty"`
+	// Configures an output to Elasticsearch or OpenSearch.
+	Elasticsearch *ElasticsearchOutput `json:"elasticsearch,omitempty"`
+	// Configures an output to a Syslog server.
+	Syslog *SyslogOutput `json:"syslog,omitempty"`
+	// Configures an output to a GELF receiver, such as Graylog.
+	GELF *GELFOutput `json:"gelf,omitempty"`
+	// Configures an output to Apache Kafka.
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedLogPipelineList contains a list of NamespacedLogPipeline
+type NamespacedLogPipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedLogPipeline `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespacedLogPipeline{}, &NamespacedLogPipelineList{})
+}
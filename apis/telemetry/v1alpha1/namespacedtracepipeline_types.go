@@ -0,0 +1,61 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,categories={kyma-telemetry,kyma-telemetry-pipelines}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Pipeline",type=string,JSONPath=`.status.pipelineName`
+// +kubebuilder:printcolumn:name="Configuration Generated",type=string,JSONPath=`.status.conditions[?(@.type=="ConfigurationGenerated")].status`
+// +kubebuilder:printcolumn:name="Gateway Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="GatewayHealthy")].status`
+// +kubebuilder:printcolumn:name="Flow Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="TelemetryFlowHealthy")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NamespacedTracePipeline is the Schema for the namespacedtracepipelines API.
+// It ships the spans of the workloads in its own Namespace. The Telemetry Manager runs it as a TracePipeline that only selects spans from the Namespace.
+type NamespacedTracePipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Defines the desired state of NamespacedTracePipeline
+	Spec NamespacedTracePipelineSpec `json:"spec,omitempty"`
+	// Shows the observed state of the NamespacedTracePipeline
+	Status NamespacedPipelineStatus `json:"status,omitempty"`
+}
+
+// NamespacedTracePipelineSpec defines the desired state of NamespacedTracePipeline
+type NamespacedTracePipelineSpec struct {
+	// Defines a destination for shipping trace data. Only one can be defined per pipeline. Secrets must be referenced from the Namespace of the pipeline.
+	Output TracePipelineOutput `json:"output"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedTracePipelineList contains a list of NamespacedTracePipeline
+type NamespacedTracePipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedTracePipeline `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespacedTracePipeline{}, &NamespacedTracePipelineList{})
+}
@@ -79,6 +79,129 @@ func TestLogPipeline_GetSecretRefs(t *testing.T) {
 				{Name: "creds", Namespace: "default", Key: "password"},
 			},
 		},
+		{
+			name: "loki output secret refs",
+			given: LogPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cls",
+				},
+				Spec: LogPipelineSpec{
+					Output: Output{
+						Loki: &LokiOutput{
+							URL: ValueType{
+								ValueFrom: &ValueFromSource{
+									SecretKeyRef: &SecretKeyRef{
+										Name: "creds", Namespace: "default", Key: "url",
+									},
+								},
+							},
+							TenantID: ValueType{
+								ValueFrom: &ValueFromSource{
+									SecretKeyRef: &SecretKeyRef{
+										Name: "creds", Namespace: "default", Key: "tenant",
+									},
+								},
+							},
+							TLSConfig: TLSConfig{
+								CA: &ValueType{
+									ValueFrom: &ValueFromSource{
+										SecretKeyRef: &SecretKeyRef{
+											Name: "tls", Namespace: "default", Key: "ca.crt",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []SecretKeyRef{
+				{Name: "creds", Namespace: "default", Key: "url"},
+				{Name: "creds", Namespace: "default", Key: "tenant"},
+				{Name: "tls", Namespace: "default", Key: "ca.crt"},
+			},
+		},
+		{
+			name: "elasticsearch output secret refs",
+			given: LogPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "es",
+				},
+				Spec: LogPipelineSpec{
+					Output: Output{
+						Elasticsearch: &ElasticsearchOutput{
+							Hosts: []string{"es.example.com"},
+							APIKey: ValueType{
+								ValueFrom: &ValueFromSource{
+									SecretKeyRef: &SecretKeyRef{
+										Name: "creds", Namespace: "default", Key: "api-key",
+									},
+								},
+							},
+							TLSConfig: TLSConfig{
+								Cert: &ValueType{
+									ValueFrom: &ValueFromSource{
+										SecretKeyRef: &SecretKeyRef{
+											Name: "tls", Namespace: "default", Key: "tls.crt",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []SecretKeyRef{
+				{Name: "creds", Namespace: "default", Key: "api-key"},
+				{Name: "tls", Namespace: "default", Key: "tls.crt"},
+			},
+		},
+		{
+			name: "syslog output secret refs",
+			given: LogPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "syslog",
+				},
+				Spec: LogPipelineSpec{
+					Output: Output{
+						Syslog: &SyslogOutput{
+							Host: ValueType{
+								ValueFrom: &ValueFromSource{
+									SecretKeyRef: &SecretKeyRef{
+										Name: "siem", Namespace: "default", Key: "host",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []SecretKeyRef{
+				{Name: "siem", Namespace: "default", Key: "host"},
+			},
+		},
+		{
+			name: "http output tls secret",
+			given: LogPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "http",
+				},
+				Spec: LogPipelineSpec{
+					Output: Output{
+						HTTP: &HTTPOutput{
+							Host: ValueType{Value: "logs.example.com"},
+							TLSConfig: TLSConfig{
+								SecretRef: &TLSSecretRef{Name: "client-cert", Namespace: "default"},
+							},
+						},
+					},
+				},
+			},
+			expected: []SecretKeyRef{
+				{Name: "client-cert", Namespace: "default", Key: "tls.crt"},
+				{Name: "client-cert", Namespace: "default", Key: "tls.key"},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -162,6 +285,54 @@ func TestTracePipeline_GetSecretRefs(t *testing.T) {
 				{Name: "secret-3", Namespace: "default", Key: "myheader"},
 			},
 		},
+		{
+			name:         "oauth2",
+			pipelineName: "test-pipeline",
+			given: &OtlpOutput{
+				Authentication: &AuthenticationOptions{
+					OAuth2: &OAuth2Options{
+						TokenURL: ValueType{Value: "https://auth.example.com/oauth2/token"},
+						ClientID: ValueType{
+							ValueFrom: &ValueFromSource{
+								SecretKeyRef: &SecretKeyRef{
+									Name:      "oauth2-client",
+									Namespace: "default",
+									Key:       "client-id",
+								}},
+						},
+						ClientSecret: ValueType{
+							ValueFrom: &ValueFromSource{
+								SecretKeyRef: &SecretKeyRef{
+									Name:      "oauth2-client",
+									Namespace: "default",
+									Key:       "client-secret",
+								}},
+						},
+					},
+				},
+			},
+
+			expected: []SecretKeyRef{
+				{Name: "oauth2-client", Namespace: "default", Key: "client-id"},
+				{Name: "oauth2-client", Namespace: "default", Key: "client-secret"},
+			},
+		},
+		{
+			name:         "tls secret",
+			pipelineName: "test-pipeline",
+			given: &OtlpOutput{
+				Endpoint: ValueType{Value: "https://backend.example.com:4317"},
+				TLS: &OtlpTLS{
+					SecretRef: &TLSSecretRef{Name: "client-cert", Namespace: "default", UseCA: true},
+				},
+			},
+
+			expected: []SecretKeyRef{
+				{Name: "client-cert", Namespace: "default", Key: "ca.crt"},
+				{Name: "client-cert", Namespace: "default", Key: "tls.crt"},
+				{Name: "client-cert", Namespace: "default", Key: "tls.key"},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -256,3 +427,55 @@ func TestMetricPipeline_GetSecretRefs(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaOutput_GetSecretRefs(t *testing.T) {
+	kafkaOutput := &KafkaOutput{
+		Brokers: []string{"kafka:9092"},
+		Topic:   "telemetry",
+		Authentication: &KafkaAuthenticationOptions{
+			SASL: &KafkaSASLOptions{
+				User: ValueType{Value: "user"},
+				Password: ValueType{
+					ValueFrom: &ValueFromSource{
+						SecretKeyRef: &SecretKeyRef{Name: "kafka", Namespace: "default", Key: "password"},
+					},
+				},
+			},
+		},
+		TLS: &OtlpTLS{
+			CA: &ValueType{
+				ValueFrom: &ValueFromSource{
+					SecretKeyRef: &SecretKeyRef{Name: "kafka-tls", Namespace: "default", Key: "ca.crt"},
+				},
+			},
+		},
+	}
+
+	expected := []SecretKeyRef{
+		{Name: "kafka", Namespace: "default", Key: "password"},
+		{Name: "kafka-tls", Namespace: "default", Key: "ca.crt"},
+	}
+
+	t.Run("trace pipeline", func(t *testing.T) {
+		sut := TracePipeline{Spec: TracePipelineSpec{Output: TracePipelineOutput{Kafka: kafkaOutput}}}
+		require.ElementsMatch(t, expected, sut.GetSecretRefs())
+	})
+
+	t.Run("metric pipeline", func(t *testing.T) {
+		sut := MetricPipeline{Spec: MetricPipelineSpec{Output: MetricPipelineOutput{Kafka: kafkaOutput}}}
+		require.ElementsMatch(t, expected, sut.GetSecretRefs())
+	})
+
+	t.Run("log pipeline", func(t *testing.T) {
+		sut := LogPipeline{Spec: LogPipelineSpec{Output: Output{Kafka: kafkaOutput}}}
+		require.ElementsMatch(t, expected, sut.GetSecretRefs())
+	})
+
+	t.Run("insecure", func(t *testing.T) {
+		insecureOutput := kafkaOutput.DeepCopy()
+		insecureOutput.TLS.Insecure = true
+
+		sut := TracePipeline{Spec: TracePipelineSpec{Output: TracePipelineOutput{Kafka: insecureOutput}}}
+		require.ElementsMatch(t, expected[:1], sut.GetSecretRefs())
+	})
+}
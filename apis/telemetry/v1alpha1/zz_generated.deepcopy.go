@@ -40,6 +40,11 @@ func (in *ApplicationInput) DeepCopyInto(out *ApplicationInput) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Throttle != nil {
+		in, out := &in.Throttle, &out.Throttle
+		*out = new(ThrottleConfig)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInput.
@@ -60,6 +65,11 @@ func (in *AuthenticationOptions) DeepCopyInto(out *AuthenticationOptions) {
 		*out = new(BasicAuthOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2Options)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationOptions.
@@ -104,6 +114,30 @@ func (in *DiagnosticMetrics) DeepCopy() *DiagnosticMetrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchOutput) DeepCopyInto(out *ElasticsearchOutput) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchOutput.
+func (in *ElasticsearchOutput) DeepCopy() *ElasticsearchOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileMount) DeepCopyInto(out *FileMount) {
 	*out = *in
@@ -134,6 +168,23 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GELFOutput) DeepCopyInto(out *GELFOutput) {
+	*out = *in
+	in.Host.DeepCopyInto(&out.Host)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GELFOutput.
+func (in *GELFOutput) DeepCopy() *GELFOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(GELFOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPOutput) DeepCopyInto(out *HTTPOutput) {
 	*out = *in
@@ -141,6 +192,11 @@ func (in *HTTPOutput) DeepCopyInto(out *HTTPOutput) {
 	in.User.DeepCopyInto(&out.User)
 	in.Password.DeepCopyInto(&out.Password)
 	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(OutputProxy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPOutput.
@@ -235,6 +291,73 @@ func (in *InputNamespaces) DeepCopy() *InputNamespaces {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaAuthenticationOptions) DeepCopyInto(out *KafkaAuthenticationOptions) {
+	*out = *in
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASLOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaAuthenticationOptions.
+func (in *KafkaAuthenticationOptions) DeepCopy() *KafkaAuthenticationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaAuthenticationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaOutput) DeepCopyInto(out *KafkaOutput) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(KafkaAuthenticationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OtlpTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaOutput.
+func (in *KafkaOutput) DeepCopy() *KafkaOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLOptions) DeepCopyInto(out *KafkaSASLOptions) {
+	*out = *in
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLOptions.
+func (in *KafkaSASLOptions) DeepCopy() *KafkaSASLOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogParser) DeepCopyInto(out *LogParser) {
 	*out = *in
@@ -439,6 +562,16 @@ func (in *LogPipelineStatus) DeepCopyInto(out *LogPipelineStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TestData != nil {
+		in, out := &in.TestData, &out.TestData
+		*out = new(TestDataStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(PipelineStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineStatus.
@@ -451,6 +584,46 @@ func (in *LogPipelineStatus) DeepCopy() *LogPipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiLabel) DeepCopyInto(out *LokiLabel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiLabel.
+func (in *LokiLabel) DeepCopy() *LokiLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiOutput) DeepCopyInto(out *LokiOutput) {
+	*out = *in
+	in.URL.DeepCopyInto(&out.URL)
+	in.TenantID.DeepCopyInto(&out.TenantID)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]LokiLabel, len(*in))
+		copy(*out, *in)
+	}
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiOutput.
+func (in *LokiOutput) DeepCopy() *LokiOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricPipeline) DeepCopyInto(out *MetricPipeline) {
 	*out = *in
@@ -623,6 +796,11 @@ func (in *MetricPipelineOutput) DeepCopyInto(out *MetricPipelineOutput) {
 		*out = new(OtlpOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineOutput.
@@ -787,6 +965,21 @@ func (in *MetricPipelineStatus) DeepCopyInto(out *MetricPipelineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnsupportedMode != nil {
+		in, out := &in.UnsupportedMode, &out.UnsupportedMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TestData != nil {
+		in, out := &in.TestData, &out.TestData
+		*out = new(TestDataStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(PipelineStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineStatus.
@@ -799,6 +992,263 @@ func (in *MetricPipelineStatus) DeepCopy() *MetricPipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedLogPipeline) DeepCopyInto(out *NamespacedLogPipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedLogPipeline.
+func (in *NamespacedLogPipeline) DeepCopy() *NamespacedLogPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedLogPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedLogPipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedLogPipelineInput) DeepCopyInto(out *NamespacedLogPipelineInput) {
+	*out = *in
+	in.Containers.DeepCopyInto(&out.Containers)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedLogPipelineInput.
+func (in *NamespacedLogPipelineInput) DeepCopy() *NamespacedLogPipelineInput {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedLogPipelineInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedLogPipelineList) DeepCopyInto(out *NamespacedLogPipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedLogPipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedLogPipelineList.
+func (in *NamespacedLogPipelineList) DeepCopy() *NamespacedLogPipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedLogPipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedLogPipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedLogPipelineOutput) DeepCopyInto(out *NamespacedLogPipelineOutput) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GELF != nil {
+		in, out := &in.GELF, &out.GELF
+		*out = new(GELFOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedLogPipelineOutput.
+func (in *NamespacedLogPipelineOutput) DeepCopy() *NamespacedLogPipelineOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedLogPipelineOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedLogPipelineSpec) DeepCopyInto(out *NamespacedLogPipelineSpec) {
+	*out = *in
+	in.Input.DeepCopyInto(&out.Input)
+	in.Output.DeepCopyInto(&out.Output)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedLogPipelineSpec.
+func (in *NamespacedLogPipelineSpec) DeepCopy() *NamespacedLogPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedLogPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedPipelineStatus) DeepCopyInto(out *NamespacedPipelineStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedPipelineStatus.
+func (in *NamespacedPipelineStatus) DeepCopy() *NamespacedPipelineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedPipelineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedTracePipeline) DeepCopyInto(out *NamespacedTracePipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedTracePipeline.
+func (in *NamespacedTracePipeline) DeepCopy() *NamespacedTracePipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedTracePipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedTracePipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedTracePipelineList) DeepCopyInto(out *NamespacedTracePipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedTracePipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedTracePipelineList.
+func (in *NamespacedTracePipelineList) DeepCopy() *NamespacedTracePipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedTracePipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedTracePipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedTracePipelineSpec) DeepCopyInto(out *NamespacedTracePipelineSpec) {
+	*out = *in
+	in.Output.DeepCopyInto(&out.Output)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedTracePipelineSpec.
+func (in *NamespacedTracePipelineSpec) DeepCopy() *NamespacedTracePipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedTracePipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2Options) DeepCopyInto(out *OAuth2Options) {
+	*out = *in
+	in.TokenURL.DeepCopyInto(&out.TokenURL)
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2Options.
+func (in *OAuth2Options) DeepCopy() *OAuth2Options {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2Options)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OtlpOutput) DeepCopyInto(out *OtlpOutput) {
 	*out = *in
@@ -820,6 +1270,16 @@ func (in *OtlpOutput) DeepCopyInto(out *OtlpOutput) {
 		*out = new(OtlpTLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(OtlpRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(OutputProxy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtlpOutput.
@@ -832,6 +1292,26 @@ func (in *OtlpOutput) DeepCopy() *OtlpOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OtlpRetry) DeepCopyInto(out *OtlpRetry) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtlpRetry.
+func (in *OtlpRetry) DeepCopy() *OtlpRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(OtlpRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OtlpTLS) DeepCopyInto(out *OtlpTLS) {
 	*out = *in
@@ -850,6 +1330,16 @@ func (in *OtlpTLS) DeepCopyInto(out *OtlpTLS) {
 		*out = new(ValueType)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TLSSecretRef)
+		**out = **in
+	}
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtlpTLS.
@@ -870,6 +1360,31 @@ func (in *Output) DeepCopyInto(out *Output) {
 		*out = new(HTTPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GELF != nil {
+		in, out := &in.GELF, &out.GELF
+		*out = new(GELFOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Otlp != nil {
 		in, out := &in.Otlp, &out.Otlp
 		*out = new(OtlpOutput)
@@ -887,6 +1402,46 @@ func (in *Output) DeepCopy() *Output {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputProxy) DeepCopyInto(out *OutputProxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputProxy.
+func (in *OutputProxy) DeepCopy() *OutputProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineStatistics) DeepCopyInto(out *PipelineStatistics) {
+	*out = *in
+	if in.Queued != nil {
+		in, out := &in.Queued, &out.Queued
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastExportTime != nil {
+		in, out := &in.LastExportTime, &out.LastExportTime
+		*out = (*in).DeepCopy()
+	}
+	in.UpdateTime.DeepCopyInto(&out.UpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineStatistics.
+func (in *PipelineStatistics) DeepCopy() *PipelineStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
 	*out = *in
@@ -902,6 +1457,23 @@ func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogOutput) DeepCopyInto(out *SyslogOutput) {
+	*out = *in
+	in.Host.DeepCopyInto(&out.Host)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogOutput.
+func (in *SyslogOutput) DeepCopy() *SyslogOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
 	*out = *in
@@ -920,6 +1492,11 @@ func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
 		*out = new(ValueType)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TLSSecretRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
@@ -932,6 +1509,52 @@ func (in *TLSConfig) DeepCopy() *TLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSecretRef) DeepCopyInto(out *TLSSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecretRef.
+func (in *TLSSecretRef) DeepCopy() *TLSSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestDataStatus) DeepCopyInto(out *TestDataStatus) {
+	*out = *in
+	in.SentAt.DeepCopyInto(&out.SentAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestDataStatus.
+func (in *TestDataStatus) DeepCopy() *TestDataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TestDataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottleConfig) DeepCopyInto(out *ThrottleConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottleConfig.
+func (in *ThrottleConfig) DeepCopy() *ThrottleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipeline) DeepCopyInto(out *TracePipeline) {
 	*out = *in
@@ -959,6 +1582,51 @@ func (in *TracePipeline) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineInput) DeepCopyInto(out *TracePipelineInput) {
+	*out = *in
+	if in.Otlp != nil {
+		in, out := &in.Otlp, &out.Otlp
+		*out = new(TracePipelineOtlpInput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineInput.
+func (in *TracePipelineInput) DeepCopy() *TracePipelineInput {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineInputNamespaceSelector) DeepCopyInto(out *TracePipelineInputNamespaceSelector) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineInputNamespaceSelector.
+func (in *TracePipelineInputNamespaceSelector) DeepCopy() *TracePipelineInputNamespaceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineInputNamespaceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineList) DeepCopyInto(out *TracePipelineList) {
 	*out = *in
@@ -991,6 +1659,26 @@ func (in *TracePipelineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineOtlpInput) DeepCopyInto(out *TracePipelineOtlpInput) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(TracePipelineInputNamespaceSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineOtlpInput.
+func (in *TracePipelineOtlpInput) DeepCopy() *TracePipelineOtlpInput {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineOtlpInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineOutput) DeepCopyInto(out *TracePipelineOutput) {
 	*out = *in
@@ -999,6 +1687,11 @@ func (in *TracePipelineOutput) DeepCopyInto(out *TracePipelineOutput) {
 		*out = new(OtlpOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineOutput.
@@ -1014,6 +1707,7 @@ func (in *TracePipelineOutput) DeepCopy() *TracePipelineOutput {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineSpec) DeepCopyInto(out *TracePipelineSpec) {
 	*out = *in
+	in.Input.DeepCopyInto(&out.Input)
 	in.Output.DeepCopyInto(&out.Output)
 }
 
@@ -1037,6 +1731,21 @@ func (in *TracePipelineStatus) DeepCopyInto(out *TracePipelineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnsupportedMode != nil {
+		in, out := &in.UnsupportedMode, &out.UnsupportedMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TestData != nil {
+		in, out := &in.TestData, &out.TestData
+		*out = new(TestDataStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(PipelineStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineStatus.
@@ -54,6 +54,11 @@ type LogPipelineSpec struct {
 	Files  []LogPipelineFileMount `json:"files,omitempty"`
 	// A list of mappings from Kubernetes Secret keys to environment variables. Mapped keys are mounted as environment variables, so that they are available as [Variables](https://docs.fluentbit.io/manual/administration/configuring-fluent-bit/classic-mode/variables) in the sections.
 	Variables []LogPipelineVariableRef `json:"variables,omitempty"`
+
+	// Priority of the pipeline if more LogPipelines exist than the maximum number of pipelines allows. A pipeline with a higher priority takes the place of the pipeline with the lowest priority, which gets the `MaxPipelinesExceeded` condition. Among pipelines with the same priority, the earlier pipelines keep their place. The default is 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // LogPipelineInput describes a log input for a LogPipeline.
@@ -80,6 +85,31 @@ type LogPipelineRuntimeInput struct {
 	// +optional
 	// +kubebuilder:default=true
 	KeepOriginalBody *bool `json:"keepOriginalBody,omitempty"`
+	// Limits the rate of collected application logs, so that a single workload cannot flood the pipeline. Logs above the limit are dropped. By default, no limit is applied.
+	// +optional
+	Throttle *LogPipelineThrottle `json:"throttle,omitempty"`
+}
+
+// LogPipelineThrottleScope defines the granularity at which a LogPipelineThrottle limit is applied.
+// +kubebuilder:validation:Enum=Namespace;Container
+type LogPipelineThrottleScope string
+
+const (
+	// LogPipelineThrottleScopeNamespace applies the limit to each Namespace.
+	LogPipelineThrottleScopeNamespace LogPipelineThrottleScope = "Namespace"
+	// LogPipelineThrottleScopeContainer applies the limit to each container within a Namespace.
+	LogPipelineThrottleScopeContainer LogPipelineThrottleScope = "Container"
+)
+
+// LogPipelineThrottle limits the rate of collected application logs.
+type LogPipelineThrottle struct {
+	// Maximum number of log records per second that are collected for each Namespace or container, as defined by `per`.
+	// +kubebuilder:validation:Minimum=1
+	RecordsPerSecond int64 `json:"recordsPerSecond"`
+	// Defines whether the limit applies to each Namespace or to each container. The default is `Namespace`.
+	// +optional
+	// +kubebuilder:default=Namespace
+	Per LogPipelineThrottleScope `json:"per,omitempty"`
 }
 
 // LogPipelineInputNamespaces describes whether application logs from specific Namespaces are selected. The options are mutually exclusive. System Namespaces are excluded by default from the collection.
@@ -108,14 +138,25 @@ type LogPipelineFilter struct {
 
 // Output describes a Fluent Bit output configuration section.
 // +kubebuilder:validation:XValidation:rule="has(self.otlp) == has(oldSelf.otlp)", message="Switching to or away from OTLP output is not supported"
-// +kubebuilder:validation:XValidation:rule="(!has(self.custom) && !has(self.http)) || !(has(self.custom) && has(self.http))", message="Exactly one output must be defined"
-// +kubebuilder:validation:XValidation:rule="(!has(self.custom) && !has(self.otlp)) || ! (has(self.custom) && has(self.otlp))", message="Exactly one output must be defined"
-// +kubebuilder:validation:XValidation:rule="(!has(self.http) && !has(self.otlp)) || ! (has(self.http) && has(self.otlp))", message="Exactly one output must be defined"
+// +kubebuilder:validation:XValidation:rule="[has(self.custom), has(self.http), has(self.loki), has(self.elasticsearch), has(self.syslog), has(self.gelf), has(self.kafka)].filter(x, x).size() <= 1", message="Exactly one output must be defined"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || !(has(self.custom) || has(self.http) || has(self.loki) || has(self.elasticsearch) || has(self.syslog) || has(self.gelf) || has(self.kafka))", message="Exactly one output must be defined"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || !has(self.otlp.authentication) || !has(self.otlp.authentication.oauth2)", message="OAuth2 authentication is not supported for LogPipelines"
+// +kubebuilder:validation:XValidation:rule="!has(self.kafka) || !has(self.kafka.tls) || !(has(self.kafka.tls.minVersion) || has(self.kafka.tls.maxVersion))", message="TLS versions are not supported for the Kafka output of LogPipelines"
 type LogPipelineOutput struct {
 	// Defines a custom output in the Fluent Bit syntax. Note: If you use a `custom` output, you put the LogPipeline in unsupported mode.
 	Custom string `json:"custom,omitempty"`
 	// Configures an HTTP-based output compatible with the Fluent Bit HTTP output plugin.
 	HTTP *LogPipelineHTTPOutput `json:"http,omitempty"`
+	// Configures an output to Grafana Loki.
+	Loki *LogPipelineLokiOutput `json:"loki,omitempty"`
+	// Configures an output to Elasticsearch or OpenSearch.
+	Elasticsearch *LogPipelineElasticsearchOutput `json:"elasticsearch,omitempty"`
+	// Configures an output to a Syslog server.
+	Syslog *LogPipelineSyslogOutput `json:"syslog,omitempty"`
+	// Configures an output to a GELF receiver, such as Graylog.
+	GELF *LogPipelineGELFOutput `json:"gelf,omitempty"`
+	// Configures an output to Apache Kafka.
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
 	// Defines an output using the OpenTelemetry protocol.
 	OTLP *OTLPOutput `json:"otlp,omitempty"`
 }
@@ -137,12 +178,178 @@ type LogPipelineHTTPOutput struct {
 	// Data format to be used in the HTTP request body. Default is `json`.
 	Format string `json:"format,omitempty"`
 	// Configures TLS for the HTTP target server.
-	TLSConfig OutputTLS `json:"tls,omitempty"`
+	TLSConfig LogPipelineOutputTLS `json:"tls,omitempty"`
 	// Enables de-dotting of Kubernetes labels and annotations for compatibility with ElasticSearch based backends. Dots (.) will be replaced by underscores (_). Default is `false`.
 	Dedot bool `json:"dedot,omitempty"`
+	// Overrides the cluster-wide proxy settings of the Telemetry resource for this output. Only proxies using plain HTTP are supported.
+	// +kubebuilder:validation:XValidation:rule="!has(self.url) || self.url.startsWith('http://')", message="Only proxies using plain HTTP are supported for this output"
+	Proxy *OutputProxy `json:"proxy,omitempty"`
+}
+
+// LogPipelineTransportMode defines the transport protocol of a Syslog or GELF output.
+// +kubebuilder:validation:Enum=tcp;udp;tls
+type LogPipelineTransportMode string
+
+const (
+	LogPipelineTransportModeTCP LogPipelineTransportMode = "tcp"
+	LogPipelineTransportModeUDP LogPipelineTransportMode = "udp"
+	LogPipelineTransportModeTLS LogPipelineTransportMode = "tls"
+)
+
+// LogPipelineSyslogFormat defines the message format of a Syslog output.
+// +kubebuilder:validation:Enum=rfc5424;rfc3164
+type LogPipelineSyslogFormat string
+
+const (
+	LogPipelineSyslogFormatRFC5424 LogPipelineSyslogFormat = "rfc5424"
+	LogPipelineSyslogFormatRFC3164 LogPipelineSyslogFormat = "rfc3164"
+)
+
+// LogPipelineSyslogOutput configures an output to a Syslog server, compatible with the Fluent Bit Syslog output plugin.
+type LogPipelineSyslogOutput struct {
+	// Defines the host of the Syslog server.
+	Host ValueType `json:"host,omitempty"`
+	// Defines the port of the Syslog server. Default is 6514 for the `tls` mode, and 514 otherwise.
+	Port string `json:"port,omitempty"`
+	// Defines the transport protocol. Default is `tls`.
+	// +kubebuilder:default=tls
+	Mode LogPipelineTransportMode `json:"mode,omitempty"`
+	// Defines the message format. Default is `rfc5424`.
+	// +kubebuilder:default=rfc5424
+	Format LogPipelineSyslogFormat `json:"format,omitempty"`
+	// Defines the facility of the Syslog messages, for example, `user`, `daemon`, or `local0`. Default is `user`.
+	// +kubebuilder:validation:Enum=kern;user;mail;daemon;auth;syslog;lpr;news;uucp;cron;authpriv;ftp;local0;local1;local2;local3;local4;local5;local6;local7
+	// +kubebuilder:default=user
+	Facility string `json:"facility,omitempty"`
+	// Configures TLS for the Syslog server. Only applies to the `tls` mode.
+	TLSConfig LogPipelineOutputTLS `json:"tls,omitempty"`
+}
+
+// LogPipelineGELFOutput configures an output to a Graylog Extended Log Format (GELF) receiver, such as Graylog, compatible with the Fluent Bit GELF output plugin.
+type LogPipelineGELFOutput struct {
+	// Defines the host of the GELF receiver.
+	Host ValueType `json:"host,omitempty"`
+	// Defines the port of the GELF receiver. Default is 12201.
+	Port string `json:"port,omitempty"`
+	// Defines the transport protocol. Default is `tls`.
+	// +kubebuilder:default=tls
+	Mode LogPipelineTransportMode `json:"mode,omitempty"`
+	// Configures TLS for the GELF receiver. Only applies to the `tls` mode.
+	TLSConfig LogPipelineOutputTLS `json:"tls,omitempty"`
+}
+
+// LogPipelineElasticsearchOutput configures an output to Elasticsearch or OpenSearch, compatible with the Fluent Bit Elasticsearch output plugin.
+// +kubebuilder:validation:XValidation:rule="!(has(self.index) && (has(self.logstashPrefix) || has(self.logstashPrefixSource)))", message="Can define either 'index' or 'logstashPrefix' and 'logstashPrefixSource', but not both"
+type LogPipelineElasticsearchOutput struct {
+	// Defines the Elasticsearch or OpenSearch nodes in the format `host` or `host:port`. If the port is omitted, 9200 is used. If multiple hosts are defined, the logs are balanced across them.
+	// +kubebuilder:validation:MinItems=1
+	Hosts []string `json:"hosts,omitempty"`
+	// Defines the index to which the logs are written. Default is `fluent-bit`.
+	Index string `json:"index,omitempty"`
+	// Defines the prefix of the daily index in the Logstash format, for example, `logs` for `logs-2024.01.31`. If defined, the Logstash index format is used.
+	LogstashPrefix string `json:"logstashPrefix,omitempty"`
+	// Defines the Kubernetes metadata attribute whose value is used as the prefix of the daily index, for example, `namespace_name` or `labels.app`. If the attribute is missing in a log record, **logstashPrefix** is used. If defined, the Logstash index format is used.
+	LogstashPrefixSource string `json:"logstashPrefixSource,omitempty"`
+	// Defines the name of the ingest pipeline that processes the logs.
+	Pipeline string `json:"pipeline,omitempty"`
+	// Defines the basic auth user.
+	User ValueType `json:"user,omitempty"`
+	// Defines the basic auth password.
+	Password ValueType `json:"password,omitempty"`
+	// Defines the API key. Cannot be combined with basic auth.
+	APIKey ValueType `json:"apiKey,omitempty"`
+	// Configures TLS for the Elasticsearch or OpenSearch nodes.
+	TLSConfig LogPipelineOutputTLS `json:"tls,omitempty"`
+}
+
+// LogPipelineLokiOutput configures an output to Grafana Loki, compatible with the Fluent Bit Loki output plugin.
+type LogPipelineLokiOutput struct {
+	// Defines the URL of the Loki push API, for example, `https://loki.example.com/loki/api/v1/push`. If the port is omitted, the default port of the URL scheme is used. If the path is omitted, `/loki/api/v1/push` is used. TLS is used only for the `https` scheme.
+	URL ValueType `json:"url,omitempty"`
+	// Defines the tenant ID that is sent in the `X-Scope-OrgID` header. Required only for multi-tenant Loki setups.
+	TenantID ValueType `json:"tenantID,omitempty"`
+	// Defines the Loki stream labels and the Kubernetes metadata attributes from which they are taken. If not defined, the `namespace`, `pod`, and `container` labels are used.
+	Labels []LogPipelineLokiLabel `json:"labels,omitempty"`
+	// Defines the basic auth user.
+	User ValueType `json:"user,omitempty"`
+	// Defines the basic auth password.
+	Password ValueType `json:"password,omitempty"`
+	// Configures TLS for the Loki server.
+	TLSConfig LogPipelineOutputTLS `json:"tls,omitempty"`
+}
+
+// LogPipelineLokiLabel maps a Kubernetes metadata attribute of a log record to a Loki stream label.
+type LogPipelineLokiLabel struct {
+	// Defines the name of the Loki label. The name must start with a letter or an underscore, followed by letters, digits, or underscores.
+	Name string `json:"name,omitempty"`
+	// Defines the Kubernetes metadata attribute to take the label value from, for example, `namespace_name`, `pod_name`, `container_name`, `host`, `labels.app`, or `annotations.my-annotation`. For `labels` and `annotations`, the remainder after the first dot is used as the key.
+	Source string `json:"source,omitempty"`
+}
+
+// LogPipelineOutputTLS configures TLS for the outputs of LogPipelines that are compatible with Fluent Bit output plugins.
+// +kubebuilder:validation:XValidation:rule="has(self.cert) == has(self.key)", message="Can define either both 'cert' and 'key', or neither"
+// +kubebuilder:validation:XValidation:rule="!has(self.secretRef) || !(has(self.ca) || has(self.cert) || has(self.key))", message="Can define either 'secretRef' or 'ca', 'cert', and 'key'"
+type LogPipelineOutputTLS struct {
+	// Indicates if TLS is disabled or enabled. Default is `false`.
+	Disabled bool `json:"disabled,omitempty"`
+	// If `true`, the validation of certificates is skipped. Default is `false`.
+	SkipCertificateValidation bool `json:"skipCertificateValidation,omitempty"`
+	// Defines an optional CA certificate for server certificate verification when using TLS. The certificate must be provided in PEM format.
+	CA *ValueType `json:"ca,omitempty"`
+	// Defines a client certificate to use when using TLS. The certificate must be provided in PEM format.
+	Cert *ValueType `json:"cert,omitempty"`
+	// Defines the client key to use when using TLS. The key must be provided in PEM format.
+	Key *ValueType `json:"key,omitempty"`
+	// Refers to a Secret of type `kubernetes.io/tls` that provides the client certificate and client key in the `tls.crt` and `tls.key` keys, as created by cert-manager. Cannot be combined with `ca`, `cert`, or `key`.
+	SecretRef *TLSSecretRef `json:"secretRef,omitempty"`
+}
+
+// GetCA returns the CA certificate. If secretRef is defined, it is taken from the referenced Secret only if useCA is set.
+func (t *LogPipelineOutputTLS) GetCA() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		if !t.SecretRef.UseCA {
+			return nil
+		}
+
+		return t.SecretRef.valueFrom(TLSSecretCAKey)
+	}
+
+	return t.CA
+}
+
+// GetCert returns the client certificate, which is taken from the referenced Secret if secretRef is defined.
+func (t *LogPipelineOutputTLS) GetCert() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		return t.SecretRef.valueFrom(TLSSecretCertKey)
+	}
+
+	return t.Cert
+}
+
+// GetKey returns the client key, which is taken from the referenced Secret if secretRef is defined.
+func (t *LogPipelineOutputTLS) GetKey() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		return t.SecretRef.valueFrom(TLSSecretKeyKey)
+	}
+
+	return t.Key
 }
 
 // +kubebuilder:validation:XValidation:rule="has(self.cert) == has(self.key)", message="Can define either both 'cert' and 'key', or neither"
+// +kubebuilder:validation:XValidation:rule="!has(self.minVersion) || !has(self.maxVersion) || self.minVersion <= self.maxVersion", message="'minVersion' must not be greater than 'maxVersion'"
+// +kubebuilder:validation:XValidation:rule="!has(self.secretRef) || !(has(self.ca) || has(self.cert) || has(self.key))", message="Can define either 'secretRef' or 'ca', 'cert', and 'key'"
 type OutputTLS struct {
 	// Indicates if TLS is disabled or enabled. Default is `false`.
 	Disabled bool `json:"disabled,omitempty"`
@@ -154,8 +361,99 @@ type OutputTLS struct {
 	Cert *ValueType `json:"cert,omitempty"`
 	// Defines the client key to use when using TLS. The key must be provided in PEM format.
 	Key *ValueType `json:"key,omitempty"`
+	// Refers to a Secret of type `kubernetes.io/tls` that provides the client certificate and client key in the `tls.crt` and `tls.key` keys, as created by cert-manager. Cannot be combined with `ca`, `cert`, or `key`.
+	SecretRef *TLSSecretRef `json:"secretRef,omitempty"`
+	// Defines the minimum TLS version (1.0, 1.1, 1.2, or 1.3). Not supported for the `kafka` output of LogPipelines.
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2";"1.3"
+	MinVersion TLSVersion `json:"minVersion,omitempty"`
+	// Defines the maximum TLS version (1.0, 1.1, 1.2, or 1.3). Not supported for the `kafka` output of LogPipelines.
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2";"1.3"
+	MaxVersion TLSVersion `json:"maxVersion,omitempty"`
+	// Defines the allowed cipher suites for TLS 1.2 and lower, using the IANA names, for example `TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256`. The cipher suites of TLS 1.3 are not configurable.
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
+
+// GetCA returns the CA certificate. If secretRef is defined, it is taken from the referenced Secret only if useCA is set.
+func (t *OutputTLS) GetCA() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		if !t.SecretRef.UseCA {
+			return nil
+		}
+
+		return t.SecretRef.valueFrom(TLSSecretCAKey)
+	}
+
+	return t.CA
+}
+
+// GetCert returns the client certificate, which is taken from the referenced Secret if secretRef is defined.
+func (t *OutputTLS) GetCert() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		return t.SecretRef.valueFrom(TLSSecretCertKey)
+	}
+
+	return t.Cert
 }
 
+// GetKey returns the client key, which is taken from the referenced Secret if secretRef is defined.
+func (t *OutputTLS) GetKey() *ValueType {
+	if t == nil {
+		return nil
+	}
+
+	if t.SecretRef != nil {
+		return t.SecretRef.valueFrom(TLSSecretKeyKey)
+	}
+
+	return t.Key
+}
+
+// TLSSecretRef refers to a Secret of type `kubernetes.io/tls`.
+type TLSSecretRef struct {
+	// The name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// The name of the Namespace containing the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// If `true`, the `ca.crt` key of the Secret is used as CA certificate for server certificate verification instead of the system trust roots. Secrets from public issuers, such as ACME issuers, have no `ca.crt` key. Default is `false`.
+	UseCA bool `json:"useCA,omitempty"`
+}
+
+// The keys of a Secret of type `kubernetes.io/tls` that hold the TLS material.
+const (
+	TLSSecretCAKey   = "ca.crt"
+	TLSSecretCertKey = "tls.crt"
+	TLSSecretKeyKey  = "tls.key"
+)
+
+func (r *TLSSecretRef) valueFrom(key string) *ValueType {
+	return &ValueType{
+		ValueFrom: &ValueFromSource{
+			SecretKeyRef: &SecretKeyRef{Name: r.Name, Namespace: r.Namespace, Key: key},
+		},
+	}
+}
+
+type TLSVersion string
+
+const (
+	TLSVersion10 TLSVersion = "1.0"
+	TLSVersion11 TLSVersion = "1.1"
+	TLSVersion12 TLSVersion = "1.2"
+	TLSVersion13 TLSVersion = "1.3"
+)
+
 // Provides file content to be consumed by a LogPipeline configuration
 type LogPipelineFileMount struct {
 	Name    string `json:"name,omitempty"`
@@ -175,6 +473,10 @@ type LogPipelineStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Is active when the LogPipeline uses a `custom` output or filter; see [unsupported mode](https://github.com/kyma-project/telemetry-manager/blob/main/docs/user/02-logs.md#unsupported-mode).
 	UnsupportedMode *bool `json:"unsupportedMode,omitempty"`
+	// The last batch of test data that the pipeline sent; see the `telemetry.kyma-project.io/send-test-data` annotation.
+	TestData *TestDataStatus `json:"testData,omitempty"`
+	// The recent throughput of the pipeline, updated about once a minute.
+	Statistics *PipelineStatistics `json:"statistics,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -202,6 +504,62 @@ func (o *LogPipelineOutput) IsHTTPDefined() bool {
 	return o.HTTP != nil && o.HTTP.Host.IsDefined()
 }
 
+func (o *LogPipelineOutput) IsLokiDefined() bool {
+	return o.Loki != nil && o.Loki.URL.IsDefined()
+}
+
+func (o *LogPipelineOutput) IsElasticsearchDefined() bool {
+	return o.Elasticsearch != nil && len(o.Elasticsearch.Hosts) > 0
+}
+
+func (o *LogPipelineOutput) IsSyslogDefined() bool {
+	return o.Syslog != nil && o.Syslog.Host.IsDefined()
+}
+
+func (o *LogPipelineOutput) IsGELFDefined() bool {
+	return o.GELF != nil && o.GELF.Host.IsDefined()
+}
+
+func (o *LogPipelineOutput) IsKafkaDefined() bool {
+	return o.Kafka != nil && len(o.Kafka.Brokers) > 0
+}
+
+// GetTLSConfig returns the TLS configuration of the defined output, or nil if the output does not support TLS configuration.
+func (o *LogPipelineOutput) GetTLSConfig() *LogPipelineOutputTLS {
+	switch {
+	case o.IsHTTPDefined():
+		return &o.HTTP.TLSConfig
+	case o.IsLokiDefined():
+		return &o.Loki.TLSConfig
+	case o.IsElasticsearchDefined():
+		return &o.Elasticsearch.TLSConfig
+	case o.IsSyslogDefined():
+		return &o.Syslog.TLSConfig
+	case o.IsGELFDefined():
+		return &o.GELF.TLSConfig
+	case o.IsKafkaDefined():
+		return kafkaTLSConfig(o.Kafka)
+	}
+
+	return nil
+}
+
+// kafkaTLSConfig maps the TLS options of a Kafka output to the TLS configuration of the other log outputs.
+func kafkaTLSConfig(kafka *KafkaOutput) *LogPipelineOutputTLS {
+	if kafka.TLS == nil {
+		return &LogPipelineOutputTLS{}
+	}
+
+	return &LogPipelineOutputTLS{
+		Disabled:                  kafka.TLS.Disabled,
+		SkipCertificateValidation: kafka.TLS.SkipCertificateValidation,
+		CA:                        kafka.TLS.CA,
+		Cert:                      kafka.TLS.Cert,
+		Key:                       kafka.TLS.Key,
+		SecretRef:                 kafka.TLS.SecretRef,
+	}
+}
+
 func (o *LogPipelineOutput) IsAnyDefined() bool {
 	return o.pluginCount() > 0
 }
@@ -220,6 +578,26 @@ func (o *LogPipelineOutput) pluginCount() int {
 		plugins++
 	}
 
+	if o.IsLokiDefined() {
+		plugins++
+	}
+
+	if o.IsElasticsearchDefined() {
+		plugins++
+	}
+
+	if o.IsSyslogDefined() {
+		plugins++
+	}
+
+	if o.IsGELFDefined() {
+		plugins++
+	}
+
+	if o.IsKafkaDefined() {
+		plugins++
+	}
+
 	return plugins
 }
 
@@ -49,6 +49,11 @@ type MetricPipelineSpec struct {
 
 	// Configures the metric gateway.
 	Output MetricPipelineOutput `json:"output,omitempty"`
+
+	// Priority of the pipeline if more MetricPipelines exist than the maximum number of pipelines allows. A pipeline with a higher priority takes the place of the pipeline with the lowest priority, which gets the `MaxPipelinesExceeded` condition. Among pipelines with the same priority, the earlier pipelines keep their place. The default is 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // MetricPipelineInput defines the input configuration section.
@@ -167,15 +172,24 @@ type DiagnosticMetrics struct {
 }
 
 // MetricPipelineOutput defines the output configuration section.
+// +kubebuilder:validation:XValidation:rule="has(self.otlp) != has(self.kafka)", message="Exactly one output must be defined"
 type MetricPipelineOutput struct {
 	// Defines an output using the OpenTelemetry protocol.
-	OTLP *OTLPOutput `json:"otlp"`
+	OTLP *OTLPOutput `json:"otlp,omitempty"`
+	// Configures the underlying OTel Collector with a [Kafka exporter](https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/kafkaexporter).
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
 }
 
 // MetricPipelineStatus defines the observed state of MetricPipeline.
 type MetricPipelineStatus struct {
 	// An array of conditions describing the status of the pipeline.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Is active when the OTel Collector configuration of the pipeline is patched with the `telemetry-override-config` ConfigMap; see [patching the collector configuration](https://github.com/kyma-project/telemetry-manager/blob/main/docs/contributor/troubleshooting.md#patching-the-collector-configuration).
+	UnsupportedMode *bool `json:"unsupportedMode,omitempty"`
+	// The last batch of test data that the pipeline sent; see the `telemetry.kyma-project.io/send-test-data` annotation.
+	TestData *TestDataStatus `json:"testData,omitempty"`
+	// The recent throughput of the pipeline, updated about once a minute.
+	Statistics *PipelineStatistics `json:"statistics,omitempty"`
 }
 
 // +kubebuilder:object:root=true
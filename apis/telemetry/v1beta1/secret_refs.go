@@ -26,36 +26,58 @@ func (lp *LogPipeline) GetEnvSecretRefs() []SecretKeyRef {
 		refs = appendIfSecretRef(refs, output.HTTP.Password)
 	}
 
+	if output.IsLokiDefined() {
+		refs = appendIfSecretRef(refs, output.Loki.URL)
+		refs = appendIfSecretRef(refs, output.Loki.TenantID)
+		refs = appendIfSecretRef(refs, output.Loki.User)
+		refs = appendIfSecretRef(refs, output.Loki.Password)
+	}
+
+	if output.IsElasticsearchDefined() {
+		refs = appendIfSecretRef(refs, output.Elasticsearch.User)
+		refs = appendIfSecretRef(refs, output.Elasticsearch.Password)
+		refs = appendIfSecretRef(refs, output.Elasticsearch.APIKey)
+	}
+
+	if output.IsSyslogDefined() {
+		refs = appendIfSecretRef(refs, output.Syslog.Host)
+	}
+
+	if output.IsKafkaDefined() && output.Kafka.Authentication != nil && output.Kafka.Authentication.SASL.IsDefined() {
+		refs = appendIfSecretRef(refs, output.Kafka.Authentication.SASL.User)
+		refs = appendIfSecretRef(refs, output.Kafka.Authentication.SASL.Password)
+	}
+
+	if output.IsGELFDefined() {
+		refs = appendIfSecretRef(refs, output.GELF.Host)
+	}
+
 	return refs
 }
 
 func (lp *LogPipeline) GetTLSSecretRefs() []SecretKeyRef {
 	var refs []SecretKeyRef
 
-	output := lp.Spec.Output
-	if output.IsHTTPDefined() {
-		tlsConfig := output.HTTP.TLSConfig
-		if tlsConfig.CA != nil {
-			refs = appendIfSecretRef(refs, *tlsConfig.CA)
-		}
-
-		if tlsConfig.Cert != nil {
-			refs = appendIfSecretRef(refs, *tlsConfig.Cert)
-		}
-
-		if tlsConfig.Key != nil {
-			refs = appendIfSecretRef(refs, *tlsConfig.Key)
-		}
+	if tlsConfig := lp.Spec.Output.GetTLSConfig(); tlsConfig != nil {
+		refs = appendTLSSecretRefs(refs, tlsConfig.GetCA(), tlsConfig.GetCert(), tlsConfig.GetKey())
 	}
 
 	return refs
 }
 
 func (tp *TracePipeline) GetSecretRefs() []SecretKeyRef {
+	if tp.Spec.Output.Kafka != nil {
+		return getRefsInKafkaOutput(tp.Spec.Output.Kafka)
+	}
+
 	return getRefsInOTLPOutput(tp.Spec.Output.OTLP)
 }
 
 func (mp *MetricPipeline) GetSecretRefs() []SecretKeyRef {
+	if mp.Spec.Output.Kafka != nil {
+		return getRefsInKafkaOutput(mp.Spec.Output.Kafka)
+	}
+
 	return getRefsInOTLPOutput(mp.Spec.Output.OTLP)
 }
 
@@ -69,27 +91,48 @@ func getRefsInOTLPOutput(out *OTLPOutput) []SecretKeyRef {
 		refs = appendIfSecretRef(refs, out.Authentication.Basic.Password)
 	}
 
+	if out.Authentication != nil && out.Authentication.OAuth2.IsDefined() {
+		refs = appendIfSecretRef(refs, out.Authentication.OAuth2.TokenURL)
+		refs = appendIfSecretRef(refs, out.Authentication.OAuth2.ClientID)
+		refs = appendIfSecretRef(refs, out.Authentication.OAuth2.ClientSecret)
+	}
+
 	for _, header := range out.Headers {
 		refs = appendIfSecretRef(refs, header.ValueType)
 	}
 
 	if out.TLS != nil && !out.TLS.Disabled {
-		if out.TLS.CA != nil {
-			refs = appendIfSecretRef(refs, *out.TLS.CA)
-		}
+		refs = appendTLSSecretRefs(refs, out.TLS.GetCA(), out.TLS.GetCert(), out.TLS.GetKey())
+	}
 
-		if out.TLS.Cert != nil {
-			refs = appendIfSecretRef(refs, *out.TLS.Cert)
-		}
+	return refs
+}
 
-		if out.TLS.Key != nil {
-			refs = appendIfSecretRef(refs, *out.TLS.Key)
-		}
+func getRefsInKafkaOutput(kafkaOut *KafkaOutput) []SecretKeyRef {
+	var refs []SecretKeyRef
+
+	if kafkaOut.Authentication != nil && kafkaOut.Authentication.SASL.IsDefined() {
+		refs = appendIfSecretRef(refs, kafkaOut.Authentication.SASL.User)
+		refs = appendIfSecretRef(refs, kafkaOut.Authentication.SASL.Password)
+	}
+
+	if kafkaOut.TLS != nil && !kafkaOut.TLS.Disabled {
+		refs = appendTLSSecretRefs(refs, kafkaOut.TLS.GetCA(), kafkaOut.TLS.GetCert(), kafkaOut.TLS.GetKey())
 	}
 
 	return refs
 }
 
+func appendTLSSecretRefs(secretKeyRefs []SecretKeyRef, values ...*ValueType) []SecretKeyRef {
+	for _, value := range values {
+		if value != nil {
+			secretKeyRefs = appendIfSecretRef(secretKeyRefs, *value)
+		}
+	}
+
+	return secretKeyRefs
+}
+
 func appendIfSecretRef(secretKeyRefs []SecretKeyRef, valueType ValueType) []SecretKeyRef {
 	if valueType.Value == "" && valueType.ValueFrom != nil && valueType.ValueFrom.IsSecretKeyRef() {
 		secretKeyRefs = append(secretKeyRefs, *valueType.ValueFrom.SecretKeyRef)
@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -61,8 +62,18 @@ const (
 	OTLPProtocolGRPC OTLPProtocol = "grpc"
 )
 
+type OTLPCompression string
+
+const (
+	OTLPCompressionGzip   OTLPCompression = "gzip"
+	OTLPCompressionZstd   OTLPCompression = "zstd"
+	OTLPCompressionSnappy OTLPCompression = "snappy"
+	OTLPCompressionNone   OTLPCompression = "none"
+)
+
 // OTLPOutput OTLP output configuration
 // +kubebuilder:validation:XValidation:rule="((!has(self.path) || size(self.path) <= 0) && (has(self.protocol) && self.protocol == 'grpc')) || (has(self.protocol) && self.protocol == 'http')", message="Path is only available with HTTP protocol"
+// +kubebuilder:validation:XValidation:rule="!has(self.proxy) || !has(self.proxy.url) || (has(self.protocol) && self.protocol == 'http')", message="Proxy URL is only available with HTTP protocol"
 type OTLPOutput struct {
 	// Defines the OTLP protocol (http or grpc). Default is grpc.
 	// +kubebuilder:default:=grpc
@@ -79,11 +90,110 @@ type OTLPOutput struct {
 	Headers []Header `json:"headers,omitempty"`
 	// Defines TLS options for the OTLP output.
 	TLS *OutputTLS `json:"tls,omitempty"`
+	// Defines the compression of the exported data (gzip, zstd, snappy, or none). Default is gzip.
+	// +kubebuilder:validation:Enum=gzip;zstd;snappy;none
+	Compression OTLPCompression `json:"compression,omitempty"`
+	// Defines the timeout of a single export request, for example `10s`. Default is 5s for the grpc protocol and 30s for the http protocol.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s|m|h))+$`
+	Timeout string `json:"timeout,omitempty"`
+	// Defines how failed export requests are retried. By default, retries start after 5s, the interval grows up to 30s, and data is dropped after 300s.
+	Retry *OTLPRetry `json:"retry,omitempty"`
+	// Overrides the cluster-wide proxy settings of the Telemetry resource for this output.
+	Proxy *OutputProxy `json:"proxy,omitempty"`
+}
+
+// OutputProxy overrides the cluster-wide proxy settings of the Telemetry resource for a single output.
+// +kubebuilder:validation:XValidation:rule="!(has(self.url) && has(self.disabled) && self.disabled)", message="Can define either 'url' or 'disabled', but not both"
+type OutputProxy struct {
+	// Defines the URL of the proxy that is used for this output instead of the cluster-wide proxy, for example `http://proxy.example.com:3128`.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url,omitempty"`
+	// Defines whether the output connects to the backend directly, bypassing the cluster-wide proxy.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// OTLPRetry defines the retry behavior of an OTLP output.
+// +kubebuilder:validation:XValidation:rule="!has(self.initialInterval) || !has(self.maxInterval) || duration(self.initialInterval) <= duration(self.maxInterval)", message="'initialInterval' must not be greater than 'maxInterval'"
+type OTLPRetry struct {
+	// Defines whether failed export requests are retried. If disabled, the data of a failed request is dropped immediately. Default is true.
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+	// Defines the time to wait after the first failure before retrying, for example `5s`. Default is 5s.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s|m|h))+$`
+	InitialInterval string `json:"initialInterval,omitempty"`
+	// Defines the upper bound of the time to wait between consecutive retries, for example `30s`. Default is 30s.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s|m|h))+$`
+	MaxInterval string `json:"maxInterval,omitempty"`
+	// Defines the maximum time spent on retrying a request before the data is dropped, for example `5m`. Set to `0s` to retry without a time limit. Default is 300s.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s|m|h))+$`
+	MaxElapsedTime string `json:"maxElapsedTime,omitempty"`
+}
+
+// IsEnabled returns whether failed export requests are retried. Retries are enabled unless explicitly disabled.
+func (r *OTLPRetry) IsEnabled() bool {
+	return r == nil || r.Enabled == nil || *r.Enabled
+}
+
+type KafkaEncoding string
+
+const (
+	KafkaEncodingOTLPProto KafkaEncoding = "otlp_proto"
+	KafkaEncodingOTLPJSON  KafkaEncoding = "otlp_json"
+)
+
+type KafkaSASLMechanism string
+
+const (
+	KafkaSASLMechanismPlain       KafkaSASLMechanism = "PLAIN"
+	KafkaSASLMechanismSCRAMSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	KafkaSASLMechanismSCRAMSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+// KafkaOutput Kafka output configuration
+type KafkaOutput struct {
+	// Defines the Kafka brokers in the format `host:port`.
+	// +kubebuilder:validation:MinItems=1
+	Brokers []string `json:"brokers"`
+	// Defines the Kafka topic to which the data is written.
+	// +kubebuilder:validation:MinLength=1
+	Topic string `json:"topic"`
+	// Defines the encoding of the messages (otlp_proto or otlp_json). Default is otlp_proto. Not supported for LogPipelines, which always write JSON records.
+	// +kubebuilder:validation:Enum=otlp_proto;otlp_json
+	Encoding KafkaEncoding `json:"encoding,omitempty"`
+	// Defines authentication options for the Kafka output.
+	Authentication *KafkaAuthenticationOptions `json:"authentication,omitempty"`
+	// Defines TLS options for the Kafka output. TLS is used unless `disabled` is set.
+	TLS *OutputTLS `json:"tls,omitempty"`
 }
 
+type KafkaAuthenticationOptions struct {
+	// Activates SASL authentication for the Kafka brokers providing relevant Secrets.
+	SASL *KafkaSASLOptions `json:"sasl,omitempty"`
+}
+
+type KafkaSASLOptions struct {
+	// Defines the SASL mechanism (PLAIN, SCRAM-SHA-256, or SCRAM-SHA-512). Default is PLAIN.
+	// +kubebuilder:default:=PLAIN
+	// +kubebuilder:validation:Enum=PLAIN;SCRAM-SHA-256;SCRAM-SHA-512
+	Mechanism KafkaSASLMechanism `json:"mechanism,omitempty"`
+	// Contains the SASL username or a Secret reference.
+	// +kubebuilder:validation:Required
+	User ValueType `json:"user"`
+	// Contains the SASL password or a Secret reference.
+	// +kubebuilder:validation:Required
+	Password ValueType `json:"password"`
+}
+
+func (s *KafkaSASLOptions) IsDefined() bool {
+	return s != nil && s.User.IsDefined() && s.Password.IsDefined()
+}
+
+// +kubebuilder:validation:XValidation:rule="!(has(self.basic) && has(self.oauth2))", message="Only one authentication method can be defined"
 type AuthenticationOptions struct {
 	// Activates `Basic` authentication for the destination providing relevant Secrets.
 	Basic *BasicAuthOptions `json:"basic,omitempty"`
+	// Activates OAuth2 authentication with the client credentials flow for the destination providing relevant Secrets. Not supported for LogPipelines.
+	OAuth2 *OAuth2Options `json:"oauth2,omitempty"`
 }
 
 type BasicAuthOptions struct {
@@ -96,5 +206,53 @@ type BasicAuthOptions struct {
 }
 
 func (b *BasicAuthOptions) IsDefined() bool {
-	return b.User.IsDefined() && b.Password.IsDefined()
+	return b != nil && b.User.IsDefined() && b.Password.IsDefined()
+}
+
+type OAuth2Options struct {
+	// Contains the URL of the token endpoint or a Secret reference.
+	// +kubebuilder:validation:Required
+	TokenURL ValueType `json:"tokenURL"`
+	// Contains the client ID or a Secret reference.
+	// +kubebuilder:validation:Required
+	ClientID ValueType `json:"clientID"`
+	// Contains the client secret or a Secret reference.
+	// +kubebuilder:validation:Required
+	ClientSecret ValueType `json:"clientSecret"`
+	// Defines the scopes requested for the access token.
+	Scopes []string `json:"scopes,omitempty"`
+	// Defines the audience requested for the access token.
+	Audience string `json:"audience,omitempty"`
+}
+
+func (o *OAuth2Options) IsDefined() bool {
+	return o != nil && o.TokenURL.IsDefined() && o.ClientID.IsDefined() && o.ClientSecret.IsDefined()
+}
+
+// AnnotationSendTestData requests a pipeline to send a small batch of test data to its output. Each new value of the annotation sends another batch.
+const AnnotationSendTestData = "telemetry.kyma-project.io/send-test-data"
+
+// TestDataStatus describes the last batch of test data that a pipeline sent.
+type TestDataStatus struct {
+	// The value of the `telemetry.kyma-project.io/send-test-data` annotation that the test data was sent for.
+	Request string `json:"request"`
+	// The time when the test data was sent.
+	SentAt metav1.Time `json:"sentAt"`
+}
+
+// PipelineStatistics describes the recent throughput of a pipeline, as recorded by the self monitor.
+// The rates are averaged over the last 5 minutes. They count spans, metric data points, or log records, depending on the type of the pipeline.
+type PipelineStatistics struct {
+	// The number of data items per second that the pipeline sent to the backend.
+	SentPerSecond string `json:"sentPerSecond,omitempty"`
+	// The number of data items per second that the pipeline dropped because they could not be sent to the backend or enqueued for sending.
+	DroppedPerSecond string `json:"droppedPerSecond,omitempty"`
+	// The number of data items per second that the gateway refused to receive. The rate applies to all pipelines of the gateway. Not available for LogPipelines.
+	RefusedPerSecond string `json:"refusedPerSecond,omitempty"`
+	// The number of batches waiting in the export queue of the pipeline. Not available for LogPipelines.
+	Queued *int64 `json:"queued,omitempty"`
+	// The last time the pipeline sent data to the backend successfully.
+	LastExportTime *metav1.Time `json:"lastExportTime,omitempty"`
+	// The time when the statistics were updated.
+	UpdateTime metav1.Time `json:"updateTime"`
 }
@@ -42,20 +42,60 @@ type TracePipeline struct {
 
 // TracePipelineSpec defines the desired state of TracePipeline
 type TracePipelineSpec struct {
+	// Configures the collection of spans that are pushed to the trace gateway.
+	// +optional
+	Input TracePipelineInput `json:"input,omitempty"`
 	// Defines a destination for shipping trace data. Only one can be defined per pipeline.
 	Output TracePipelineOutput `json:"output"`
+
+	// Priority of the pipeline if more TracePipelines exist than the maximum number of pipelines allows. A pipeline with a higher priority takes the place of the pipeline with the lowest priority, which gets the `MaxPipelinesExceeded` condition. Among pipelines with the same priority, the earlier pipelines keep their place. The default is 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// TracePipelineInput defines the collection of spans that are pushed to the trace gateway.
+type TracePipelineInput struct {
+	// Configures the collection of spans that are pushed with the OpenTelemetry protocol.
+	// +optional
+	OTLP *TracePipelineOTLPInput `json:"otlp,omitempty"`
+}
+
+// TracePipelineOTLPInput defines the collection of spans that are pushed with the OpenTelemetry protocol.
+type TracePipelineOTLPInput struct {
+	// Describes whether spans from specific namespaces are selected. By default, spans from all namespaces are selected.
+	// +optional
+	Namespaces *TracePipelineInputNamespaceSelector `json:"namespaces,omitempty"`
+}
+
+// TracePipelineInputNamespaceSelector describes whether spans from specific namespaces are selected.
+// +kubebuilder:validation:XValidation:rule="!((has(self.include) && size(self.include) != 0) && (has(self.exclude) && size(self.exclude) != 0))", message="Can only define one namespace selector - either 'include' or 'exclude'"
+type TracePipelineInputNamespaceSelector struct {
+	// Include spans from the specified Namespace names only.
+	Include []string `json:"include,omitempty"`
+	// Exclude spans from the specified Namespace names.
+	Exclude []string `json:"exclude,omitempty"`
 }
 
 // TracePipelineOutput defines the output configuration section.
+// +kubebuilder:validation:XValidation:rule="has(self.otlp) != has(self.kafka)", message="Exactly one output must be defined"
 type TracePipelineOutput struct {
 	// Configures the underlying OTel Collector with an [OTLP exporter](https://github.com/open-telemetry/opentelemetry-collector/blob/main/exporter/otlpexporter/README.md). If you switch `protocol`to `http`, an [OTLP HTTP exporter](https://github.com/open-telemetry/opentelemetry-collector/tree/main/exporter/otlphttpexporter) is used.
-	OTLP *OTLPOutput `json:"otlp"`
+	OTLP *OTLPOutput `json:"otlp,omitempty"`
+	// Configures the underlying OTel Collector with a [Kafka exporter](https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/kafkaexporter).
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
 }
 
 // Defines the observed state of TracePipeline.
 type TracePipelineStatus struct {
 	// An array of conditions describing the status of the pipeline.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Is active when the OTel Collector configuration of the pipeline is patched with the `telemetry-override-config` ConfigMap; see [patching the collector configuration](https://github.com/kyma-project/telemetry-manager/blob/main/docs/contributor/troubleshooting.md#patching-the-collector-configuration).
+	UnsupportedMode *bool `json:"unsupportedMode,omitempty"`
+	// The last batch of test data that the pipeline sent; see the `telemetry.kyma-project.io/send-test-data` annotation.
+	TestData *TestDataStatus `json:"testData,omitempty"`
+	// The recent throughput of the pipeline, updated about once a minute.
+	Statistics *PipelineStatistics `json:"statistics,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -33,6 +33,11 @@ func (in *AuthenticationOptions) DeepCopyInto(out *AuthenticationOptions) {
 		*out = new(BasicAuthOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2Options)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationOptions.
@@ -93,6 +98,73 @@ func (in *Header) DeepCopy() *Header {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaAuthenticationOptions) DeepCopyInto(out *KafkaAuthenticationOptions) {
+	*out = *in
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASLOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaAuthenticationOptions.
+func (in *KafkaAuthenticationOptions) DeepCopy() *KafkaAuthenticationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaAuthenticationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaOutput) DeepCopyInto(out *KafkaOutput) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(KafkaAuthenticationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OutputTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaOutput.
+func (in *KafkaOutput) DeepCopy() *KafkaOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLOptions) DeepCopyInto(out *KafkaSASLOptions) {
+	*out = *in
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLOptions.
+func (in *KafkaSASLOptions) DeepCopy() *KafkaSASLOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipeline) DeepCopyInto(out *LogPipeline) {
 	*out = *in
@@ -120,6 +192,30 @@ func (in *LogPipeline) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineElasticsearchOutput) DeepCopyInto(out *LogPipelineElasticsearchOutput) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineElasticsearchOutput.
+func (in *LogPipelineElasticsearchOutput) DeepCopy() *LogPipelineElasticsearchOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineElasticsearchOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineFileMount) DeepCopyInto(out *LogPipelineFileMount) {
 	*out = *in
@@ -150,6 +246,23 @@ func (in *LogPipelineFilter) DeepCopy() *LogPipelineFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineGELFOutput) DeepCopyInto(out *LogPipelineGELFOutput) {
+	*out = *in
+	in.Host.DeepCopyInto(&out.Host)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineGELFOutput.
+func (in *LogPipelineGELFOutput) DeepCopy() *LogPipelineGELFOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineGELFOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineHTTPOutput) DeepCopyInto(out *LogPipelineHTTPOutput) {
 	*out = *in
@@ -157,6 +270,11 @@ func (in *LogPipelineHTTPOutput) DeepCopyInto(out *LogPipelineHTTPOutput) {
 	in.User.DeepCopyInto(&out.User)
 	in.Password.DeepCopyInto(&out.Password)
 	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(OutputProxy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineHTTPOutput.
@@ -267,6 +385,46 @@ func (in *LogPipelineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineLokiLabel) DeepCopyInto(out *LogPipelineLokiLabel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineLokiLabel.
+func (in *LogPipelineLokiLabel) DeepCopy() *LogPipelineLokiLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineLokiLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineLokiOutput) DeepCopyInto(out *LogPipelineLokiOutput) {
+	*out = *in
+	in.URL.DeepCopyInto(&out.URL)
+	in.TenantID.DeepCopyInto(&out.TenantID)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]LogPipelineLokiLabel, len(*in))
+		copy(*out, *in)
+	}
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineLokiOutput.
+func (in *LogPipelineLokiOutput) DeepCopy() *LogPipelineLokiOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineLokiOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineOutput) DeepCopyInto(out *LogPipelineOutput) {
 	*out = *in
@@ -275,6 +433,31 @@ func (in *LogPipelineOutput) DeepCopyInto(out *LogPipelineOutput) {
 		*out = new(LogPipelineHTTPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LogPipelineLokiOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(LogPipelineElasticsearchOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(LogPipelineSyslogOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GELF != nil {
+		in, out := &in.GELF, &out.GELF
+		*out = new(LogPipelineGELFOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OTLP != nil {
 		in, out := &in.OTLP, &out.OTLP
 		*out = new(OTLPOutput)
@@ -292,6 +475,41 @@ func (in *LogPipelineOutput) DeepCopy() *LogPipelineOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineOutputTLS) DeepCopyInto(out *LogPipelineOutputTLS) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ValueType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TLSSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineOutputTLS.
+func (in *LogPipelineOutputTLS) DeepCopy() *LogPipelineOutputTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineOutputTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineRuntimeInput) DeepCopyInto(out *LogPipelineRuntimeInput) {
 	*out = *in
@@ -307,6 +525,11 @@ func (in *LogPipelineRuntimeInput) DeepCopyInto(out *LogPipelineRuntimeInput) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Throttle != nil {
+		in, out := &in.Throttle, &out.Throttle
+		*out = new(LogPipelineThrottle)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineRuntimeInput.
@@ -368,6 +591,16 @@ func (in *LogPipelineStatus) DeepCopyInto(out *LogPipelineStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TestData != nil {
+		in, out := &in.TestData, &out.TestData
+		*out = new(TestDataStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(PipelineStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineStatus.
@@ -380,6 +613,38 @@ func (in *LogPipelineStatus) DeepCopy() *LogPipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineSyslogOutput) DeepCopyInto(out *LogPipelineSyslogOutput) {
+	*out = *in
+	in.Host.DeepCopyInto(&out.Host)
+	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineSyslogOutput.
+func (in *LogPipelineSyslogOutput) DeepCopy() *LogPipelineSyslogOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineSyslogOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineThrottle) DeepCopyInto(out *LogPipelineThrottle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineThrottle.
+func (in *LogPipelineThrottle) DeepCopy() *LogPipelineThrottle {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineThrottle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineVariableRef) DeepCopyInto(out *LogPipelineVariableRef) {
 	*out = *in
@@ -568,6 +833,11 @@ func (in *MetricPipelineOutput) DeepCopyInto(out *MetricPipelineOutput) {
 		*out = new(OTLPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineOutput.
@@ -732,6 +1002,21 @@ func (in *MetricPipelineStatus) DeepCopyInto(out *MetricPipelineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnsupportedMode != nil {
+		in, out := &in.UnsupportedMode, &out.UnsupportedMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TestData != nil {
+		in, out := &in.TestData, &out.TestData
+		*out = new(TestDataStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(PipelineStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineStatus.
@@ -744,6 +1029,29 @@ func (in *MetricPipelineStatus) DeepCopy() *MetricPipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2Options) DeepCopyInto(out *OAuth2Options) {
+	*out = *in
+	in.TokenURL.DeepCopyInto(&out.TokenURL)
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2Options.
+func (in *OAuth2Options) DeepCopy() *OAuth2Options {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2Options)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTLPOutput) DeepCopyInto(out *OTLPOutput) {
 	*out = *in
@@ -765,6 +1073,16 @@ func (in *OTLPOutput) DeepCopyInto(out *OTLPOutput) {
 		*out = new(OutputTLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(OTLPRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(OutputProxy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPOutput.
@@ -777,6 +1095,41 @@ func (in *OTLPOutput) DeepCopy() *OTLPOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPRetry) DeepCopyInto(out *OTLPRetry) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPRetry.
+func (in *OTLPRetry) DeepCopy() *OTLPRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputProxy) DeepCopyInto(out *OutputProxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputProxy.
+func (in *OutputProxy) DeepCopy() *OutputProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutputTLS) DeepCopyInto(out *OutputTLS) {
 	*out = *in
@@ -795,6 +1148,16 @@ func (in *OutputTLS) DeepCopyInto(out *OutputTLS) {
 		*out = new(ValueType)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(TLSSecretRef)
+		**out = **in
+	}
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputTLS.
@@ -807,6 +1170,31 @@ func (in *OutputTLS) DeepCopy() *OutputTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineStatistics) DeepCopyInto(out *PipelineStatistics) {
+	*out = *in
+	if in.Queued != nil {
+		in, out := &in.Queued, &out.Queued
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastExportTime != nil {
+		in, out := &in.LastExportTime, &out.LastExportTime
+		*out = (*in).DeepCopy()
+	}
+	in.UpdateTime.DeepCopyInto(&out.UpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineStatistics.
+func (in *PipelineStatistics) DeepCopy() *PipelineStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
 	*out = *in
@@ -822,6 +1210,37 @@ func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSecretRef) DeepCopyInto(out *TLSSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecretRef.
+func (in *TLSSecretRef) DeepCopy() *TLSSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestDataStatus) DeepCopyInto(out *TestDataStatus) {
+	*out = *in
+	in.SentAt.DeepCopyInto(&out.SentAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestDataStatus.
+func (in *TestDataStatus) DeepCopy() *TestDataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TestDataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipeline) DeepCopyInto(out *TracePipeline) {
 	*out = *in
@@ -849,6 +1268,51 @@ func (in *TracePipeline) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineInput) DeepCopyInto(out *TracePipelineInput) {
+	*out = *in
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(TracePipelineOTLPInput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineInput.
+func (in *TracePipelineInput) DeepCopy() *TracePipelineInput {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineInputNamespaceSelector) DeepCopyInto(out *TracePipelineInputNamespaceSelector) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineInputNamespaceSelector.
+func (in *TracePipelineInputNamespaceSelector) DeepCopy() *TracePipelineInputNamespaceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineInputNamespaceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineList) DeepCopyInto(out *TracePipelineList) {
 	*out = *in
@@ -881,6 +1345,26 @@ func (in *TracePipelineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineOTLPInput) DeepCopyInto(out *TracePipelineOTLPInput) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(TracePipelineInputNamespaceSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineOTLPInput.
+func (in *TracePipelineOTLPInput) DeepCopy() *TracePipelineOTLPInput {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineOTLPInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineOutput) DeepCopyInto(out *TracePipelineOutput) {
 	*out = *in
@@ -889,6 +1373,11 @@ func (in *TracePipelineOutput) DeepCopyInto(out *TracePipelineOutput) {
 		*out = new(OTLPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineOutput.
@@ -904,6 +1393,7 @@ func (in *TracePipelineOutput) DeepCopy() *TracePipelineOutput {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineSpec) DeepCopyInto(out *TracePipelineSpec) {
 	*out = *in
+	in.Input.DeepCopyInto(&out.Input)
 	in.Output.DeepCopyInto(&out.Output)
 }
 
@@ -927,6 +1417,21 @@ func (in *TracePipelineStatus) DeepCopyInto(out *TracePipelineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnsupportedMode != nil {
+		in, out := &in.UnsupportedMode, &out.UnsupportedMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TestData != nil {
+		in, out := &in.TestData, &out.TestData
+		*out = new(TestDataStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(PipelineStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineStatus.
@@ -93,6 +93,10 @@ func (r *TelemetryController) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(
 			&telemetryv1alpha1.MetricPipeline{},
 			handler.EnqueueRequestsFromMapFunc(r.mapMetricPipeline),
+			ctrlbuilder.WithPredicates(predicate.CreateOrUpdateOrDelete())).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.mapOverridesConfigMap),
 			ctrlbuilder.WithPredicates(predicate.CreateOrUpdateOrDelete()))
 
 	return b.Complete(r)
@@ -154,6 +158,15 @@ func (r *TelemetryController) mapMetricPipeline(ctx context.Context, object clie
 	return r.createTelemetryRequests(ctx)
 }
 
+// mapOverridesConfigMap reconciles the Telemetry CRs if the overrides change, so that their OverridesApplied condition is updated
+func (r *TelemetryController) mapOverridesConfigMap(ctx context.Context, object client.Object) []reconcile.Request {
+	if object.GetName() != overrides.ConfigMapName || object.GetNamespace() != r.config.TelemetryNamespace {
+		return nil
+	}
+
+	return r.createTelemetryRequests(ctx)
+}
+
 func (r *TelemetryController) createTelemetryRequests(ctx context.Context) []reconcile.Request {
 	var telemetries operatorv1alpha1.TelemetryList
 
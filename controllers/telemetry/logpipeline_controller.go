@@ -18,30 +18,39 @@ limitations under the License.
 
 import (
 	"context"
+	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/fluentbit/config/builder"
 	"github.com/kyma-project/telemetry-manager/internal/istiostatus"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/pipelinelimits"
 	"github.com/kyma-project/telemetry-manager/internal/predicate"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline"
 	logpipelinefluentbit "github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/fluentbit"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/otel"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/namespacedpipeline"
+	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/fluentbit"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
@@ -66,6 +75,7 @@ type LogPipelineController struct {
 }
 
 type LogPipelineControllerConfig struct {
+	EventRecorder      record.EventRecorder
 	ExporterImage      string
 	FluentBitImage     string
 	PriorityClassName  string
@@ -88,6 +98,7 @@ func NewLogPipelineController(client client.Client, reconcileTriggerChan <-chan
 		EnvSecret:             types.NamespacedName{Name: "telemetry-fluent-bit-env", Namespace: config.TelemetryNamespace},
 		OutputTLSConfigSecret: types.NamespacedName{Name: "telemetry-fluent-bit-output-tls-config", Namespace: config.TelemetryNamespace},
 		DaemonSet:             types.NamespacedName{Name: "telemetry-fluent-bit", Namespace: config.TelemetryNamespace},
+		EventRecorder:         config.EventRecorder,
 		PipelineDefaults: builder.PipelineDefaults{
 			InputTag:          "tele",
 			MemoryBufferLimit: "10M",
@@ -105,10 +116,30 @@ func NewLogPipelineController(client client.Client, reconcileTriggerChan <-chan
 		},
 	}
 
+	pipelineLock := resourcelock.New(
+		client,
+		types.NamespacedName{
+			Name:      "telemetry-logpipeline-lock",
+			Namespace: config.TelemetryNamespace,
+		},
+		pipelinelimits.DefaultMaxLogPipelines,
+		resourcelock.WithMaxOwnersFunc(func(ctx context.Context) int {
+			return pipelinelimits.MaxLogPipelines(ctx, client)
+		}),
+		resourcelock.WithPriorityFunc(func(owner metav1.Object) int32 {
+			if pipeline, ok := owner.(*telemetryv1alpha1.LogPipeline); ok {
+				return namespacedpipeline.PipelinePriority(pipeline, pipeline.Spec.Priority)
+			}
+
+			return 0
+		}),
+	)
+
 	pipelineValidator := &logpipelinefluentbit.Validator{
 		EndpointValidator:  &endpoint.Validator{Client: client},
 		TLSCertValidator:   tlscert.New(client),
 		SecretRefValidator: &secretref.Validator{Client: client},
+		PipelineLock:       pipelineLock,
 	}
 
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config.RestConfig)
@@ -116,7 +147,7 @@ func NewLogPipelineController(client client.Client, reconcileTriggerChan <-chan
 		return nil, err
 	}
 
-	fbReconciler := logpipelinefluentbit.New(client, fluentbitConfig, &workloadstatus.DaemonSetProber{Client: client}, flowHealthProber, istiostatus.NewChecker(discoveryClient), pipelineValidator, &conditions.ErrorToMessageConverter{})
+	fbReconciler := logpipelinefluentbit.New(client, fluentbitConfig, &workloadstatus.DaemonSetProber{Client: client}, flowHealthProber, istiostatus.NewChecker(discoveryClient), pipelineLock, pipelineValidator, &conditions.ErrorToMessageConverter{})
 	otelReconciler := otel.New(client, &conditions.ErrorToMessageConverter{})
 
 	reconciler := logpipeline.New(
@@ -166,5 +197,42 @@ func (r *LogPipelineController) SetupWithManager(mgr ctrl.Manager) error {
 		)
 	}
 
-	return b.Complete(r)
+	return b.Watches(
+		&operatorv1alpha1.Telemetry{},
+		handler.EnqueueRequestsFromMapFunc(r.mapTelemetryChanges),
+		ctrlbuilder.WithPredicates(predicate.CreateOrUpdateOrDelete()),
+	).Complete(r)
+}
+
+func (r *LogPipelineController) mapTelemetryChanges(ctx context.Context, object client.Object) []reconcile.Request {
+	_, ok := object.(*operatorv1alpha1.Telemetry)
+	if !ok {
+		logf.FromContext(ctx).V(1).Error(nil, "Unexpected type: expected Telemetry")
+		return nil
+	}
+
+	requests, err := r.createRequestsForAllPipelines(ctx)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Unable to create reconcile requests")
+	}
+
+	return requests
+}
+
+func (r *LogPipelineController) createRequestsForAllPipelines(ctx context.Context) ([]reconcile.Request, error) {
+	var pipelines telemetryv1alpha1.LogPipelineList
+
+	var requests []reconcile.Request
+
+	err := r.List(ctx, &pipelines)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LogPipelines: %w", err)
+	}
+
+	for i := range pipelines.Items {
+		var pipeline = pipelines.Items[i]
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+	}
+
+	return requests, nil
 }
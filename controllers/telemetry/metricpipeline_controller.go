@@ -25,9 +25,11 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,6 +46,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric/agent"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric/gateway"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/pipelinelimits"
 	"github.com/kyma-project/telemetry-manager/internal/predicate"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/metricpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
@@ -56,7 +59,6 @@ import (
 )
 
 const (
-	maxMetricPipelines    = 3
 	metricGatewayBaseName = "telemetry-metric-gateway"
 	metricAgentBaseName   = "telemetry-metric-agent"
 )
@@ -86,6 +88,7 @@ type MetricPipelineController struct {
 }
 
 type MetricPipelineControllerConfig struct {
+	EventRecorder                  record.EventRecorder
 	MetricAgentPriorityClassName   string
 	MetricGatewayPriorityClassName string
 	MetricGatewayServiceName       string
@@ -108,7 +111,17 @@ func NewMetricPipelineController(client client.Client, reconcileTriggerChan <-ch
 			Name:      "telemetry-metricpipeline-lock",
 			Namespace: config.TelemetryNamespace,
 		},
-		maxMetricPipelines,
+		pipelinelimits.DefaultMaxMetricPipelines,
+		resourcelock.WithMaxOwnersFunc(func(ctx context.Context) int {
+			return pipelinelimits.MaxMetricPipelines(ctx, client)
+		}),
+		resourcelock.WithPriorityFunc(func(owner metav1.Object) int32 {
+			if pipeline, ok := owner.(*telemetryv1alpha1.MetricPipeline); ok {
+				return pipeline.Spec.Priority
+			}
+
+			return 0
+		}),
 	)
 
 	pipelineValidator := &metricpipeline.Validator{
@@ -127,7 +140,9 @@ func NewMetricPipelineController(client client.Client, reconcileTriggerChan <-ch
 		AgentName:          metricAgentBaseName,
 		GatewayName:        metricGatewayBaseName,
 		ModuleVersion:      config.ModuleVersion,
+		OTLPServiceName:    config.MetricGatewayServiceName,
 		TelemetryNamespace: config.TelemetryNamespace,
+		EventRecorder:      config.EventRecorder,
 	}
 	reconciler := metricpipeline.New(
 		client,
@@ -136,6 +151,7 @@ func NewMetricPipelineController(client client.Client, reconcileTriggerChan <-ch
 		&agent.Builder{
 			Config: agent.BuilderConfig{
 				GatewayOTLPServiceName: types.NamespacedName{Namespace: config.TelemetryNamespace, Name: config.MetricGatewayServiceName},
+				SelfMonitorServiceName: types.NamespacedName{Namespace: config.TelemetryNamespace, Name: config.SelfMonitorName},
 			},
 		},
 		&workloadstatus.DaemonSetProber{Client: client},
@@ -0,0 +1,146 @@
+package telemetry
+
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/predicate"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/namespacedpipeline"
+)
+
+// NamespacedPipelineController reconciles the namespaced pipelines of one kind, such as NamespacedTracePipelines
+type NamespacedPipelineController struct {
+	client.Client
+
+	reconciler         *namespacedpipeline.Reconciler
+	namespacedPipeline client.Object
+	newList            func() client.ObjectList
+	pipeline           client.Object
+}
+
+func NewNamespacedTracePipelineController(c client.Client) *NamespacedPipelineController {
+	return &NamespacedPipelineController{
+		Client:             c,
+		reconciler:         namespacedpipeline.NewTraceReconciler(c),
+		namespacedPipeline: &telemetryv1alpha1.NamespacedTracePipeline{},
+		newList:            func() client.ObjectList { return &telemetryv1alpha1.NamespacedTracePipelineList{} },
+		pipeline:           &telemetryv1alpha1.TracePipeline{},
+	}
+}
+
+func NewNamespacedLogPipelineController(c client.Client) *NamespacedPipelineController {
+	return &NamespacedPipelineController{
+		Client:             c,
+		reconciler:         namespacedpipeline.NewLogReconciler(c),
+		namespacedPipeline: &telemetryv1alpha1.NamespacedLogPipeline{},
+		newList:            func() client.ObjectList { return &telemetryv1alpha1.NamespacedLogPipelineList{} },
+		pipeline:           &telemetryv1alpha1.LogPipeline{},
+	}
+}
+
+func (r *NamespacedPipelineController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconciler.Reconcile(ctx, req)
+}
+
+func (r *NamespacedPipelineController) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.namespacedPipeline).
+		// The pipelines of a namespace share the maximum number of pipelines per namespace, so a deleted pipeline can make room for another one
+		Watches(
+			r.namespacedPipeline,
+			handler.EnqueueRequestsFromMapFunc(r.mapNamespacedPipelineChanges),
+			ctrlbuilder.WithPredicates(predicate.CreateOrDelete()),
+		).
+		Watches(
+			r.pipeline,
+			handler.EnqueueRequestsFromMapFunc(r.mapPipelineChanges),
+		).
+		Watches(
+			&operatorv1alpha1.Telemetry{},
+			handler.EnqueueRequestsFromMapFunc(r.mapTelemetryChanges),
+			ctrlbuilder.WithPredicates(predicate.CreateOrUpdateOrDelete()),
+		).
+		Complete(r)
+}
+
+func (r *NamespacedPipelineController) mapNamespacedPipelineChanges(ctx context.Context, object client.Object) []reconcile.Request {
+	requests, err := r.createRequestsForPipelines(ctx, client.InNamespace(object.GetNamespace()))
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Unable to create reconcile requests")
+	}
+
+	return requests
+}
+
+func (r *NamespacedPipelineController) mapPipelineChanges(ctx context.Context, object client.Object) []reconcile.Request {
+	namespacedName, found := namespacedpipeline.NamespacedPipeline(object)
+	if !found {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: namespacedName}}
+}
+
+func (r *NamespacedPipelineController) mapTelemetryChanges(ctx context.Context, object client.Object) []reconcile.Request {
+	_, ok := object.(*operatorv1alpha1.Telemetry)
+	if !ok {
+		logf.FromContext(ctx).V(1).Error(nil, "Unexpected type: expected Telemetry")
+		return nil
+	}
+
+	requests, err := r.createRequestsForPipelines(ctx)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Unable to create reconcile requests")
+	}
+
+	return requests
+}
+
+func (r *NamespacedPipelineController) createRequestsForPipelines(ctx context.Context, opts ...client.ListOption) ([]reconcile.Request, error) {
+	list := r.newList()
+	if err := r.List(ctx, list, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list namespaced pipelines: %w", err)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract namespaced pipelines: %w", err)
+	}
+
+	var requests []reconcile.Request
+
+	for _, item := range items {
+		if pipeline, ok := item.(client.Object); ok {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: pipeline.GetNamespace(), Name: pipeline.GetName()}})
+		}
+	}
+
+	return requests, nil
+}
@@ -25,9 +25,11 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,7 +45,9 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/istiostatus"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/trace/gateway"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/pipelinelimits"
 	"github.com/kyma-project/telemetry-manager/internal/predicate"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/namespacedpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/tracepipeline"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
@@ -55,7 +59,6 @@ import (
 )
 
 const (
-	maxTracePipelines    = 3
 	traceGatewayBaseName = "telemetry-trace-gateway"
 )
 
@@ -78,6 +81,7 @@ type TracePipelineController struct {
 }
 
 type TracePipelineControllerConfig struct {
+	EventRecorder                 record.EventRecorder
 	RestConfig                    *rest.Config
 	SelfMonitorName               string
 	TelemetryNamespace            string
@@ -98,7 +102,17 @@ func NewTracePipelineController(client client.Client, reconcileTriggerChan <-cha
 			Name:      "telemetry-tracepipeline-lock",
 			Namespace: config.TelemetryNamespace,
 		},
-		maxTracePipelines,
+		pipelinelimits.DefaultMaxTracePipelines,
+		resourcelock.WithMaxOwnersFunc(func(ctx context.Context) int {
+			return pipelinelimits.MaxTracePipelines(ctx, client)
+		}),
+		resourcelock.WithPriorityFunc(func(owner metav1.Object) int32 {
+			if pipeline, ok := owner.(*telemetryv1alpha1.TracePipeline); ok {
+				return namespacedpipeline.PipelinePriority(pipeline, pipeline.Spec.Priority)
+			}
+
+			return 0
+		}),
 	)
 
 	pipelineValidator := &tracepipeline.Validator{
@@ -115,7 +129,9 @@ func NewTracePipelineController(client client.Client, reconcileTriggerChan <-cha
 
 	reconcilerConfig := tracepipeline.Config{
 		TraceGatewayName:   traceGatewayBaseName,
+		OTLPServiceName:    config.TraceGatewayServiceName,
 		TelemetryNamespace: config.TelemetryNamespace,
+		EventRecorder:      config.EventRecorder,
 	}
 	reconciler := tracepipeline.New(
 		client,
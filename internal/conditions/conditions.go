@@ -9,6 +9,9 @@ const (
 	TypeGatewayHealthy          = "GatewayHealthy"
 	TypeLogComponentsHealthy    = "LogComponentsHealthy"
 	TypeMetricComponentsHealthy = "MetricComponentsHealthy"
+	TypeOutputReachable         = "OutputReachable"
+	TypeOverridesApplied        = "OverridesApplied"
+	TypeTestDataDelivered       = "TestDataDelivered"
 	TypeTraceComponentsHealthy  = "TraceComponentsHealthy"
 )
 
@@ -26,6 +29,7 @@ const (
 	ReasonSelfMonBufferFillingUp      = "BufferFillingUp"
 	ReasonSelfMonFlowHealthy          = "FlowHealthy"
 	ReasonSelfMonGatewayThrottling    = "GatewayThrottling"
+	ReasonSelfMonOAuth2TokenFailed    = "OAuth2TokenFetchFailed"
 	ReasonSelfMonProbingFailed        = "ProbingFailed"
 	ReasonSelfMonSomeDataDropped      = "SomeTelemetryDataDropped"
 	ReasonSelfMonConfigNotGenerated   = "ConfigurationNotGenerated"
@@ -35,17 +39,53 @@ const (
 	ReasonValidationFailed            = "ValidationFailed"
 	ReasonRolloutInProgress           = "RolloutInProgress"
 
+	// Output reachability reasons
+	ReasonConnectionFailed        = "ConnectionFailed"
+	ReasonConnectionRefused       = "ConnectionRefused"
+	ReasonDNSResolutionFailed     = "DNSResolutionFailed"
+	ReasonEndpointReachable       = "EndpointReachable"
+	ReasonOutputNotProbed         = "OutputNotProbed"
+	ReasonOutputProbeNotSupported = "OutputProbeNotSupported"
+	ReasonOutputProbingFailed     = "OutputProbingFailed"
+	ReasonProxyConnectionFailed   = "ProxyConnectionFailed"
+	ReasonTLSHandshakeFailed      = "TLSHandshakeFailed"
+	ReasonTLSVerificationFailed   = "TLSVerificationFailed"
+
+	// Test data reasons
+	ReasonTestDataExported     = "TestDataExported"
+	ReasonTestDataExportFailed = "TestDataExportFailed"
+	ReasonTestDataNotConfirmed = "TestDataNotConfirmed"
+	ReasonTestDataNotSent      = "TestDataNotSent"
+	ReasonTestDataPending      = "TestDataPending"
+	ReasonTestDataRollingOut   = "TestDataRollingOut"
+	ReasonTestDataSendFailed   = "TestDataSendFailed"
+
 	// Telemetry reasons
 	ReasonComponentsRunning      = "ComponentsRunning"
 	ReasonNoPipelineDeployed     = "NoPipelineDeployed"
 	ReasonResourceBlocksDeletion = "ResourceBlocksDeletion"
 
+	// Overrides reasons
+	ReasonNoOverrides               = "NoOverrides"
+	ReasonOverridesActive           = "OverridesActive"
+	ReasonOverridesInvalid          = "OverridesInvalid"
+	ReasonOverridesPartiallyApplied = "OverridesPartiallyApplied"
+
 	// LogPipeline reasons
 	ReasonAgentConfigured        = "AgentConfigured"
 	ReasonSelfMonNoLogsDelivered = "NoLogsDelivered"
+	ReasonSelfMonAgentThrottling = "AgentThrottling"
 
 	// MetricPipeline reasons
-	ReasonMetricAgentNotRequired = "AgentNotRequired"
+	ReasonMetricAgentNotRequired    = "AgentNotRequired"
+	ReasonSelfMonAgentExportFailing = "AgentExportFailing"
+	ReasonSelfMonScrapeTargetsDown  = "ScrapeTargetsDown"
+
+	// Namespaced pipeline reasons
+	ReasonMaxPipelinesPerNamespaceExceeded = "MaxPipelinesPerNamespaceExceeded"
+	ReasonPipelineNameConflict             = "PipelineNameConflict"
+	ReasonPipelineNotCreated               = "PipelineNotCreated"
+	ReasonSecretNamespaceNotAllowed        = "SecretNamespaceNotAllowed"
 )
 
 // Error messages
@@ -57,10 +97,27 @@ const (
 )
 
 var commonMessages = map[string]string{
+	ReasonConnectionFailed:        "Output endpoint cannot be connected: %s",
+	ReasonConnectionRefused:       "Output endpoint refused the connection: %s",
+	ReasonDNSResolutionFailed:     "Host name of the output endpoint cannot be resolved: %s",
+	ReasonEndpointReachable:       "Output endpoint is reachable",
 	ReasonNoPipelineDeployed:      "No pipelines have been deployed",
+	ReasonOutputNotProbed:         "Output reachability is not probed because the pipeline configuration is not generated. Check the 'ConfigurationGenerated' condition for more details",
+	ReasonOutputProbeNotSupported: "Output reachability cannot be probed: %s",
+	ReasonOutputProbingFailed:     "Could not probe the reachability of the output: %s",
+	ReasonProxyConnectionFailed:   "Output endpoint cannot be connected through the proxy: %s",
 	ReasonSelfMonFlowHealthy:      "No problems detected in the telemetry flow",
 	ReasonSelfMonProbingFailed:    "Could not determine the health of the telemetry flow because the self monitor probing failed",
+	ReasonTestDataExported:        "Test data was sent and the pipeline exported data to the backend",
+	ReasonTestDataExportFailed:    "Test data was sent but the pipeline failed to export data to the backend. Check the 'TelemetryFlowHealthy' condition for more details",
+	ReasonTestDataNotConfirmed:    "Test data was sent but no export to the backend was recorded within %s",
+	ReasonTestDataNotSent:         "Test data is not sent because the pipeline configuration is not generated. Check the 'ConfigurationGenerated' condition for more details",
+	ReasonTestDataPending:         "Test data was sent, waiting for the export result",
+	ReasonTestDataRollingOut:      "Test data is sent once the pipeline configuration with the test data output is rolled out",
+	ReasonTestDataSendFailed:      "Test data cannot be sent: %s",
 	ReasonTLSConfigurationInvalid: "TLS configuration invalid: %s",
+	ReasonTLSHandshakeFailed:      "TLS handshake with the output endpoint failed: %s",
+	ReasonTLSVerificationFailed:   "Certificate of the output endpoint cannot be verified: %s",
 	ReasonValidationFailed:        "Pipeline validation failed due to an error from the Kubernetes API server",
 }
 
@@ -70,6 +127,7 @@ var logPipelineMessages = map[string]string{
 	ReasonAgentReady:                "Fluent Bit agent DaemonSet is ready",
 	ReasonComponentsRunning:         "All log components are running",
 	ReasonEndpointInvalid:           "HTTP output host invalid: %s",
+	ReasonSelfMonAgentThrottling:    "Incoming log rate of some Namespaces or containers exceeds the configured throttle limit. Logs above the limit are dropped",
 	ReasonSelfMonAllDataDropped:     "Backend is not reachable or rejecting logs. All logs are dropped. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/02-logs?id=no-logs-arrive-at-the-backend",
 	ReasonSelfMonBufferFillingUp:    "Buffer nearing capacity. Incoming log rate exceeds export rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/02-logs?id=agent-buffer-filling-up",
 	ReasonSelfMonConfigNotGenerated: "No logs delivered to backend because LogPipeline specification is not applied to the configuration of Fluent Bit agent. Check the 'ConfigurationGenerated' condition for more details",
@@ -87,6 +145,7 @@ var tracePipelineMessages = map[string]string{
 	ReasonSelfMonBufferFillingUp:    "Buffer nearing capacity. Incoming span rate exceeds export rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=gateway-buffer-filling-up",
 	ReasonSelfMonConfigNotGenerated: "No spans delivered to backend because TracePipeline specification is not applied to the configuration of Trace gateway. Check the 'ConfigurationGenerated' condition for more details",
 	ReasonSelfMonGatewayThrottling:  "Trace gateway is unable to receive spans at current rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=gateway-throttling",
+	ReasonSelfMonOAuth2TokenFailed:  "Spans are dropped because no access token can be fetched from the OAuth2 token endpoint. Check the token URL and the client credentials of the output. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=oauth2-token-cannot-be-fetched",
 	ReasonSelfMonSomeDataDropped:    "Backend is reachable, but rejecting spans. Some spans are dropped. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=not-all-spans-arrive-at-the-backend",
 }
 
@@ -94,6 +153,8 @@ var metricPipelineMessages = map[string]string{
 	ReasonAgentNotReady:             "Metric agent DaemonSet is not ready",
 	ReasonAgentReady:                "Metric agent DaemonSet is ready",
 	ReasonComponentsRunning:         "All metric components are running",
+	ReasonSelfMonAgentExportFailing: "Metric agent is unable to send metrics to the Metric gateway. Metrics of the runtime, prometheus, and istio inputs are dropped. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=agent-export-failing",
+	ReasonSelfMonAgentThrottling:    "Metric agent is unable to process metrics at current rate. Metrics of the runtime, prometheus, and istio inputs are refused. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=agent-throttling",
 	ReasonEndpointInvalid:           "OTLP output endpoint invalid: %s",
 	ReasonGatewayConfigured:         "MetricPipeline specification is successfully applied to the configuration of Metric gateway",
 	ReasonGatewayNotReady:           "Metric gateway Deployment is not ready",
@@ -102,9 +163,25 @@ var metricPipelineMessages = map[string]string{
 	ReasonSelfMonBufferFillingUp:    "Buffer nearing capacity. Incoming metric rate exceeds export rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=gateway-buffer-filling-up",
 	ReasonSelfMonConfigNotGenerated: "No metrics delivered to backend because MetricPipeline specification is not applied to the configuration of Metric gateway. Check the 'ConfigurationGenerated' condition for more details",
 	ReasonSelfMonGatewayThrottling:  "Metric gateway is unable to receive metrics at current rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=gateway-throttling",
+	ReasonSelfMonOAuth2TokenFailed:  "Metrics are dropped because no access token can be fetched from the OAuth2 token endpoint. Check the token URL and the client credentials of the output. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=oauth2-token-cannot-be-fetched",
+	ReasonSelfMonScrapeTargetsDown:  "Prometheus scrape targets are down: %s. Metrics of these targets are missing. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=scrape-targets-down",
 	ReasonSelfMonSomeDataDropped:    "Backend is reachable, but rejecting metrics. Some metrics are dropped. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=metrics-not-arriving-at-the-destination",
 }
 
+var namespacedPipelineMessages = map[string]string{
+	ReasonMaxPipelinesPerNamespaceExceeded: "Maximum number of pipelines in the Namespace exceeded: %d",
+	ReasonPipelineNameConflict:             "Pipeline %s already exists and is not managed by this pipeline",
+	ReasonPipelineNotCreated:               "Pipeline cannot be created: %s",
+	ReasonSecretNamespaceNotAllowed:        "Secret %s must be in the Namespace of the pipeline",
+}
+
+var overridesMessages = map[string]string{
+	ReasonNoOverrides:               "No overrides are configured",
+	ReasonOverridesActive:           "Overrides are active: %s",
+	ReasonOverridesInvalid:          "Overrides are not applied: %s",
+	ReasonOverridesPartiallyApplied: "Some overrides are ignored: %s. Active overrides: %s",
+}
+
 func MessageForLogPipeline(reason string) string {
 	return message(reason, logPipelineMessages)
 }
@@ -117,6 +194,14 @@ func MessageForMetricPipeline(reason string) string {
 	return message(reason, metricPipelineMessages)
 }
 
+func MessageForNamespacedPipeline(reason string) string {
+	return message(reason, namespacedPipelineMessages)
+}
+
+func MessageForOverrides(reason string) string {
+	return message(reason, overridesMessages)
+}
+
 func message(reason string, specializedMessages map[string]string) string {
 	if condMessage, found := commonMessages[reason]; found {
 		return condMessage
@@ -0,0 +1,46 @@
+package conditions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+var outputProbeFailureReasons = []struct {
+	err    error
+	reason string
+}{
+	{prober.ErrDNSResolutionFailed, ReasonDNSResolutionFailed},
+	{prober.ErrConnectionRefused, ReasonConnectionRefused},
+	{prober.ErrProxyConnectionFailed, ReasonProxyConnectionFailed},
+	{prober.ErrConnectionFailed, ReasonConnectionFailed},
+	{prober.ErrTLSVerificationFailed, ReasonTLSVerificationFailed},
+	{prober.ErrTLSHandshakeFailed, ReasonTLSHandshakeFailed},
+}
+
+func EvaluateOutputReachableCondition(errProbe error) (status metav1.ConditionStatus, reason, message string) {
+	if errProbe == nil {
+		return metav1.ConditionTrue, ReasonEndpointReachable, commonMessages[ReasonEndpointReachable]
+	}
+
+	for _, failure := range outputProbeFailureReasons {
+		if errors.Is(errProbe, failure.err) {
+			return metav1.ConditionFalse, failure.reason, fmt.Sprintf(commonMessages[failure.reason], probeErrorCause(errProbe, failure.err))
+		}
+	}
+
+	if errors.Is(errProbe, prober.ErrOutputProbeNotSupported) {
+		return metav1.ConditionUnknown, ReasonOutputProbeNotSupported, fmt.Sprintf(commonMessages[ReasonOutputProbeNotSupported], probeErrorCause(errProbe, prober.ErrOutputProbeNotSupported))
+	}
+
+	return metav1.ConditionUnknown, ReasonOutputProbingFailed, fmt.Sprintf(commonMessages[ReasonOutputProbingFailed], errProbe)
+}
+
+// probeErrorCause strips the sentinel error from the message, because the condition reason already tells it
+func probeErrorCause(errProbe, sentinel error) string {
+	return strings.TrimPrefix(errProbe.Error(), sentinel.Error()+": ")
+}
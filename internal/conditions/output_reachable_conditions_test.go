@@ -0,0 +1,88 @@
+package conditions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+func Test_EvaluateOutputReachableCondition(t *testing.T) {
+	tests := []struct {
+		name            string
+		given           error
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "reachable",
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonEndpointReachable,
+			expectedMessage: "Output endpoint is reachable",
+		},
+		{
+			name:            "dns resolution failed",
+			given:           fmt.Errorf("%w: %w", prober.ErrDNSResolutionFailed, errors.New("lookup backend.invalid: no such host")),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonDNSResolutionFailed,
+			expectedMessage: "Host name of the output endpoint cannot be resolved: lookup backend.invalid: no such host",
+		},
+		{
+			name:            "connection refused",
+			given:           fmt.Errorf("%w: %w", prober.ErrConnectionRefused, errors.New("dial tcp 10.0.0.1:4317: connect: connection refused")),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonConnectionRefused,
+			expectedMessage: "Output endpoint refused the connection: dial tcp 10.0.0.1:4317: connect: connection refused",
+		},
+		{
+			name:           "connection through proxy failed",
+			given:          fmt.Errorf("%w: proxy responded with 403 Forbidden", prober.ErrProxyConnectionFailed),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ReasonProxyConnectionFailed,
+		},
+		{
+			name:            "tls verification failed",
+			given:           fmt.Errorf("%w: %w", prober.ErrTLSVerificationFailed, errors.New("x509: certificate signed by unknown authority")),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonTLSVerificationFailed,
+			expectedMessage: "Certificate of the output endpoint cannot be verified: x509: certificate signed by unknown authority",
+		},
+		{
+			name:           "tls handshake failed",
+			given:          fmt.Errorf("%w: %w", prober.ErrTLSHandshakeFailed, errors.New("EOF")),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ReasonTLSHandshakeFailed,
+		},
+		{
+			name:            "probe not supported",
+			given:           fmt.Errorf("%w: custom outputs cannot be probed", prober.ErrOutputProbeNotSupported),
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  ReasonOutputProbeNotSupported,
+			expectedMessage: "Output reachability cannot be probed: custom outputs cannot be probed",
+		},
+		{
+			name:            "other error",
+			given:           errors.New("failed to list telemetry"),
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  ReasonOutputProbingFailed,
+			expectedMessage: "Could not probe the reachability of the output: failed to list telemetry",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, reason, message := EvaluateOutputReachableCondition(tt.given)
+			require.Equal(t, tt.expectedStatus, status)
+			require.Equal(t, tt.expectedReason, reason)
+
+			if tt.expectedMessage != "" {
+				require.Equal(t, tt.expectedMessage, message)
+			}
+		})
+	}
+}
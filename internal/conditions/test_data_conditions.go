@@ -0,0 +1,58 @@
+package conditions
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+// TestDataTimeout is the time after which test data that was neither confirmed as exported nor as failed is reported as not confirmed.
+const TestDataTimeout = 10 * time.Minute
+
+// IsTestDataPending checks whether the test data of the send-test-data annotation still has to be sent or its export result is still open.
+// Only then the pipeline configuration contains the test data output, so that no other workload can use it to reach the backend.
+func IsTestDataPending(annotations map[string]string, testData *telemetryv1alpha1.TestDataStatus, pipelineConditions []metav1.Condition) bool {
+	request := annotations[telemetryv1alpha1.AnnotationSendTestData]
+	if request == "" {
+		return false
+	}
+
+	if testData == nil || testData.Request != request {
+		return true
+	}
+
+	condition := meta.FindStatusCondition(pipelineConditions, TypeTestDataDelivered)
+
+	return condition == nil || condition.Reason == ReasonTestDataPending
+}
+
+// IsTestDataRolloutAwaited checks whether a previous reconciliation already waited for the rollout of the test data output, or failed to send the test data.
+// Only then the workload can have the pipeline configuration with the test data output, which is rendered after the request is seen for the first time.
+func IsTestDataRolloutAwaited(condition *metav1.Condition) bool {
+	return condition != nil && (condition.Reason == ReasonTestDataRollingOut || condition.Reason == ReasonTestDataSendFailed)
+}
+
+// EvaluateTestDataCondition evaluates the export result of test data that was sent at the given time.
+// A failed probe keeps the condition pending, so that the next reconciliation probes again.
+func EvaluateTestDataCondition(result prober.TestDataProbeResult, errProbe error, sentAt, now time.Time) (status metav1.ConditionStatus, reason, message string) {
+	if errProbe == nil {
+		if result.ExportFailed {
+			return metav1.ConditionFalse, ReasonTestDataExportFailed, commonMessages[ReasonTestDataExportFailed]
+		}
+
+		if result.Exported {
+			return metav1.ConditionTrue, ReasonTestDataExported, commonMessages[ReasonTestDataExported]
+		}
+	}
+
+	if now.Sub(sentAt) >= TestDataTimeout {
+		return metav1.ConditionUnknown, ReasonTestDataNotConfirmed, fmt.Sprintf(commonMessages[ReasonTestDataNotConfirmed], TestDataTimeout)
+	}
+
+	return metav1.ConditionUnknown, ReasonTestDataPending, commonMessages[ReasonTestDataPending]
+}
@@ -0,0 +1,133 @@
+package conditions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+func Test_EvaluateTestDataCondition(t *testing.T) {
+	sentAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		result          prober.TestDataProbeResult
+		errProbe        error
+		now             time.Time
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "exported",
+			result:          prober.TestDataProbeResult{Exported: true},
+			now:             sentAt.Add(time.Minute),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonTestDataExported,
+			expectedMessage: "Test data was sent and the pipeline exported data to the backend",
+		},
+		{
+			name:           "export failed",
+			result:         prober.TestDataProbeResult{Exported: true, ExportFailed: true},
+			now:            sentAt.Add(time.Minute),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ReasonTestDataExportFailed,
+		},
+		{
+			name:            "pending",
+			now:             sentAt.Add(time.Minute),
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  ReasonTestDataPending,
+			expectedMessage: "Test data was sent, waiting for the export result",
+		},
+		{
+			name:           "probing failed",
+			errProbe:       errors.New("prometheus unavailable"),
+			now:            sentAt.Add(time.Minute),
+			expectedStatus: metav1.ConditionUnknown,
+			expectedReason: ReasonTestDataPending,
+		},
+		{
+			name:            "not confirmed",
+			now:             sentAt.Add(TestDataTimeout),
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  ReasonTestDataNotConfirmed,
+			expectedMessage: "Test data was sent but no export to the backend was recorded within 10m0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, reason, message := EvaluateTestDataCondition(tt.result, tt.errProbe, sentAt, tt.now)
+			require.Equal(t, tt.expectedStatus, status)
+			require.Equal(t, tt.expectedReason, reason)
+
+			if tt.expectedMessage != "" {
+				require.Equal(t, tt.expectedMessage, message)
+			}
+		})
+	}
+}
+
+func Test_IsTestDataPending(t *testing.T) {
+	annotations := map[string]string{telemetryv1alpha1.AnnotationSendTestData: "run-2"}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		testData    *telemetryv1alpha1.TestDataStatus
+		conditions  []metav1.Condition
+		expected    bool
+	}{
+		{
+			name:     "no annotation",
+			testData: &telemetryv1alpha1.TestDataStatus{Request: "run-1"},
+			expected: false,
+		},
+		{
+			name:        "not sent yet",
+			annotations: annotations,
+			expected:    true,
+		},
+		{
+			name:        "new request",
+			annotations: annotations,
+			testData:    &telemetryv1alpha1.TestDataStatus{Request: "run-1"},
+			conditions:  []metav1.Condition{{Type: TypeTestDataDelivered, Reason: ReasonTestDataExported}},
+			expected:    true,
+		},
+		{
+			name:        "waiting for the export result",
+			annotations: annotations,
+			testData:    &telemetryv1alpha1.TestDataStatus{Request: "run-2"},
+			conditions:  []metav1.Condition{{Type: TypeTestDataDelivered, Reason: ReasonTestDataPending}},
+			expected:    true,
+		},
+		{
+			name:        "export result known",
+			annotations: annotations,
+			testData:    &telemetryv1alpha1.TestDataStatus{Request: "run-2"},
+			conditions:  []metav1.Condition{{Type: TypeTestDataDelivered, Reason: ReasonTestDataNotConfirmed}},
+			expected:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, IsTestDataPending(tt.annotations, tt.testData, tt.conditions))
+		})
+	}
+}
+
+func Test_IsTestDataRolloutAwaited(t *testing.T) {
+	require.False(t, IsTestDataRolloutAwaited(nil))
+	require.False(t, IsTestDataRolloutAwaited(&metav1.Condition{Type: TypeTestDataDelivered, Reason: ReasonTestDataExported}))
+	require.True(t, IsTestDataRolloutAwaited(&metav1.Condition{Type: TypeTestDataDelivered, Reason: ReasonTestDataRollingOut}))
+	require.True(t, IsTestDataRolloutAwaited(&metav1.Condition{Type: TypeTestDataDelivered, Reason: ReasonTestDataSendFailed}))
+}
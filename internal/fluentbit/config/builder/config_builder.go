@@ -24,6 +24,8 @@ type PipelineDefaults struct {
 type BuilderConfig struct {
 	PipelineDefaults
 	CollectAgentLogs bool
+	// TestDataPending adds the output for the test data of the pipeline, which is only needed while the test data is pending
+	TestDataPending bool
 }
 
 // BuildFluentBitConfig merges Fluent Bit filters and outputs to a single Fluent Bit configuration.
@@ -38,21 +40,32 @@ func BuildFluentBitConfig(pipeline *telemetryv1alpha1.LogPipeline, config Builde
 		return "", err
 	}
 
+	err = validateLokiURL(pipeline)
+	if err != nil {
+		return "", err
+	}
+
 	includePath := createIncludePath(pipeline)
 	excludePath := createExcludePath(pipeline, config.CollectAgentLogs)
 
 	var sb strings.Builder
 
 	sb.WriteString(createInputSection(pipeline, includePath, excludePath))
+
 	// skip if the filter is a multiline filter, multiline filter should be first filter in the pipeline filter chain
 	// see for more details https://docs.fluentbit.io/manual/pipeline/filters/multiline-stacktrace
 	sb.WriteString(createCustomFilters(pipeline, multilineFilter))
 	sb.WriteString(createRecordModifierFilter(pipeline))
 	sb.WriteString(createKubernetesFilter(pipeline))
+	sb.WriteString(createThrottleFilter(pipeline))
 	sb.WriteString(createCustomFilters(pipeline, nonMultilineFilter))
 	sb.WriteString(createLuaDedotFilter(pipeline))
 	sb.WriteString(createOutputSection(pipeline, config.PipelineDefaults))
 
+	if config.TestDataPending {
+		sb.WriteString(createTestDataOutputSection(pipeline, config.PipelineDefaults))
+	}
+
 	return sb.String(), nil
 }
 
@@ -97,6 +110,19 @@ func validateCustomSections(pipeline *telemetryv1alpha1.LogPipeline) error {
 	return nil
 }
 
+// validateLokiURL checks that a Loki URL given as plain value can be split into the parameters of the Fluent Bit Loki output plugin.
+// A URL referenced from a Secret is split when the env Secret is synced.
+func validateLokiURL(pipeline *telemetryv1alpha1.LogPipeline) error {
+	output := pipeline.Spec.Output
+	if !output.IsLokiDefined() || output.Loki.URL.Value == "" {
+		return nil
+	}
+
+	_, err := SplitLokiURL(output.Loki.URL.Value)
+
+	return err
+}
+
 func validateOutput(pipeline *telemetryv1alpha1.LogPipeline) error {
 	if !pipeline.Spec.Output.IsAnyDefined() {
 		return ErrUndefinedOutputPlugin
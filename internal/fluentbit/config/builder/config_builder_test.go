@@ -136,6 +136,19 @@ func TestMergeSectionsConfig(t *testing.T) {
     tls                      on
     tls.verify               on
 
+[OUTPUT]
+    name                     http
+    match                    foo_test-data
+    alias                    foo_test-data
+    allow_duplicated_headers true
+    format                   json
+    host                     localhost
+    port                     443
+    retry_limit              300
+    storage.total_limit_size 1G
+    tls                      on
+    tls.verify               on
+
 `
 	logPipeline := &telemetryv1alpha1.LogPipeline{
 		Spec: telemetryv1alpha1.LogPipelineSpec{
@@ -184,7 +197,7 @@ func TestMergeSectionsConfig(t *testing.T) {
 		FsBufferLimit:     "1G",
 	}
 
-	actual, err := BuildFluentBitConfig(logPipeline, BuilderConfig{PipelineDefaults: defaults})
+	actual, err := BuildFluentBitConfig(logPipeline, BuilderConfig{PipelineDefaults: defaults, TestDataPending: true})
 	require.NoError(t, err)
 	require.Equal(t, expected, actual)
 }
@@ -276,3 +289,26 @@ func TestMergeSectionsConfigWithMissingOutput(t *testing.T) {
 	require.Error(t, err)
 	require.Empty(t, actual)
 }
+
+func TestMergeSectionsConfigWithInvalidLokiURL(t *testing.T) {
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Loki: &telemetryv1alpha1.LokiOutput{
+					URL: telemetryv1alpha1.ValueType{Value: "loki"},
+				},
+			},
+		},
+	}
+	logPipeline.Name = "foo"
+	defaults := PipelineDefaults{
+		InputTag:          "kube",
+		MemoryBufferLimit: "10M",
+		StorageType:       "filesystem",
+		FsBufferLimit:     "1G",
+	}
+
+	actual, err := BuildFluentBitConfig(logPipeline, BuilderConfig{PipelineDefaults: defaults})
+	require.ErrorContains(t, err, "loki url 'loki' has no host")
+	require.Empty(t, actual)
+}
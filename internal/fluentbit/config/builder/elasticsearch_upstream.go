@@ -0,0 +1,62 @@
+package builder
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+const (
+	defaultElasticsearchPort = "9200"
+	filesMountPath           = "/files"
+)
+
+// ElasticsearchUpstreamFileName returns the name of the file in the files ConfigMap that holds the upstream nodes of an Elasticsearch output.
+func ElasticsearchUpstreamFileName(pipelineName string) string {
+	return fmt.Sprintf("%s-elasticsearch-upstream.conf", pipelineName)
+}
+
+// ElasticsearchUpstreamFilePath returns the path of the upstream file as mounted into the Fluent Bit container.
+func ElasticsearchUpstreamFilePath(pipelineName string) string {
+	return fmt.Sprintf("%s/%s", filesMountPath, ElasticsearchUpstreamFileName(pipelineName))
+}
+
+// BuildElasticsearchUpstreamFile creates the upstream configuration that balances the logs across the hosts of an Elasticsearch output.
+// The Fluent Bit Elasticsearch output plugin only supports a single host in its own section, so multiple hosts must be defined as upstream nodes.
+// An empty string is returned if the pipeline does not need an upstream file.
+func BuildElasticsearchUpstreamFile(pipeline *telemetryv1alpha1.LogPipeline) string {
+	output := pipeline.Spec.Output
+	if !output.IsElasticsearchDefined() || len(output.Elasticsearch.Hosts) < 2 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(NewUpstreamSectionBuilder().
+		AddConfigParam("name", pipeline.Name).
+		Build())
+
+	for i, h := range output.Elasticsearch.Hosts {
+		host, port := splitElasticsearchHost(h)
+		nodeBuilder := NewNodeSectionBuilder().
+			AddConfigParam("name", fmt.Sprintf("%s-%d", pipeline.Name, i)).
+			AddConfigParam("host", host).
+			AddConfigParam("port", port)
+		// TLS is configured per node in an upstream, so the output settings must be repeated
+		addTLSConfigParams(nodeBuilder, output.Elasticsearch.TLSConfig, pipeline.Name)
+		sb.WriteString(nodeBuilder.Build())
+	}
+
+	return sb.String()
+}
+
+func splitElasticsearchHost(hostport string) (host, port string) {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return hostport, defaultElasticsearchPort
+	}
+
+	return host, port
+}
@@ -0,0 +1,65 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestBuildElasticsearchUpstreamFile(t *testing.T) {
+	t.Run("multiple hosts", func(t *testing.T) {
+		logPipeline := &telemetryv1alpha1.LogPipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: telemetryv1alpha1.LogPipelineSpec{
+				Output: telemetryv1alpha1.Output{
+					Elasticsearch: &telemetryv1alpha1.ElasticsearchOutput{
+						Hosts: []string{"es-0.example.com", "es-1.example.com:9243"},
+						TLSConfig: telemetryv1alpha1.TLSConfig{
+							CA: &telemetryv1alpha1.ValueType{Value: "fake-ca-value"},
+						},
+					},
+				},
+			},
+		}
+
+		expected := `[UPSTREAM]
+    name foo
+
+[NODE]
+    name        foo-0
+    host        es-0.example.com
+    port        9200
+    tls         on
+    tls.ca_file /fluent-bit/etc/output-tls-config/foo-ca.crt
+    tls.verify  on
+
+[NODE]
+    name        foo-1
+    host        es-1.example.com
+    port        9243
+    tls         on
+    tls.ca_file /fluent-bit/etc/output-tls-config/foo-ca.crt
+    tls.verify  on
+
+`
+		require.Equal(t, expected, BuildElasticsearchUpstreamFile(logPipeline))
+	})
+
+	t.Run("single host", func(t *testing.T) {
+		logPipeline := &telemetryv1alpha1.LogPipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: telemetryv1alpha1.LogPipelineSpec{
+				Output: telemetryv1alpha1.Output{
+					Elasticsearch: &telemetryv1alpha1.ElasticsearchOutput{
+						Hosts: []string{"es-0.example.com"},
+					},
+				},
+			},
+		}
+
+		require.Empty(t, BuildElasticsearchUpstreamFile(logPipeline))
+	})
+}
@@ -0,0 +1,68 @@
+package builder
+
+import (
+	"fmt"
+	"net/url"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+const (
+	LokiURLHost = "HOST"
+	LokiURLPort = "PORT"
+	LokiURLURI  = "URI"
+	LokiURLTLS  = "TLS"
+
+	defaultLokiURI = "/loki/api/v1/push"
+)
+
+// LokiURLComponents are the parameters of the Fluent Bit Loki output plugin that are derived from a Loki push API URL.
+type LokiURLComponents struct {
+	Host string
+	Port string
+	URI  string
+	// TLS is "on" for the https scheme and "off" for the http scheme
+	TLS string
+}
+
+// SplitLokiURL splits a Loki push API URL into the host, port, URI, and TLS parameters of the Fluent Bit Loki output plugin.
+// If the port or the path are missing, the default port of the scheme and the default push API path are used.
+func SplitLokiURL(rawURL string) (LokiURLComponents, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return LokiURLComponents{}, fmt.Errorf("failed to parse loki url: %w", err)
+	}
+
+	components := LokiURLComponents{
+		Host: u.Hostname(),
+		Port: u.Port(),
+		URI:  u.EscapedPath(),
+		TLS:  "on",
+	}
+
+	if components.Host == "" {
+		return LokiURLComponents{}, fmt.Errorf("loki url '%s' has no host", rawURL)
+	}
+
+	if u.Scheme == "http" {
+		components.TLS = "off"
+	}
+
+	if components.Port == "" {
+		components.Port = "443"
+		if u.Scheme == "http" {
+			components.Port = "80"
+		}
+	}
+
+	if components.URI == "" || components.URI == "/" {
+		components.URI = defaultLokiURI
+	}
+
+	return components, nil
+}
+
+// FormatLokiURLEnvVarName returns the name of the environment variable that holds a component of a Loki URL referenced from a Secret.
+func FormatLokiURLEnvVarName(pipelineName string, secretKeyRef telemetryv1alpha1.SecretKeyRef, component string) string {
+	return FormatEnvVarName(pipelineName, secretKeyRef.Namespace, secretKeyRef.Name, secretKeyRef.Key) + "_" + component
+}
@@ -2,8 +2,12 @@ package builder
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
+	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 )
 
 // Considering Fluent Bit's exponential back-off and jitter algorithm with the default scheduler.base and scheduler.cap,
@@ -12,7 +16,43 @@ import (
 // that malformed logs stay in the buffer forever.
 var retryLimit = "300"
 
+// syslogFacilities lists the Syslog facility names in the order of their numerical codes as defined in RFC 5424.
+var syslogFacilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news", "uucp", "cron", "authpriv", "ftp",
+	"ntp", "security", "console", "solaris-cron", "local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+var defaultLokiLabels = []telemetryv1alpha1.LokiLabel{
+	{Name: "namespace", Source: "namespace_name"},
+	{Name: "pod", Source: "pod_name"},
+	{Name: "container", Source: "container_name"},
+}
+
 func createOutputSection(pipeline *telemetryv1alpha1.LogPipeline, defaults PipelineDefaults) string {
+	sb := newOutputSectionBuilder(pipeline, defaults)
+	if sb == nil {
+		return ""
+	}
+
+	return sb.Build()
+}
+
+// createTestDataOutputSection creates a copy of the output of the pipeline, which only receives the test data of the pipeline.
+// The copy has an alias of its own, so that the self monitor can tell the export of the test data apart from the export of other logs.
+func createTestDataOutputSection(pipeline *telemetryv1alpha1.LogPipeline, defaults PipelineDefaults) string {
+	sb := newOutputSectionBuilder(pipeline, defaults)
+	if sb == nil {
+		return ""
+	}
+
+	testDataName := synthetic.TestDataName(pipeline.Name)
+
+	return sb.SetConfigParam("alias", testDataName).
+		SetConfigParam("match", testDataName).
+		Build()
+}
+
+func newOutputSectionBuilder(pipeline *telemetryv1alpha1.LogPipeline, defaults PipelineDefaults) *SectionBuilder {
 	output := &pipeline.Spec.Output
 	if output.IsCustomDefined() {
 		return generateCustomOutput(output, defaults.FsBufferLimit, pipeline.Name)
@@ -22,10 +62,30 @@ func createOutputSection(pipeline *telemetryv1alpha1.LogPipeline, defaults Pipel
 		return generateHTTPOutput(output.HTTP, defaults.FsBufferLimit, pipeline.Name)
 	}
 
-	return ""
+	if output.IsLokiDefined() {
+		return generateLokiOutput(output.Loki, defaults.FsBufferLimit, pipeline.Name)
+	}
+
+	if output.IsElasticsearchDefined() {
+		return generateElasticsearchOutput(output.Elasticsearch, defaults.FsBufferLimit, pipeline.Name)
+	}
+
+	if output.IsSyslogDefined() {
+		return generateSyslogOutput(output.Syslog, defaults.FsBufferLimit, pipeline.Name)
+	}
+
+	if output.IsGELFDefined() {
+		return generateGELFOutput(output.GELF, defaults.FsBufferLimit, pipeline.Name)
+	}
+
+	if output.IsKafkaDefined() {
+		return generateKafkaOutput(output, defaults.FsBufferLimit, pipeline.Name)
+	}
+
+	return nil
 }
 
-func generateCustomOutput(output *telemetryv1alpha1.Output, fsBufferLimit string, name string) string {
+func generateCustomOutput(output *telemetryv1alpha1.Output, fsBufferLimit string, name string) *SectionBuilder {
 	sb := NewOutputSectionBuilder()
 	customOutputParams := parseMultiline(output.Custom)
 	aliasPresent := customOutputParams.ContainsKey("alias")
@@ -42,10 +102,10 @@ func generateCustomOutput(output *telemetryv1alpha1.Output, fsBufferLimit string
 	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
 	sb.AddConfigParam("retry_limit", retryLimit)
 
-	return sb.Build()
+	return sb
 }
 
-func generateHTTPOutput(httpOutput *telemetryv1alpha1.HTTPOutput, fsBufferLimit string, name string) string {
+func generateHTTPOutput(httpOutput *telemetryv1alpha1.HTTPOutput, fsBufferLimit string, name string) *SectionBuilder {
 	sb := NewOutputSectionBuilder()
 	sb.AddConfigParam("name", "http")
 	sb.AddConfigParam("allow_duplicated_headers", "true")
@@ -73,33 +133,323 @@ func generateHTTPOutput(httpOutput *telemetryv1alpha1.HTTPOutput, fsBufferLimit
 		sb.AddConfigParam("http_user", value)
 	}
 
+	if httpOutput.Proxy != nil {
+		sb.AddIfNotEmpty("proxy", httpOutput.Proxy.URL)
+	}
+
+	addTLSConfigParams(sb, httpOutput.TLSConfig, name)
+
+	return sb
+}
+
+func generateLokiOutput(lokiOutput *telemetryv1alpha1.LokiOutput, fsBufferLimit string, name string) *SectionBuilder {
+	sb := NewOutputSectionBuilder()
+	sb.AddConfigParam("name", "loki")
+	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
+	sb.AddConfigParam("alias", name)
+	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
+	sb.AddConfigParam("retry_limit", retryLimit)
+	sb.AddConfigParam("line_format", "json")
+	sb.AddConfigParam("labels", lokiLabels(lokiOutput.Labels))
+
+	// The TLS setting follows the URL scheme unless TLS is disabled explicitly
+	tlsEnabled := "on"
+
+	// A URL from a Secret cannot be split in the Fluent Bit configuration, so its components are provided as separate environment variables
+	if lokiOutput.URL.Value != "" {
+		// The URL is checked by validateLokiURL before the output is generated
+		components, _ := SplitLokiURL(lokiOutput.URL.Value)
+		sb.AddConfigParam("host", components.Host)
+		sb.AddConfigParam("port", components.Port)
+		sb.AddConfigParam("uri", components.URI)
+		tlsEnabled = components.TLS
+	} else if lokiOutput.URL.ValueFrom != nil && lokiOutput.URL.ValueFrom.IsSecretKeyRef() {
+		secretKeyRef := lokiOutput.URL.ValueFrom.SecretKeyRef
+		sb.AddConfigParam("host", fmt.Sprintf("${%s}", FormatLokiURLEnvVarName(name, *secretKeyRef, LokiURLHost)))
+		sb.AddConfigParam("port", fmt.Sprintf("${%s}", FormatLokiURLEnvVarName(name, *secretKeyRef, LokiURLPort)))
+		sb.AddConfigParam("uri", fmt.Sprintf("${%s}", FormatLokiURLEnvVarName(name, *secretKeyRef, LokiURLURI)))
+		tlsEnabled = fmt.Sprintf("${%s}", FormatLokiURLEnvVarName(name, *secretKeyRef, LokiURLTLS))
+	}
+
+	if lokiOutput.TLSConfig.Disabled {
+		tlsEnabled = "off"
+	}
+
+	if lokiOutput.TenantID.IsDefined() {
+		sb.AddConfigParam("tenant_id", resolveValue(lokiOutput.TenantID, name))
+	}
+
+	if lokiOutput.Password.IsDefined() {
+		sb.AddConfigParam("http_passwd", resolveValue(lokiOutput.Password, name))
+	}
+
+	if lokiOutput.User.IsDefined() {
+		sb.AddConfigParam("http_user", resolveValue(lokiOutput.User, name))
+	}
+
+	addTLSParams(sb, tlsEnabled, lokiOutput.TLSConfig, name)
+
+	return sb
+}
+
+func generateElasticsearchOutput(esOutput *telemetryv1alpha1.ElasticsearchOutput, fsBufferLimit string, name string) *SectionBuilder {
+	sb := NewOutputSectionBuilder()
+	sb.AddConfigParam("name", "es")
+	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
+	sb.AddConfigParam("alias", name)
+	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
+	sb.AddConfigParam("retry_limit", retryLimit)
+	// Elasticsearch 8 and OpenSearch 2 reject the type name, and dots in keys would be interpreted as nested objects
+	sb.AddConfigParam("suppress_type_name", "on")
+	sb.AddConfigParam("replace_dots", "on")
+	sb.AddIfNotEmpty("index", esOutput.Index)
+	sb.AddIfNotEmpty("pipeline", esOutput.Pipeline)
+
+	if esOutput.LogstashPrefix != "" || esOutput.LogstashPrefixSource != "" {
+		sb.AddConfigParam("logstash_format", "on")
+		sb.AddIfNotEmpty("logstash_prefix", esOutput.LogstashPrefix)
+
+		if esOutput.LogstashPrefixSource != "" {
+			sb.AddConfigParam("logstash_prefix_key", kubernetesMetadataAccessor(esOutput.LogstashPrefixSource))
+		}
+	}
+
+	if len(esOutput.Hosts) > 1 {
+		sb.AddConfigParam("upstream", ElasticsearchUpstreamFilePath(name))
+	} else {
+		host, port := splitElasticsearchHost(esOutput.Hosts[0])
+		sb.AddConfigParam("host", host)
+		sb.AddConfigParam("port", port)
+	}
+
+	if esOutput.User.IsDefined() {
+		sb.AddConfigParam("http_user", resolveValue(esOutput.User, name))
+	}
+
+	if esOutput.Password.IsDefined() {
+		sb.AddConfigParam("http_passwd", resolveValue(esOutput.Password, name))
+	}
+
+	if esOutput.APIKey.IsDefined() {
+		sb.AddConfigParam("http_api_key", resolveValue(esOutput.APIKey, name))
+	}
+
+	addTLSConfigParams(sb, esOutput.TLSConfig, name)
+
+	return sb
+}
+
+func generateSyslogOutput(syslogOutput *telemetryv1alpha1.SyslogOutput, fsBufferLimit string, name string) *SectionBuilder {
+	mode := transportModeOrDefault(syslogOutput.Mode)
+	defaultPort := "514"
+
+	if mode == telemetryv1alpha1.TransportModeTLS {
+		defaultPort = "6514"
+	}
+
+	sb := NewOutputSectionBuilder()
+	sb.AddConfigParam("name", "syslog")
+	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
+	sb.AddConfigParam("alias", name)
+	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
+	sb.AddConfigParam("retry_limit", retryLimit)
+	sb.AddConfigParam("mode", string(mode))
+	sb.AddIfNotEmptyOrDefault("port", syslogOutput.Port, defaultPort)
+	sb.AddIfNotEmptyOrDefault("syslog_format", string(syslogOutput.Format), string(telemetryv1alpha1.SyslogFormatRFC5424))
+	sb.AddConfigParam("syslog_facility_preset", syslogFacilityCode(syslogOutput.Facility))
+	sb.AddConfigParam("syslog_message_key", "log")
+
+	if syslogOutput.Host.IsDefined() {
+		sb.AddConfigParam("host", resolveValue(syslogOutput.Host, name))
+	}
+
+	addTransportTLSConfigParams(sb, mode, syslogOutput.TLSConfig, name)
+
+	return sb
+}
+
+func generateGELFOutput(gelfOutput *telemetryv1alpha1.GELFOutput, fsBufferLimit string, name string) *SectionBuilder {
+	mode := transportModeOrDefault(gelfOutput.Mode)
+
+	sb := NewOutputSectionBuilder()
+	sb.AddConfigParam("name", "gelf")
+	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
+	sb.AddConfigParam("alias", name)
+	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
+	sb.AddConfigParam("retry_limit", retryLimit)
+	sb.AddConfigParam("mode", string(mode))
+	sb.AddIfNotEmptyOrDefault("port", gelfOutput.Port, "12201")
+	sb.AddConfigParam("gelf_short_message_key", "log")
+
+	if gelfOutput.Host.IsDefined() {
+		sb.AddConfigParam("host", resolveValue(gelfOutput.Host, name))
+	}
+
+	addTransportTLSConfigParams(sb, mode, gelfOutput.TLSConfig, name)
+
+	return sb
+}
+
+func generateKafkaOutput(output *telemetryv1alpha1.Output, fsBufferLimit string, name string) *SectionBuilder {
+	kafkaOutput := output.Kafka
+	tlsConfig := output.GetTLSConfig()
+	saslEnabled := kafkaOutput.Authentication != nil && kafkaOutput.Authentication.SASL.IsDefined()
+
+	sb := NewOutputSectionBuilder()
+	sb.AddConfigParam("name", "kafka")
+	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
+	sb.AddConfigParam("alias", name)
+	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
+	sb.AddConfigParam("retry_limit", retryLimit)
+	sb.AddConfigParam("brokers", strings.Join(kafkaOutput.Brokers, ","))
+	sb.AddConfigParam("topics", kafkaOutput.Topic)
+	sb.AddConfigParam("format", "json")
+	sb.AddConfigParam("rdkafka.security.protocol", kafkaSecurityProtocol(saslEnabled, !tlsConfig.Disabled))
+
+	if saslEnabled {
+		sasl := kafkaOutput.Authentication.SASL
+		mechanism := sasl.Mechanism
+		if mechanism == "" {
+			mechanism = telemetryv1alpha1.KafkaSASLMechanismPlain
+		}
+
+		sb.AddConfigParam("rdkafka.sasl.mechanism", mechanism)
+		sb.AddConfigParam("rdkafka.sasl.username", resolveValue(sasl.User, name))
+		sb.AddConfigParam("rdkafka.sasl.password", resolveValue(sasl.Password, name))
+	}
+
+	if !tlsConfig.Disabled {
+		if tlsConfig.SkipCertificateValidation {
+			sb.AddConfigParam("rdkafka.enable.ssl.certificate.verification", "false")
+		}
+
+		if tlsConfig.GetCA().IsDefined() {
+			sb.AddConfigParam("rdkafka.ssl.ca.location", fmt.Sprintf("/fluent-bit/etc/output-tls-config/%s-ca.crt", name))
+		}
+
+		if tlsConfig.GetCert().IsDefined() {
+			sb.AddConfigParam("rdkafka.ssl.certificate.location", fmt.Sprintf("/fluent-bit/etc/output-tls-config/%s-cert.crt", name))
+		}
+
+		if tlsConfig.GetKey().IsDefined() {
+			sb.AddConfigParam("rdkafka.ssl.key.location", fmt.Sprintf("/fluent-bit/etc/output-tls-config/%s-key.key", name))
+		}
+
+		sb.AddIfNotEmpty("rdkafka.ssl.cipher.suites", openSSLCipherList(output.GetTLSCipherSuites()))
+	}
+
+	return sb
+}
+
+// kafkaSecurityProtocol returns the librdkafka security protocol for the given combination of SASL and TLS.
+func kafkaSecurityProtocol(saslEnabled, tlsEnabled bool) string {
+	switch {
+	case saslEnabled && tlsEnabled:
+		return "SASL_SSL"
+	case saslEnabled:
+		return "SASL_PLAINTEXT"
+	case tlsEnabled:
+		return "SSL"
+	default:
+		return "PLAINTEXT"
+	}
+}
+
+func transportModeOrDefault(mode telemetryv1alpha1.TransportMode) telemetryv1alpha1.TransportMode {
+	if mode == "" {
+		return telemetryv1alpha1.TransportModeTLS
+	}
+
+	return mode
+}
+
+// addTransportTLSConfigParams adds the TLS parameters for outputs that select TLS by their transport mode.
+func addTransportTLSConfigParams(sb *SectionBuilder, mode telemetryv1alpha1.TransportMode, tlsConfig telemetryv1alpha1.TLSConfig, name string) {
+	tlsConfig.Disabled = mode != telemetryv1alpha1.TransportModeTLS
+	addTLSConfigParams(sb, tlsConfig, name)
+}
+
+func syslogFacilityCode(facility string) string {
+	for code, name := range syslogFacilities {
+		if name == facility {
+			return strconv.Itoa(code)
+		}
+	}
+
+	// user-level messages
+	return "1"
+}
+
+// lokiLabels maps the configured labels to Fluent Bit record accessors on the Kubernetes metadata of the log record.
+func lokiLabels(labels []telemetryv1alpha1.LokiLabel) string {
+	if len(labels) == 0 {
+		labels = defaultLokiLabels
+	}
+
+	var mapped []string
+
+	for _, label := range labels {
+		mapped = append(mapped, fmt.Sprintf("%s=%s", label.Name, kubernetesMetadataAccessor(label.Source)))
+	}
+
+	return strings.Join(mapped, ", ")
+}
+
+// kubernetesMetadataAccessor returns the Fluent Bit record accessor for a Kubernetes metadata attribute, or for a key of the labels or annotations.
+func kubernetesMetadataAccessor(source string) string {
+	for _, prefix := range []string{"labels", "annotations"} {
+		if key, found := strings.CutPrefix(source, prefix+"."); found {
+			return fmt.Sprintf("$kubernetes['%s']['%s']", prefix, key)
+		}
+	}
+
+	return fmt.Sprintf("$kubernetes['%s']", source)
+}
+
+func addTLSConfigParams(sb *SectionBuilder, tlsConfig telemetryv1alpha1.TLSConfig, name string) {
 	tlsEnabled := "on"
-	if httpOutput.TLSConfig.Disabled {
+	if tlsConfig.Disabled {
 		tlsEnabled = "off"
 	}
 
+	addTLSParams(sb, tlsEnabled, tlsConfig, name)
+}
+
+// addTLSParams adds the TLS parameters with the given value of the tls parameter, which can also be a reference to an environment variable.
+func addTLSParams(sb *SectionBuilder, tlsEnabled string, tlsConfig telemetryv1alpha1.TLSConfig, name string) {
 	sb.AddConfigParam("tls", tlsEnabled)
 
 	tlsVerify := "on"
-	if httpOutput.TLSConfig.SkipCertificateValidation {
+	if tlsConfig.SkipCertificateValidation {
 		tlsVerify = "off"
 	}
 
 	sb.AddConfigParam("tls.verify", tlsVerify)
 
-	if httpOutput.TLSConfig.CA.IsDefined() {
+	if tlsConfig.GetCA().IsDefined() {
 		sb.AddConfigParam("tls.ca_file", fmt.Sprintf("/fluent-bit/etc/output-tls-config/%s-ca.crt", name))
 	}
 
-	if httpOutput.TLSConfig.Cert.IsDefined() {
+	if tlsConfig.GetCert().IsDefined() {
 		sb.AddConfigParam("tls.crt_file", fmt.Sprintf("/fluent-bit/etc/output-tls-config/%s-cert.crt", name))
 	}
 
-	if httpOutput.TLSConfig.Key.IsDefined() {
+	if tlsConfig.GetKey().IsDefined() {
 		sb.AddConfigParam("tls.key_file", fmt.Sprintf("/fluent-bit/etc/output-tls-config/%s-key.key", name))
 	}
+}
 
-	return sb.Build()
+// openSSLCipherList converts the IANA names of cipher suites to an OpenSSL cipher list.
+func openSSLCipherList(cipherSuites []string) string {
+	var names []string
+
+	for _, cipherSuite := range cipherSuites {
+		if name := tlscert.OpenSSLCipherSuiteName(cipherSuite); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, ":")
 }
 
 func resolveValue(value telemetryv1alpha1.ValueType, logPipeline string) string {
@@ -34,6 +34,31 @@ func TestCreateOutputSectionWithCustomOutput(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestCreateTestDataOutputSection(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     null
+    match                    foo_test-data
+    alias                    foo_test-data
+    retry_limit              300
+    storage.total_limit_size 1G
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Custom: `
+    name  null
+    alias custom-alias`,
+			},
+		},
+	}
+	logPipeline.Name = "foo"
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createTestDataOutputSection(logPipeline, pipelineConfig)
+	require.Equal(t, expected, actual)
+}
+
 func TestCreateOutputSectionWithHTTPOutput(t *testing.T) {
 	expected := `[OUTPUT]
     name                     http
@@ -75,6 +100,40 @@ func TestCreateOutputSectionWithHTTPOutput(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestCreateOutputSectionWithHTTPOutputWithProxy(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     http
+    match                    foo.*
+    alias                    foo
+    allow_duplicated_headers true
+    format                   json
+    host                     localhost
+    port                     443
+    proxy                    http://proxy.example.com:3128
+    retry_limit              300
+    storage.total_limit_size 1G
+    tls                      on
+    tls.verify               on
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				HTTP: &telemetryv1alpha1.HTTPOutput{
+					Host:  telemetryv1alpha1.ValueType{Value: "localhost"},
+					Proxy: &telemetryv1alpha1.OutputProxy{URL: "http://proxy.example.com:3128"},
+				},
+			},
+		},
+	}
+	logPipeline.Name = "foo"
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
 func TestCreateOutputSectionWithHTTPOutputWithSecretReference(t *testing.T) {
 	expected := `[OUTPUT]
     name                     http
@@ -167,6 +226,437 @@ func TestCreateOutputSectionWithHTTPOutputWithTLS(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestCreateOutputSectionWithLokiOutput(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     loki
+    match                    foo.*
+    alias                    foo
+    host                     loki.example.com
+    http_passwd              ${FOO_MY_NAMESPACE_SECRET_PASSWORD}
+    http_user                user
+    labels                   namespace=$kubernetes['namespace_name'], app=$kubernetes['labels']['app.kubernetes.io/name']
+    line_format              json
+    port                     3100
+    retry_limit              300
+    storage.total_limit_size 1G
+    tenant_id                tenant
+    tls                      on
+    tls.ca_file              /fluent-bit/etc/output-tls-config/foo-ca.crt
+    tls.verify               off
+    uri                      /custom/push
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Loki: &telemetryv1alpha1.LokiOutput{
+					URL:      telemetryv1alpha1.ValueType{Value: "https://loki.example.com:3100/custom/push"},
+					TenantID: telemetryv1alpha1.ValueType{Value: "tenant"},
+					Labels: []telemetryv1alpha1.LokiLabel{
+						{Name: "namespace", Source: "namespace_name"},
+						{Name: "app", Source: "labels.app.kubernetes.io/name"},
+					},
+					User: telemetryv1alpha1.ValueType{Value: "user"},
+					Password: telemetryv1alpha1.ValueType{
+						ValueFrom: &telemetryv1alpha1.ValueFromSource{
+							SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+								Name:      "secret",
+								Key:       "password",
+								Namespace: "my-namespace",
+							},
+						},
+					},
+					TLSConfig: telemetryv1alpha1.TLSConfig{
+						SkipCertificateValidation: true,
+						CA:                        &telemetryv1alpha1.ValueType{Value: "fake-ca-value"},
+					},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithLokiOutputWithHTTPURL(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     loki
+    match                    foo.*
+    alias                    foo
+    host                     loki.loki-ns
+    labels                   namespace=$kubernetes['namespace_name'], pod=$kubernetes['pod_name'], container=$kubernetes['container_name']
+    line_format              json
+    port                     3100
+    retry_limit              300
+    storage.total_limit_size 1G
+    tls                      off
+    tls.verify               on
+    uri                      /loki/api/v1/push
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Loki: &telemetryv1alpha1.LokiOutput{
+					URL: telemetryv1alpha1.ValueType{Value: "http://loki.loki-ns:3100"},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithLokiOutputWithURLSecretReference(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     loki
+    match                    foo.*
+    alias                    foo
+    host                     ${FOO_MY_NAMESPACE_SECRET_URL_HOST}
+    labels                   namespace=$kubernetes['namespace_name'], pod=$kubernetes['pod_name'], container=$kubernetes['container_name']
+    line_format              json
+    port                     ${FOO_MY_NAMESPACE_SECRET_URL_PORT}
+    retry_limit              300
+    storage.total_limit_size 1G
+    tls                      ${FOO_MY_NAMESPACE_SECRET_URL_TLS}
+    tls.verify               on
+    uri                      ${FOO_MY_NAMESPACE_SECRET_URL_URI}
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Loki: &telemetryv1alpha1.LokiOutput{
+					URL: telemetryv1alpha1.ValueType{
+						ValueFrom: &telemetryv1alpha1.ValueFromSource{
+							SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+								Name:      "secret",
+								Key:       "url",
+								Namespace: "my-namespace",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithElasticsearchOutput(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     es
+    match                    foo.*
+    alias                    foo
+    host                     es.example.com
+    http_api_key             ${FOO_MY_NAMESPACE_SECRET_API_KEY}
+    logstash_format          on
+    logstash_prefix          logs
+    logstash_prefix_key      $kubernetes['labels']['app']
+    pipeline                 ingest
+    port                     9200
+    replace_dots             on
+    retry_limit              300
+    storage.total_limit_size 1G
+    suppress_type_name       on
+    tls                      on
+    tls.verify               on
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Elasticsearch: &telemetryv1alpha1.ElasticsearchOutput{
+					Hosts:                []string{"es.example.com"},
+					LogstashPrefix:       "logs",
+					LogstashPrefixSource: "labels.app",
+					Pipeline:             "ingest",
+					APIKey: telemetryv1alpha1.ValueType{
+						ValueFrom: &telemetryv1alpha1.ValueFromSource{
+							SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+								Name:      "secret",
+								Key:       "api-key",
+								Namespace: "my-namespace",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithElasticsearchOutputWithMultipleHosts(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     es
+    match                    foo.*
+    alias                    foo
+    http_passwd              secret
+    http_user                user
+    index                    logs
+    replace_dots             on
+    retry_limit              300
+    storage.total_limit_size 1G
+    suppress_type_name       on
+    tls                      off
+    tls.verify               on
+    upstream                 /files/foo-elasticsearch-upstream.conf
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Elasticsearch: &telemetryv1alpha1.ElasticsearchOutput{
+					Hosts:     []string{"es-0.example.com", "es-1.example.com:9243"},
+					Index:     "logs",
+					User:      telemetryv1alpha1.ValueType{Value: "user"},
+					Password:  telemetryv1alpha1.ValueType{Value: "secret"},
+					TLSConfig: telemetryv1alpha1.TLSConfig{Disabled: true},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithSyslogOutput(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     syslog
+    match                    foo.*
+    alias                    foo
+    host                     ${FOO_MY_NAMESPACE_SECRET_HOST}
+    mode                     tls
+    port                     6514
+    retry_limit              300
+    storage.total_limit_size 1G
+    syslog_facility_preset   16
+    syslog_format            rfc5424
+    syslog_message_key       log
+    tls                      on
+    tls.ca_file              /fluent-bit/etc/output-tls-config/foo-ca.crt
+    tls.verify               on
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Syslog: &telemetryv1alpha1.SyslogOutput{
+					Host: telemetryv1alpha1.ValueType{
+						ValueFrom: &telemetryv1alpha1.ValueFromSource{
+							SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+								Name:      "secret",
+								Key:       "host",
+								Namespace: "my-namespace",
+							},
+						},
+					},
+					Facility: "local0",
+					TLSConfig: telemetryv1alpha1.TLSConfig{
+						CA: &telemetryv1alpha1.ValueType{Value: "fake-ca-value"},
+					},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithGELFOutput(t *testing.T) {
+	expected := `[OUTPUT]
+    name                     gelf
+    match                    foo.*
+    alias                    foo
+    gelf_short_message_key   log
+    host                     graylog.example.com
+    mode                     udp
+    port                     12201
+    retry_limit              300
+    storage.total_limit_size 1G
+    tls                      off
+    tls.verify               on
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				GELF: &telemetryv1alpha1.GELFOutput{
+					Host: telemetryv1alpha1.ValueType{Value: "graylog.example.com"},
+					Mode: telemetryv1alpha1.TransportModeUDP,
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithKafkaOutput(t *testing.T) {
+	expected := `[OUTPUT]
+    name                                        kafka
+    match                                       foo.*
+    alias                                       foo
+    brokers                                     kafka-1:9093,kafka-2:9093
+    format                                      json
+    rdkafka.enable.ssl.certificate.verification false
+    rdkafka.sasl.mechanism                      SCRAM-SHA-256
+    rdkafka.sasl.password                       ${FOO_DEFAULT_KAFKA_CREDENTIALS_PASSWORD}
+    rdkafka.sasl.username                       user
+    rdkafka.security.protocol                   SASL_SSL
+    rdkafka.ssl.ca.location                     /fluent-bit/etc/output-tls-config/foo-ca.crt
+    rdkafka.ssl.cipher.suites                   ECDHE-RSA-AES256-GCM-SHA384
+    retry_limit                                 300
+    storage.total_limit_size                    1G
+    topics                                      logs
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Kafka: &telemetryv1alpha1.KafkaOutput{
+					Brokers: []string{"kafka-1:9093", "kafka-2:9093"},
+					Topic:   "logs",
+					Authentication: &telemetryv1alpha1.KafkaAuthenticationOptions{
+						SASL: &telemetryv1alpha1.KafkaSASLOptions{
+							Mechanism: telemetryv1alpha1.KafkaSASLMechanismSCRAMSHA256,
+							User:      telemetryv1alpha1.ValueType{Value: "user"},
+							Password: telemetryv1alpha1.ValueType{
+								ValueFrom: &telemetryv1alpha1.ValueFromSource{
+									SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+										Name:      "kafka-credentials",
+										Namespace: "default",
+										Key:       "password",
+									},
+								},
+							},
+						},
+					},
+					TLS: &telemetryv1alpha1.OtlpTLS{
+						InsecureSkipVerify: true,
+						CA:                 &telemetryv1alpha1.ValueType{Value: "ca"},
+						CipherSuites:       []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+					},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateOutputSectionWithInsecureKafkaOutput(t *testing.T) {
+	expected := `[OUTPUT]
+    name                      kafka
+    match                     foo.*
+    alias                     foo
+    brokers                   kafka:9092
+    format                    json
+    rdkafka.security.protocol PLAINTEXT
+    retry_limit               300
+    storage.total_limit_size  1G
+    topics                    logs
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Kafka: &telemetryv1alpha1.KafkaOutput{
+					Brokers: []string{"kafka:9092"},
+					Topic:   "logs",
+					TLS: &telemetryv1alpha1.OtlpTLS{
+						Insecure: true,
+					},
+				},
+			},
+		},
+	}
+	pipelineConfig := PipelineDefaults{FsBufferLimit: "1G"}
+
+	actual := createOutputSection(logPipeline, pipelineConfig)
+	require.NotEmpty(t, actual)
+	require.Equal(t, expected, actual)
+}
+
+func TestSplitLokiURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		expected  LokiURLComponents
+		expectErr bool
+	}{
+		{
+			name:     "full url",
+			url:      "https://loki.example.com:3100/loki/api/v1/push",
+			expected: LokiURLComponents{Host: "loki.example.com", Port: "3100", URI: "/loki/api/v1/push", TLS: "on"},
+		},
+		{
+			name:     "https without port and path",
+			url:      "https://loki.example.com",
+			expected: LokiURLComponents{Host: "loki.example.com", Port: "443", URI: "/loki/api/v1/push", TLS: "on"},
+		},
+		{
+			name:     "http without port",
+			url:      "http://loki.loki-ns:/push",
+			expected: LokiURLComponents{Host: "loki.loki-ns", Port: "80", URI: "/push", TLS: "off"},
+		},
+		{
+			name:      "no host",
+			url:       "loki",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			components, err := SplitLokiURL(tt.url)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, components)
+		})
+	}
+}
+
 func TestResolveValueWithValue(t *testing.T) {
 	value := telemetryv1alpha1.ValueType{
 		Value: "test",
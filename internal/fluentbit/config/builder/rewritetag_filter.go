@@ -12,6 +12,26 @@ func getEmitterPostfixByOutput(output *telemetryv1alpha1.Output) string {
 		return "http"
 	}
 
+	if output.IsLokiDefined() {
+		return "loki"
+	}
+
+	if output.IsElasticsearchDefined() {
+		return "es"
+	}
+
+	if output.IsSyslogDefined() {
+		return "syslog"
+	}
+
+	if output.IsGELFDefined() {
+		return "gelf"
+	}
+
+	if output.IsKafkaDefined() {
+		return "kafka"
+	}
+
 	if !output.IsCustomDefined() {
 		return ""
 	}
@@ -98,3 +98,70 @@ func TestCreateRewriteTagFilterWithCustomOutput(t *testing.T) {
 	actual := createRewriteTagFilter(logPipeline, pipelineConfig)
 	require.Equal(t, expected, actual)
 }
+
+func TestCreateRewriteTagFilterWithLokiOutput(t *testing.T) {
+	pipelineConfig := PipelineDefaults{
+		InputTag:          "kube",
+		MemoryBufferLimit: "10M",
+		StorageType:       "filesystem",
+	}
+
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "logpipeline1",
+		},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Loki: &telemetryv1alpha1.LokiOutput{
+					URL: telemetryv1alpha1.ValueType{Value: "https://loki.example.com"},
+				},
+			},
+		},
+	}
+
+	expected := `[FILTER]
+    name                  rewrite_tag
+    match                 kube.*
+    emitter_mem_buf_limit 10M
+    emitter_name          logpipeline1-loki
+    emitter_storage.type  filesystem
+    rule                  $log "^.*$" logpipeline1.$TAG true
+
+`
+	actual := createRewriteTagFilter(logPipeline, pipelineConfig)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateRewriteTagFilterWithKafkaOutput(t *testing.T) {
+	pipelineConfig := PipelineDefaults{
+		InputTag:          "kube",
+		MemoryBufferLimit: "10M",
+		StorageType:       "filesystem",
+	}
+
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "logpipeline1",
+		},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Kafka: &telemetryv1alpha1.KafkaOutput{
+					Brokers: []string{"kafka:9093"},
+					Topic:   "logs",
+				},
+			},
+		},
+	}
+
+	expected := `[FILTER]
+    name                  rewrite_tag
+    match                 kube.*
+    emitter_mem_buf_limit 10M
+    emitter_name          logpipeline1-kafka
+    emitter_storage.type  filesystem
+    rule                  $log "^.*$" logpipeline1.$TAG true
+
+`
+	actual := createRewriteTagFilter(logPipeline, pipelineConfig)
+	require.Equal(t, expected, actual)
+}
@@ -29,6 +29,23 @@ func NewOutputSectionBuilder() *SectionBuilder {
 	return sb.createOutputSection()
 }
 
+func NewUpstreamSectionBuilder() *SectionBuilder {
+	sb := SectionBuilder{}
+	return sb.createSection("[UPSTREAM]")
+}
+
+func NewNodeSectionBuilder() *SectionBuilder {
+	sb := SectionBuilder{}
+	return sb.createSection("[NODE]")
+}
+
+func (sb *SectionBuilder) createSection(header string) *SectionBuilder {
+	sb.builder.WriteString(header)
+	sb.builder.WriteByte('\n')
+
+	return sb
+}
+
 func (sb *SectionBuilder) createInputSection() *SectionBuilder {
 	sb.builder.WriteString("[INPUT]")
 	sb.builder.WriteByte('\n')
@@ -60,6 +77,21 @@ func (sb *SectionBuilder) AddConfigParam(key string, value string) *SectionBuild
 	return sb
 }
 
+// SetConfigParam replaces all values of the given key with the given value, or adds the key if it is not set yet.
+func (sb *SectionBuilder) SetConfigParam(key string, value string) *SectionBuilder {
+	params := sb.params[:0]
+
+	for _, p := range sb.params {
+		if p.Key != strings.ToLower(key) {
+			params = append(params, p)
+		}
+	}
+
+	sb.params = params
+
+	return sb.AddConfigParam(key, value)
+}
+
 func (sb *SectionBuilder) AddIfNotEmpty(key string, value string) *SectionBuilder {
 	if value != "" {
 		sb.AddConfigParam(key, value)
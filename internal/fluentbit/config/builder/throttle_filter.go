@@ -0,0 +1,56 @@
+package builder
+
+import (
+	"fmt"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// throttleLuaCode keeps a per-second counter for each key and drops records once the counter exceeds the limit.
+// The Fluent Bit throttle filter does not support keying by record fields, so the rate limiting is implemented as an inline Lua filter.
+// Every filter instance has its own Lua state, so the counters are not shared between pipelines.
+// The number of counters is bounded by throttleMaxKeys: within a second, the records of all keys beyond the bound share a single counter.
+const throttleLuaCode = `local limit, maxKeys, window, counts, size = %d, %d, 0, {}, 0 ` +
+	`function throttle(tag, timestamp, record) ` +
+	`local k8s = record.kubernetes ` +
+	`if k8s == nil then return 0, timestamp, record end ` +
+	`local key = %s ` +
+	`local now = os.time() ` +
+	`if now ~= window then window, counts, size = now, {}, 0 end ` +
+	`if counts[key] == nil then if size >= maxKeys then key = "" else size = size + 1 end end ` +
+	`local count = (counts[key] or 0) + 1 ` +
+	`counts[key] = count ` +
+	`if count > limit then return -1, timestamp, record end ` +
+	`return 0, timestamp, record ` +
+	`end`
+
+// throttleMaxKeys bounds the memory of the throttle filter. It exceeds the number of Namespaces or containers that typically run on a node.
+const throttleMaxKeys = 1000
+
+const (
+	throttleKeyNamespace = `tostring(k8s.namespace_name)`
+	throttleKeyContainer = `tostring(k8s.namespace_name) .. "/" .. tostring(k8s.container_name)`
+)
+
+// createThrottleFilter creates a filter that limits the log rate per Namespace or per container.
+// The filter must be placed after the kubernetes filter, since it relies on the enriched Kubernetes metadata.
+// The pipeline name is used as alias, so that the dropped records are attributed to the pipeline by the self-monitor.
+func createThrottleFilter(pipeline *telemetryv1alpha1.LogPipeline) string {
+	throttle := pipeline.Spec.Input.Application.Throttle
+	if throttle == nil {
+		return ""
+	}
+
+	key := throttleKeyNamespace
+	if throttle.Per == telemetryv1alpha1.ThrottleScopeContainer {
+		key = throttleKeyContainer
+	}
+
+	return NewFilterSectionBuilder().
+		AddConfigParam("name", "lua").
+		AddConfigParam("match", fmt.Sprintf("%s.*", pipeline.Name)).
+		AddConfigParam("alias", pipeline.Name).
+		AddConfigParam("call", "throttle").
+		AddConfigParam("code", fmt.Sprintf(throttleLuaCode, throttle.RecordsPerSecond, throttleMaxKeys, key)).
+		Build()
+}
@@ -0,0 +1,67 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestCreateThrottleFilterNotDefined(t *testing.T) {
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-logpipeline"},
+	}
+
+	actual := createThrottleFilter(logPipeline)
+	require.Empty(t, actual)
+}
+
+func TestCreateThrottleFilterPerNamespace(t *testing.T) {
+	expected := `[FILTER]
+    name  lua
+    match test-logpipeline.*
+    alias test-logpipeline
+    call  throttle
+    code  local limit, maxKeys, window, counts, size = 100, 1000, 0, {}, 0 function throttle(tag, timestamp, record) local k8s = record.kubernetes if k8s == nil then return 0, timestamp, record end local key = tostring(k8s.namespace_name) local now = os.time() if now ~= window then window, counts, size = now, {}, 0 end if counts[key] == nil then if size >= maxKeys then key = "" else size = size + 1 end end local count = (counts[key] or 0) + 1 counts[key] = count if count > limit then return -1, timestamp, record end return 0, timestamp, record end
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-logpipeline"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Input: telemetryv1alpha1.Input{
+				Application: telemetryv1alpha1.ApplicationInput{
+					Throttle: &telemetryv1alpha1.ThrottleConfig{
+						RecordsPerSecond: 100,
+						Per:              telemetryv1alpha1.ThrottleScopeNamespace,
+					},
+				}}}}
+
+	actual := createThrottleFilter(logPipeline)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateThrottleFilterPerContainer(t *testing.T) {
+	expected := `[FILTER]
+    name  lua
+    match test-logpipeline.*
+    alias test-logpipeline
+    call  throttle
+    code  local limit, maxKeys, window, counts, size = 50, 1000, 0, {}, 0 function throttle(tag, timestamp, record) local k8s = record.kubernetes if k8s == nil then return 0, timestamp, record end local key = tostring(k8s.namespace_name) .. "/" .. tostring(k8s.container_name) local now = os.time() if now ~= window then window, counts, size = now, {}, 0 end if counts[key] == nil then if size >= maxKeys then key = "" else size = size + 1 end end local count = (counts[key] or 0) + 1 counts[key] = count if count > limit then return -1, timestamp, record end return 0, timestamp, record end
+
+`
+	logPipeline := &telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-logpipeline"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Input: telemetryv1alpha1.Input{
+				Application: telemetryv1alpha1.ApplicationInput{
+					Throttle: &telemetryv1alpha1.ThrottleConfig{
+						RecordsPerSecond: 50,
+						Per:              telemetryv1alpha1.ThrottleScopeContainer,
+					},
+				}}}}
+
+	actual := createThrottleFilter(logPipeline)
+	require.Equal(t, expected, actual)
+}
@@ -3,5 +3,6 @@ package ports
 const (
 	HTTP            = 2020
 	ExporterMetrics = 2021
+	TestData        = 2022
 	IstioEnvoy      = 15090
 )
@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zapr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// WithLevel returns a logger that writes all entries from the given level on, regardless of the level of the underlying zap core.
+// It is used to change the verbosity for a single reconciliation. Loggers that are not backed by zap are returned unchanged.
+func WithLevel(log logr.Logger, level zapcore.Level) logr.Logger {
+	underlier, ok := log.GetSink().(zapr.Underlier)
+	if !ok {
+		return log
+	}
+
+	zapLogger := underlier.GetUnderlying().WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &levelCore{Core: core, level: level}
+	}))
+
+	return zapr.NewLogger(zapLogger)
+}
+
+// levelCore replaces the level of the wrapped core. Entries are passed to the Write method of the wrapped core, which does not check the level again.
+type levelCore struct {
+	zapcore.Core
+
+	level zapcore.Level
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return level >= c.level
+}
+
+func (c *levelCore) Level() zapcore.Level {
+	return c.level
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
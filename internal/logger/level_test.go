@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zapr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWithLevel(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	log := zapr.NewLogger(zap.New(core)).WithValues("pipeline", "test")
+
+	log.V(1).Info("debug before")
+
+	debugLog := WithLevel(log, zapcore.DebugLevel)
+	debugLog.V(1).Info("debug after")
+	debugLog.Info("info after")
+
+	log.V(1).Info("debug on original logger")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+	require.Equal(t, "debug after", entries[0].Message)
+	require.Equal(t, zapcore.DebugLevel, entries[0].Level)
+	require.Equal(t, "test", entries[0].ContextMap()["pipeline"], "values of the original logger must be kept")
+	require.Equal(t, "info after", entries[1].Message)
+}
+
+func TestWithLevelNonZapLogger(t *testing.T) {
+	log := logr.Discard()
+	require.Equal(t, log, WithLevel(log, zapcore.DebugLevel))
+}
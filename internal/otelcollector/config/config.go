@@ -14,6 +14,20 @@ type Base struct {
 type Extensions struct {
 	HealthCheck Endpoint `yaml:"health_check,omitempty"`
 	Pprof       Endpoint `yaml:"pprof,omitempty"`
+
+	OAuth2Clients OAuth2ClientExtensions `yaml:",inline,omitempty"`
+}
+
+// OAuth2ClientExtensions maps the IDs of oauth2client extensions to their configuration.
+type OAuth2ClientExtensions map[string]*OAuth2ClientExtension
+
+type OAuth2ClientExtension struct {
+	ClientID       string              `yaml:"client_id"`
+	ClientSecret   string              `yaml:"client_secret"`
+	TokenURL       string              `yaml:"token_url"`
+	Scopes         []string            `yaml:"scopes,omitempty"`
+	EndpointParams map[string][]string `yaml:"endpoint_params,omitempty"`
+	Timeout        string              `yaml:"timeout,omitempty"`
 }
 
 type Endpoint struct {
@@ -1,21 +1,38 @@
 package config
 
+import (
+	"strings"
+
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
+)
+
 type OTLPExporter struct {
 	MetricsEndpoint string            `yaml:"metrics_endpoint,omitempty"`
 	TracesEndpoint  string            `yaml:"traces_endpoint,omitempty"`
 	Endpoint        string            `yaml:"endpoint,omitempty"`
 	Headers         map[string]string `yaml:"headers,omitempty"`
+	Auth            *Auth             `yaml:"auth,omitempty"`
 	TLS             TLS               `yaml:"tls,omitempty"`
+	Compression     string            `yaml:"compression,omitempty"`
+	Timeout         string            `yaml:"timeout,omitempty"`
+	ProxyURL        string            `yaml:"proxy_url,omitempty"`
 	SendingQueue    SendingQueue      `yaml:"sending_queue,omitempty"`
 	RetryOnFailure  RetryOnFailure    `yaml:"retry_on_failure,omitempty"`
 }
 
+type Auth struct {
+	Authenticator string `yaml:"authenticator"`
+}
+
 type TLS struct {
-	Insecure           bool   `yaml:"insecure"`
-	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
-	CertPem            string `yaml:"cert_pem,omitempty"`
-	KeyPem             string `yaml:"key_pem,omitempty"`
-	CAPem              string `yaml:"ca_pem,omitempty"`
+	Insecure           bool     `yaml:"insecure"`
+	InsecureSkipVerify bool     `yaml:"insecure_skip_verify,omitempty"`
+	CertPem            string   `yaml:"cert_pem,omitempty"`
+	KeyPem             string   `yaml:"key_pem,omitempty"`
+	CAPem              string   `yaml:"ca_pem,omitempty"`
+	MinVersion         string   `yaml:"min_version,omitempty"`
+	MaxVersion         string   `yaml:"max_version,omitempty"`
+	CipherSuites       []string `yaml:"cipher_suites,omitempty"`
 }
 
 type SendingQueue struct {
@@ -25,7 +42,43 @@ type SendingQueue struct {
 
 type RetryOnFailure struct {
 	Enabled         bool   `yaml:"enabled"`
-	InitialInterval string `yaml:"initial_interval"`
-	MaxInterval     string `yaml:"max_interval"`
-	MaxElapsedTime  string `yaml:"max_elapsed_time"`
+	InitialInterval string `yaml:"initial_interval,omitempty"`
+	MaxInterval     string `yaml:"max_interval,omitempty"`
+	MaxElapsedTime  string `yaml:"max_elapsed_time,omitempty"`
+}
+
+type KafkaExporter struct {
+	Brokers         []string       `yaml:"brokers"`
+	Topic           string         `yaml:"topic"`
+	Encoding        string         `yaml:"encoding"`
+	ProtocolVersion string         `yaml:"protocol_version"`
+	Auth            KafkaAuth      `yaml:"auth,omitempty"`
+	SendingQueue    SendingQueue   `yaml:"sending_queue,omitempty"`
+	RetryOnFailure  RetryOnFailure `yaml:"retry_on_failure,omitempty"`
+}
+
+type KafkaAuth struct {
+	SASL *KafkaSASL `yaml:"sasl,omitempty"`
+	TLS  *TLS       `yaml:"tls,omitempty"`
+}
+
+type KafkaSASL struct {
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
+	Mechanism string `yaml:"mechanism"`
+}
+
+// DebugExporter logs the data that passes a pipeline. It is only added if it is requested in the overrides for a pipeline.
+type DebugExporter struct {
+	Verbosity string `yaml:"verbosity"`
+}
+
+func DebugExporterID(pipelineName string) string {
+	return "debug/" + pipelineName
+}
+
+// TestDataExporterID returns the ID of the copy of the given exporter of a pipeline, which only exports the test data of the pipeline.
+func TestDataExporterID(exporterID, pipelineName string) string {
+	exporterType, _, _ := strings.Cut(exporterID, "/")
+	return exporterType + "/" + synthetic.TestDataName(pipelineName)
 }
@@ -0,0 +1,214 @@
+package kafkaexporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpexporter"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+const (
+	// protocolVersion is the Kafka protocol version used by the exporter. All Kafka versions since 2.0.0 support it.
+	protocolVersion = "2.0.0"
+
+	saslUsernameVariablePrefix  = "KAFKA_SASL_USERNAME"
+	saslPasswordVariablePrefix  = "KAFKA_SASL_PASSWORD"
+	tlsConfigCertVariablePrefix = "KAFKA_TLS_CERT_PEM"
+	tlsConfigKeyVariablePrefix  = "KAFKA_TLS_KEY_PEM"
+	tlsConfigCaVariablePrefix   = "KAFKA_TLS_CA_PEM"
+)
+
+var (
+	ErrValueOrSecretRefUndefined = errors.New("either value or secret key reference must be defined")
+)
+
+type ConfigBuilder struct {
+	reader       client.Reader
+	kafkaOutput  *telemetryv1alpha1.KafkaOutput
+	pipelineName string
+	queueSize    int
+}
+
+func NewConfigBuilder(reader client.Reader, kafkaOutput *telemetryv1alpha1.KafkaOutput, pipelineName string, queueSize int) *ConfigBuilder {
+	return &ConfigBuilder{
+		reader:       reader,
+		kafkaOutput:  kafkaOutput,
+		pipelineName: pipelineName,
+		queueSize:    queueSize,
+	}
+}
+
+func (cb *ConfigBuilder) MakeConfig(ctx context.Context) (*config.KafkaExporter, otlpexporter.EnvVars, error) {
+	envVars, err := makeEnvVars(ctx, cb.reader, cb.kafkaOutput, cb.pipelineName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to make env vars: %w", err)
+	}
+
+	return makeExporterConfig(cb.kafkaOutput, cb.pipelineName, cb.queueSize), envVars, nil
+}
+
+func ExporterID(pipelineName string) string {
+	return fmt.Sprintf("kafka/%s", pipelineName)
+}
+
+func makeExporterConfig(kafkaOutput *telemetryv1alpha1.KafkaOutput, pipelineName string, queueSize int) *config.KafkaExporter {
+	encoding := kafkaOutput.Encoding
+	if encoding == "" {
+		encoding = telemetryv1alpha1.KafkaEncodingOTLPProto
+	}
+
+	exporterConfig := config.KafkaExporter{
+		Brokers:         kafkaOutput.Brokers,
+		Topic:           kafkaOutput.Topic,
+		Encoding:        encoding,
+		ProtocolVersion: protocolVersion,
+		SendingQueue: config.SendingQueue{
+			Enabled:   true,
+			QueueSize: queueSize,
+		},
+		RetryOnFailure: config.RetryOnFailure{
+			Enabled:         true,
+			InitialInterval: "5s",
+			MaxInterval:     "30s",
+			MaxElapsedTime:  "300s",
+		},
+	}
+
+	if sasl := saslOptions(kafkaOutput); sasl != nil {
+		mechanism := sasl.Mechanism
+		if mechanism == "" {
+			mechanism = telemetryv1alpha1.KafkaSASLMechanismPlain
+		}
+
+		exporterConfig.Auth.SASL = &config.KafkaSASL{
+			Username:  fmt.Sprintf("${%s}", makeVariable(saslUsernameVariablePrefix, pipelineName)),
+			Password:  fmt.Sprintf("${%s}", makeVariable(saslPasswordVariablePrefix, pipelineName)),
+			Mechanism: mechanism,
+		}
+	}
+
+	exporterConfig.Auth.TLS = makeTLSConfig(kafkaOutput.TLS, pipelineName)
+
+	return &exporterConfig
+}
+
+// makeTLSConfig returns the TLS configuration of the exporter. Without a TLS configuration, the Kafka exporter connects in plaintext,
+// so TLS is only left out if the output is explicitly marked as insecure.
+func makeTLSConfig(tls *telemetryv1alpha1.OtlpTLS, pipelineName string) *config.TLS {
+	if tls == nil {
+		return &config.TLS{}
+	}
+
+	if tls.Insecure {
+		return nil
+	}
+
+	cfg := config.TLS{
+		InsecureSkipVerify: tls.InsecureSkipVerify,
+		MinVersion:         tls.MinVersion,
+		MaxVersion:         tls.MaxVersion,
+		CipherSuites:       tls.CipherSuites,
+	}
+
+	if tls.GetCA().IsDefined() {
+		cfg.CAPem = fmt.Sprintf("${%s}", makeVariable(tlsConfigCaVariablePrefix, pipelineName))
+	}
+
+	if tls.GetCert().IsDefined() && tls.GetKey().IsDefined() {
+		cfg.CertPem = fmt.Sprintf("${%s}", makeVariable(tlsConfigCertVariablePrefix, pipelineName))
+		cfg.KeyPem = fmt.Sprintf("${%s}", makeVariable(tlsConfigKeyVariablePrefix, pipelineName))
+	}
+
+	return &cfg
+}
+
+func makeEnvVars(ctx context.Context, c client.Reader, kafkaOutput *telemetryv1alpha1.KafkaOutput, pipelineName string) (otlpexporter.EnvVars, error) {
+	secretData := make(otlpexporter.EnvVars)
+
+	if sasl := saslOptions(kafkaOutput); sasl != nil {
+		if err := resolveInto(ctx, c, secretData, sasl.User, makeVariable(saslUsernameVariablePrefix, pipelineName)); err != nil {
+			return nil, err
+		}
+
+		if err := resolveInto(ctx, c, secretData, sasl.Password, makeVariable(saslPasswordVariablePrefix, pipelineName)); err != nil {
+			return nil, err
+		}
+	}
+
+	tls := kafkaOutput.TLS
+	if tls == nil || tls.Insecure {
+		return secretData, nil
+	}
+
+	if tls.GetCA().IsDefined() {
+		if err := resolveInto(ctx, c, secretData, *tls.GetCA(), makeVariable(tlsConfigCaVariablePrefix, pipelineName)); err != nil {
+			return nil, err
+		}
+	}
+
+	if tls.GetCert().IsDefined() && tls.GetKey().IsDefined() {
+		certVariable := makeVariable(tlsConfigCertVariablePrefix, pipelineName)
+		if err := resolveInto(ctx, c, secretData, *tls.GetCert(), certVariable); err != nil {
+			return nil, err
+		}
+
+		keyVariable := makeVariable(tlsConfigKeyVariablePrefix, pipelineName)
+		if err := resolveInto(ctx, c, secretData, *tls.GetKey(), keyVariable); err != nil {
+			return nil, err
+		}
+
+		// Make a best effort replacement of linebreaks in cert/key if present.
+		secretData[certVariable] = bytes.ReplaceAll(secretData[certVariable], []byte("\\n"), []byte("\n"))
+		secretData[keyVariable] = bytes.ReplaceAll(secretData[keyVariable], []byte("\\n"), []byte("\n"))
+	}
+
+	return secretData, nil
+}
+
+func saslOptions(kafkaOutput *telemetryv1alpha1.KafkaOutput) *telemetryv1alpha1.KafkaSASLOptions {
+	if kafkaOutput.Authentication == nil || !kafkaOutput.Authentication.SASL.IsDefined() {
+		return nil
+	}
+
+	return kafkaOutput.Authentication.SASL
+}
+
+func resolveInto(ctx context.Context, c client.Reader, secretData otlpexporter.EnvVars, value telemetryv1alpha1.ValueType, variable string) error {
+	if value.Value != "" {
+		secretData[variable] = []byte(value.Value)
+		return nil
+	}
+
+	if value.ValueFrom == nil || !value.ValueFrom.IsSecretKeyRef() {
+		return ErrValueOrSecretRefUndefined
+	}
+
+	resolved, err := secretref.GetValue(ctx, c, *value.ValueFrom.SecretKeyRef)
+	if err != nil {
+		return err
+	}
+
+	secretData[variable] = resolved
+
+	return nil
+}
+
+func makeVariable(prefix, pipelineName string) string {
+	return fmt.Sprintf("%s_%s", prefix, sanitizeEnvVarName(pipelineName))
+}
+
+func sanitizeEnvVarName(input string) string {
+	result := strings.ToUpper(input)
+	result = strings.ReplaceAll(result, ".", "_")
+	result = strings.ReplaceAll(result, "-", "_")
+
+	return result
+}
@@ -0,0 +1,175 @@
+package kafkaexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestExporterID(t *testing.T) {
+	require.Equal(t, "kafka/test", ExporterID("test"))
+}
+
+func TestMakeConfig(t *testing.T) {
+	output := &telemetryv1alpha1.KafkaOutput{
+		Brokers: []string{"broker-1:9092", "broker-2:9092"},
+		Topic:   "traces",
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512)
+	kafkaExporterConfig, envVars, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, envVars)
+
+	require.Equal(t, []string{"broker-1:9092", "broker-2:9092"}, kafkaExporterConfig.Brokers)
+	require.Equal(t, "traces", kafkaExporterConfig.Topic)
+	require.Equal(t, "otlp_proto", kafkaExporterConfig.Encoding)
+	require.Equal(t, "2.0.0", kafkaExporterConfig.ProtocolVersion)
+	require.Nil(t, kafkaExporterConfig.Auth.SASL)
+	require.NotNil(t, kafkaExporterConfig.Auth.TLS, "TLS must be enabled by default")
+
+	require.True(t, kafkaExporterConfig.SendingQueue.Enabled)
+	require.Equal(t, 512, kafkaExporterConfig.SendingQueue.QueueSize)
+
+	require.True(t, kafkaExporterConfig.RetryOnFailure.Enabled)
+	require.Equal(t, "5s", kafkaExporterConfig.RetryOnFailure.InitialInterval)
+	require.Equal(t, "30s", kafkaExporterConfig.RetryOnFailure.MaxInterval)
+	require.Equal(t, "300s", kafkaExporterConfig.RetryOnFailure.MaxElapsedTime)
+}
+
+func TestMakeConfigWithEncoding(t *testing.T) {
+	output := &telemetryv1alpha1.KafkaOutput{
+		Brokers:  []string{"broker:9092"},
+		Topic:    "traces",
+		Encoding: telemetryv1alpha1.KafkaEncodingOTLPJSON,
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512)
+	kafkaExporterConfig, _, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "otlp_json", kafkaExporterConfig.Encoding)
+}
+
+func TestMakeConfigWithSASL(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kafka-credentials",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"password": []byte("secret-password"),
+		},
+	}
+
+	output := &telemetryv1alpha1.KafkaOutput{
+		Brokers: []string{"broker:9092"},
+		Topic:   "traces",
+		Authentication: &telemetryv1alpha1.KafkaAuthenticationOptions{
+			SASL: &telemetryv1alpha1.KafkaSASLOptions{
+				Mechanism: telemetryv1alpha1.KafkaSASLMechanismSCRAMSHA512,
+				User:      telemetryv1alpha1.ValueType{Value: "user"},
+				Password: telemetryv1alpha1.ValueType{
+					ValueFrom: &telemetryv1alpha1.ValueFromSource{
+						SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+							Name:      "kafka-credentials",
+							Namespace: "default",
+							Key:       "password",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().WithObjects(secret).Build(), output, "test-pipeline", 512)
+	kafkaExporterConfig, envVars, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []byte("user"), envVars["KAFKA_SASL_USERNAME_TEST_PIPELINE"])
+	require.Equal(t, []byte("secret-password"), envVars["KAFKA_SASL_PASSWORD_TEST_PIPELINE"])
+
+	require.NotNil(t, kafkaExporterConfig.Auth.SASL)
+	require.Equal(t, "${KAFKA_SASL_USERNAME_TEST_PIPELINE}", kafkaExporterConfig.Auth.SASL.Username)
+	require.Equal(t, "${KAFKA_SASL_PASSWORD_TEST_PIPELINE}", kafkaExporterConfig.Auth.SASL.Password)
+	require.Equal(t, "SCRAM-SHA-512", kafkaExporterConfig.Auth.SASL.Mechanism)
+}
+
+func TestMakeConfigWithMissingSecret(t *testing.T) {
+	output := &telemetryv1alpha1.KafkaOutput{
+		Brokers: []string{"broker:9092"},
+		Topic:   "traces",
+		Authentication: &telemetryv1alpha1.KafkaAuthenticationOptions{
+			SASL: &telemetryv1alpha1.KafkaSASLOptions{
+				User: telemetryv1alpha1.ValueType{Value: "user"},
+				Password: telemetryv1alpha1.ValueType{
+					ValueFrom: &telemetryv1alpha1.ValueFromSource{
+						SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+							Name:      "non-existing",
+							Namespace: "default",
+							Key:       "password",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512)
+	_, _, err := cb.MakeConfig(context.Background())
+	require.Error(t, err)
+}
+
+func TestMakeConfigWithTLS(t *testing.T) {
+	output := &telemetryv1alpha1.KafkaOutput{
+		Brokers: []string{"broker:9093"},
+		Topic:   "traces",
+		TLS: &telemetryv1alpha1.OtlpTLS{
+			InsecureSkipVerify: true,
+			CA:                 &telemetryv1alpha1.ValueType{Value: "ca"},
+			Cert:               &telemetryv1alpha1.ValueType{Value: "cert\\nline"},
+			Key:                &telemetryv1alpha1.ValueType{Value: "key"},
+			MinVersion:         "1.2",
+			CipherSuites:       []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+		},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512)
+	kafkaExporterConfig, envVars, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []byte("ca"), envVars["KAFKA_TLS_CA_PEM_TEST"])
+	require.Equal(t, []byte("cert\nline"), envVars["KAFKA_TLS_CERT_PEM_TEST"])
+	require.Equal(t, []byte("key"), envVars["KAFKA_TLS_KEY_PEM_TEST"])
+
+	require.NotNil(t, kafkaExporterConfig.Auth.TLS)
+	require.True(t, kafkaExporterConfig.Auth.TLS.InsecureSkipVerify)
+	require.Equal(t, "${KAFKA_TLS_CA_PEM_TEST}", kafkaExporterConfig.Auth.TLS.CAPem)
+	require.Equal(t, "${KAFKA_TLS_CERT_PEM_TEST}", kafkaExporterConfig.Auth.TLS.CertPem)
+	require.Equal(t, "${KAFKA_TLS_KEY_PEM_TEST}", kafkaExporterConfig.Auth.TLS.KeyPem)
+	require.Equal(t, "1.2", kafkaExporterConfig.Auth.TLS.MinVersion)
+	require.Empty(t, kafkaExporterConfig.Auth.TLS.MaxVersion)
+	require.Equal(t, []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"}, kafkaExporterConfig.Auth.TLS.CipherSuites)
+}
+
+func TestMakeConfigInsecure(t *testing.T) {
+	output := &telemetryv1alpha1.KafkaOutput{
+		Brokers: []string{"broker:9092"},
+		Topic:   "traces",
+		TLS: &telemetryv1alpha1.OtlpTLS{
+			Insecure: true,
+			CA:       &telemetryv1alpha1.ValueType{Value: "ca"},
+		},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512)
+	kafkaExporterConfig, envVars, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, envVars)
+	require.Nil(t, kafkaExporterConfig.Auth.TLS)
+}
@@ -163,10 +163,14 @@ type Processors struct {
 	InsertSkipEnrichmentAttribute     *metric.TransformProcessor `yaml:"transform/insert-skip-enrichment-attribute,omitempty"`
 	DropK8sClusterMetrics             *FilterProcessor           `yaml:"filter/drop-k8s-cluster-metrics,omitempty"`
 	DropNonPVCVolumesMetrics          *FilterProcessor           `yaml:"filter/drop-non-pvc-volumes-metrics,omitempty"`
+	DeleteTargetNamespace             *metric.TransformProcessor `yaml:"transform/delete-target-namespace,omitempty"`
+	KeepScrapeTargetUp                *FilterProcessor           `yaml:"filter/keep-scrape-target-up,omitempty"`
+	RenameScrapeTargetUp              *metric.TransformProcessor `yaml:"transform/rename-scrape-target-up,omitempty"`
 }
 
 type Exporters struct {
-	OTLP config.OTLPExporter `yaml:"otlp"`
+	OTLP             config.OTLPExporter  `yaml:"otlp"`
+	OTLPScrapeHealth *config.OTLPExporter `yaml:"otlphttp/scrape-health,omitempty"`
 }
 
 type FilterProcessor struct {
@@ -9,10 +9,15 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+	selfmonitorports "github.com/kyma-project/telemetry-manager/internal/selfmonitor/ports"
 )
 
+// scrapeTargetUpMetricName is the name under which the up metric of the Prometheus scrape targets is pushed to the self monitor
+const scrapeTargetUpMetricName = "scrape_target_up"
+
 type BuilderConfig struct {
 	GatewayOTLPServiceName types.NamespacedName
+	SelfMonitorServiceName types.NamespacedName
 }
 
 type Builder struct {
@@ -55,7 +60,7 @@ func (b *Builder) Build(pipelines []telemetryv1alpha1.MetricPipeline, opts Build
 		},
 		Receivers:  makeReceiversConfig(inputs, opts),
 		Processors: makeProcessorsConfig(inputs, opts.InstrumentationScopeVersion),
-		Exporters:  makeExportersConfig(b.Config.GatewayOTLPServiceName),
+		Exporters:  makeExportersConfig(b.Config, inputs),
 	}
 }
 
@@ -146,8 +151,9 @@ func enableIstioMetricsScraping(pipelines []telemetryv1alpha1.MetricPipeline) bo
 }
 
 //nolint:mnd // all static config from here
-func makeExportersConfig(gatewayServiceName types.NamespacedName) Exporters {
-	return Exporters{
+func makeExportersConfig(builderConfig BuilderConfig, inputs inputSources) Exporters {
+	gatewayServiceName := builderConfig.GatewayOTLPServiceName
+	exportersConfig := Exporters{
 		OTLP: config.OTLPExporter{
 			Endpoint: fmt.Sprintf("%s.%s.svc.cluster.local:%d", gatewayServiceName.Name, gatewayServiceName.Namespace, ports.OTLPGRPC),
 			TLS: config.TLS{
@@ -165,6 +171,26 @@ func makeExportersConfig(gatewayServiceName types.NamespacedName) Exporters {
 			},
 		},
 	}
+
+	if inputs.prometheus {
+		// The self monitor accepts the health of the scrape targets with its OTLP receiver (Prometheus feature otlp-write-receiver)
+		selfMonitorServiceName := builderConfig.SelfMonitorServiceName
+		exportersConfig.OTLPScrapeHealth = &config.OTLPExporter{
+			MetricsEndpoint: fmt.Sprintf("http://%s.%s.svc.cluster.local:%d/api/v1/otlp/v1/metrics", selfMonitorServiceName.Name, selfMonitorServiceName.Namespace, selfmonitorports.PrometheusPort),
+			SendingQueue: config.SendingQueue{
+				Enabled:   true,
+				QueueSize: 64,
+			},
+			RetryOnFailure: config.RetryOnFailure{
+				Enabled:         true,
+				InitialInterval: "5s",
+				MaxInterval:     "30s",
+				MaxElapsedTime:  "60s",
+			},
+		}
+	}
+
+	return exportersConfig
 }
 
 func makePipelinesConfig(inputs inputSources) config.Pipelines {
@@ -181,9 +207,16 @@ func makePipelinesConfig(inputs inputSources) config.Pipelines {
 	if inputs.prometheus {
 		pipelinesConfig["metrics/prometheus"] = config.Pipeline{
 			Receivers:  []string{"prometheus/app-pods", "prometheus/app-services"},
-			Processors: []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"},
+			Processors: []string{"memory_limiter", "transform/delete-target-namespace", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"},
 			Exporters:  []string{"otlp"},
 		}
+
+		// The health of the scrape targets is pushed to the self monitor, which reports scrape targets that are down
+		pipelinesConfig["metrics/scrape-health"] = config.Pipeline{
+			Receivers:  []string{"prometheus/app-pods", "prometheus/app-services"},
+			Processors: []string{"memory_limiter", "filter/keep-scrape-target-up", "transform/rename-scrape-target-up"},
+			Exporters:  []string{"otlphttp/scrape-health"},
+		}
 	}
 
 	if inputs.istio {
@@ -17,9 +17,11 @@ import (
 
 func TestBuildAgentConfig(t *testing.T) {
 	gatewayServiceName := types.NamespacedName{Name: "metrics", Namespace: "telemetry-system"}
+	selfMonitorServiceName := types.NamespacedName{Name: "self-monitor", Namespace: "telemetry-system"}
 	sut := Builder{
 		Config: BuilderConfig{
 			GatewayOTLPServiceName: gatewayServiceName,
+			SelfMonitorServiceName: selfMonitorServiceName,
 		},
 	}
 
@@ -125,11 +127,17 @@ func TestBuildAgentConfig(t *testing.T) {
 			require.Nil(t, collectorConfig.Processors.SetInstrumentationScopeRuntime)
 			require.Nil(t, collectorConfig.Processors.SetInstrumentationScopeIstio)
 
-			require.Len(t, collectorConfig.Service.Pipelines, 1)
+			require.Len(t, collectorConfig.Service.Pipelines, 2)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/prometheus")
 			require.Equal(t, []string{"prometheus/app-pods", "prometheus/app-services"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Receivers)
-			require.Equal(t, []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
+			require.Equal(t, []string{"memory_limiter", "transform/delete-target-namespace", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
 			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Exporters)
+			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/scrape-health")
+			require.Equal(t, []string{"prometheus/app-pods", "prometheus/app-services"}, collectorConfig.Service.Pipelines["metrics/scrape-health"].Receivers)
+			require.Equal(t, []string{"memory_limiter", "filter/keep-scrape-target-up", "transform/rename-scrape-target-up"}, collectorConfig.Service.Pipelines["metrics/scrape-health"].Processors)
+			require.Equal(t, []string{"otlphttp/scrape-health"}, collectorConfig.Service.Pipelines["metrics/scrape-health"].Exporters)
+			require.NotNil(t, collectorConfig.Exporters.OTLPScrapeHealth)
+			require.Equal(t, "http://self-monitor.telemetry-system.svc.cluster.local:9090/api/v1/otlp/v1/metrics", collectorConfig.Exporters.OTLPScrapeHealth.MetricsEndpoint)
 		})
 
 		t.Run("istio input enabled", func(t *testing.T) {
@@ -141,6 +149,7 @@ func TestBuildAgentConfig(t *testing.T) {
 			require.NotNil(t, collectorConfig.Processors.SetInstrumentationScopeIstio)
 			require.Nil(t, collectorConfig.Processors.SetInstrumentationScopeRuntime)
 			require.Nil(t, collectorConfig.Processors.SetInstrumentationScopePrometheus)
+			require.Nil(t, collectorConfig.Exporters.OTLPScrapeHealth)
 
 			require.Len(t, collectorConfig.Service.Pipelines, 1)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/istio")
@@ -159,14 +168,14 @@ func TestBuildAgentConfig(t *testing.T) {
 			require.NotNil(t, collectorConfig.Processors.SetInstrumentationScopePrometheus)
 			require.NotNil(t, collectorConfig.Processors.SetInstrumentationScopeIstio)
 
-			require.Len(t, collectorConfig.Service.Pipelines, 3)
+			require.Len(t, collectorConfig.Service.Pipelines, 4)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/runtime")
 			require.Equal(t, []string{"kubeletstats", "singleton_receiver_creator/k8s_cluster"}, collectorConfig.Service.Pipelines["metrics/runtime"].Receivers)
 			require.Equal(t, []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-runtime", "transform/insert-skip-enrichment-attribute", "filter/drop-k8s-cluster-metrics", "batch"}, collectorConfig.Service.Pipelines["metrics/runtime"].Processors)
 			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/runtime"].Exporters)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/prometheus")
 			require.Equal(t, []string{"prometheus/app-pods", "prometheus/app-services"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Receivers)
-			require.Equal(t, []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
+			require.Equal(t, []string{"memory_limiter", "transform/delete-target-namespace", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
 			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Exporters)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/istio")
 			require.Equal(t, []string{"prometheus/istio"}, collectorConfig.Service.Pipelines["metrics/istio"].Receivers)
@@ -237,10 +246,10 @@ func TestBuildAgentConfig(t *testing.T) {
 			require.NotNil(t, collectorConfig.Processors.SetInstrumentationScopePrometheus)
 			require.Nil(t, collectorConfig.Processors.SetInstrumentationScopeIstio)
 
-			require.Len(t, collectorConfig.Service.Pipelines, 1)
+			require.Len(t, collectorConfig.Service.Pipelines, 2)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/prometheus")
 			require.Equal(t, []string{"prometheus/app-pods", "prometheus/app-services"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Receivers)
-			require.Equal(t, []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
+			require.Equal(t, []string{"memory_limiter", "transform/delete-target-namespace", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
 			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Exporters)
 		})
 
@@ -254,10 +263,10 @@ func TestBuildAgentConfig(t *testing.T) {
 			require.Nil(t, collectorConfig.Processors.SetInstrumentationScopeRuntime)
 			require.NotNil(t, collectorConfig.Processors.SetInstrumentationScopePrometheus)
 
-			require.Len(t, collectorConfig.Service.Pipelines, 1)
+			require.Len(t, collectorConfig.Service.Pipelines, 2)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/prometheus")
 			require.Equal(t, []string{"prometheus/app-pods", "prometheus/app-services"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Receivers)
-			require.Equal(t, []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
+			require.Equal(t, []string{"memory_limiter", "transform/delete-target-namespace", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
 			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Exporters)
 		})
 
@@ -271,14 +280,14 @@ func TestBuildAgentConfig(t *testing.T) {
 			require.NotNil(t, collectorConfig.Processors.SetInstrumentationScopeRuntime)
 			require.NotNil(t, collectorConfig.Processors.SetInstrumentationScopeRuntime)
 
-			require.Len(t, collectorConfig.Service.Pipelines, 2)
+			require.Len(t, collectorConfig.Service.Pipelines, 3)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/runtime")
 			require.Equal(t, []string{"kubeletstats", "singleton_receiver_creator/k8s_cluster"}, collectorConfig.Service.Pipelines["metrics/runtime"].Receivers)
 			require.Equal(t, []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-runtime", "transform/insert-skip-enrichment-attribute", "filter/drop-k8s-cluster-metrics", "batch"}, collectorConfig.Service.Pipelines["metrics/runtime"].Processors)
 			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/runtime"].Exporters)
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/prometheus")
 			require.Equal(t, []string{"prometheus/app-pods", "prometheus/app-services"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Receivers)
-			require.Equal(t, []string{"memory_limiter", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
+			require.Equal(t, []string{"memory_limiter", "transform/delete-target-namespace", "resource/delete-service-name", "transform/set-instrumentation-scope-prometheus", "batch"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Processors)
 			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/prometheus"].Exporters)
 		})
 	})
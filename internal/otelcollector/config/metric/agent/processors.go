@@ -31,6 +31,9 @@ func makeProcessorsConfig(inputs inputSources, instrumentationScopeVersion strin
 
 		if inputs.prometheus {
 			processorsConfig.SetInstrumentationScopePrometheus = metric.MakeInstrumentationScopeProcessor(instrumentationScopeVersion, metric.InputSourcePrometheus)
+			processorsConfig.DeleteTargetNamespace = makeDeleteTargetNamespaceProcessor()
+			processorsConfig.KeepScrapeTargetUp = makeKeepScrapeTargetUpProcessor()
+			processorsConfig.RenameScrapeTargetUp = makeRenameScrapeTargetUpProcessor()
 		}
 
 		if inputs.istio {
@@ -128,3 +131,43 @@ func makeDropNonPVCVolumesMetricsProcessor() *FilterProcessor {
 		},
 	}
 }
+
+func makeDeleteTargetNamespaceProcessor() *metric.TransformProcessor {
+	return &metric.TransformProcessor{
+		ErrorMode: "ignore",
+		MetricStatements: []config.TransformProcessorStatements{
+			{
+				Context: "datapoint",
+				Statements: []string{
+					fmt.Sprintf("delete_key(attributes, \"%s\")", targetNamespaceLabel),
+				},
+			},
+		},
+	}
+}
+
+// makeKeepScrapeTargetUpProcessor drops all scraped metrics except for the up metric, which reports the health of each scrape target
+func makeKeepScrapeTargetUpProcessor() *FilterProcessor {
+	return &FilterProcessor{
+		Metrics: FilterProcessorMetrics{
+			Metric: []string{
+				"name != \"up\"",
+			},
+		},
+	}
+}
+
+// makeRenameScrapeTargetUpProcessor renames the up metric, so that it does not clash with the up metric of the self monitor's own scrape targets
+func makeRenameScrapeTargetUpProcessor() *metric.TransformProcessor {
+	return &metric.TransformProcessor{
+		ErrorMode: "ignore",
+		MetricStatements: []config.TransformProcessorStatements{
+			{
+				Context: "metric",
+				Statements: []string{
+					fmt.Sprintf("set(name, \"%s\") where name == \"up\"", scrapeTargetUpMetricName),
+				},
+			},
+		},
+	}
+}
@@ -27,6 +27,10 @@ const (
 	sampleLimit    = 50000
 )
 
+// targetNamespaceLabel holds the Namespace of an annotated scrape target, so that the scrape health can be reported per Namespace.
+// The label is removed before the scraped metrics are sent to the gateway.
+const targetNamespaceLabel = "target_namespace"
+
 // makePrometheusConfigForPods creates a Prometheus configuration for scraping Pods that are annotated with prometheus.io annotations.
 func makePrometheusConfigForPods(opts BuildOptions) *PrometheusReceiver {
 	return makePrometheusConfig(opts, "app-pods", RolePod, makePrometheusPodsRelabelConfigs)
@@ -91,7 +95,8 @@ func makePrometheusPodsRelabelConfigs(requireHTTPS bool) []RelabelConfig {
 
 	return append(relabelConfigs,
 		inferMetricsPathFromAnnotation(AnnotatedPod),
-		inferAddressFromAnnotation(AnnotatedPod))
+		inferAddressFromAnnotation(AnnotatedPod),
+		inferTargetNamespaceFromMetaLabel())
 }
 
 // makePrometheusEndpointsRelabelConfigs generates a set of relabel configs for the Endpoint role type.
@@ -120,7 +125,8 @@ func makePrometheusEndpointsRelabelConfigs(requireHTTPS bool) []RelabelConfig {
 	return append(relabelConfigs,
 		inferMetricsPathFromAnnotation(AnnotatedService),
 		inferAddressFromAnnotation(AnnotatedService),
-		inferServiceFromMetaLabel())
+		inferServiceFromMetaLabel(),
+		inferTargetNamespaceFromMetaLabel())
 }
 
 func makeTLSConfig(istioCertPath string) *TLSConfig {
@@ -251,6 +257,14 @@ func inferServiceFromMetaLabel() RelabelConfig {
 	}
 }
 
+func inferTargetNamespaceFromMetaLabel() RelabelConfig {
+	return RelabelConfig{
+		SourceLabels: []string{"__meta_kubernetes_namespace"},
+		Action:       Replace,
+		TargetLabel:  targetNamespaceLabel,
+	}
+}
+
 func dropIfPodNotRunning() RelabelConfig {
 	return RelabelConfig{
 		SourceLabels: []string{"__meta_kubernetes_pod_phase"},
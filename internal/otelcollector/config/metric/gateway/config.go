@@ -64,12 +64,14 @@ type Processors struct {
 	DropKymaAttributes                           *config.ResourceProcessor      `yaml:"resource/drop-kyma-attributes,omitempty"`
 	SetInstrumentationScopeKyma                  *metric.TransformProcessor     `yaml:"transform/set-instrumentation-scope-kyma,omitempty"`
 	DeleteSkipEnrichmentAttribute                *config.ResourceProcessor      `yaml:"resource/delete-skip-enrichment-attribute,omitempty"`
+	DropTestData                                 *FilterProcessor               `yaml:"filter/drop-test-data,omitempty"`
 
-	// NamespaceFilters contains filter processors, which need different configurations per pipeline
-	NamespaceFilters NamespaceFilters `yaml:",inline,omitempty"`
+	// PipelineFilters contains filter processors, which need different configurations per pipeline,
+	// such as the filters that keep only the test data of a pipeline or drop the metrics of namespaces that a pipeline does not select
+	PipelineFilters PipelineFilters `yaml:",inline,omitempty"`
 }
 
-type NamespaceFilters map[string]*FilterProcessor
+type PipelineFilters map[string]*FilterProcessor
 
 type FilterProcessor struct {
 	Metrics FilterProcessorMetrics `yaml:"metrics"`
@@ -82,7 +84,23 @@ type FilterProcessorMetrics struct {
 type Exporters map[string]Exporter
 
 type Exporter struct {
-	OTLP *config.OTLPExporter `yaml:",inline,omitempty"`
+	OTLP  *config.OTLPExporter  `yaml:",inline,omitempty"`
+	Kafka *config.KafkaExporter `yaml:",inline,omitempty"`
+	Debug *config.DebugExporter `yaml:",inline,omitempty"`
+}
+
+// MarshalYAML renders the exporter configuration that is set. Inlining several configurations at once is rejected by the YAML encoder
+// because of their overlapping keys.
+func (e Exporter) MarshalYAML() (any, error) {
+	if e.Kafka != nil {
+		return e.Kafka, nil
+	}
+
+	if e.Debug != nil {
+		return e.Debug, nil
+	}
+
+	return e.OTLP, nil
 }
 
 // Connectors is a map of connectors. The key is the name of the connector. The value is the connector configuration.
@@ -9,8 +9,10 @@ import (
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/kafkaexporter"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpexporter"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 )
 
 type Builder struct {
@@ -20,6 +22,10 @@ type Builder struct {
 type BuildOptions struct {
 	GatewayNamespace            string
 	InstrumentationScopeVersion string
+	// DebugExporterVerbosity is the verbosity of the debug exporter that is added to a pipeline, keyed by pipeline name.
+	DebugExporterVerbosity map[string]string
+	// PendingTestData marks the pipelines, keyed by pipeline name, whose test data is still pending. Only those get a test data pipeline.
+	PendingTestData map[string]bool
 }
 
 func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1alpha1.MetricPipeline, opts BuildOptions) (*Config, otlpexporter.EnvVars, error) {
@@ -45,14 +51,7 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1alpha1.Metri
 			continue
 		}
 
-		otlpExporterBuilder := otlpexporter.NewConfigBuilder(
-			b.Reader,
-			pipeline.Spec.Output.Otlp,
-			pipeline.Name,
-			queueSize,
-			otlpexporter.SignalTypeMetric,
-		)
-		if err := declareComponentsForMetricPipeline(ctx, otlpExporterBuilder, &pipeline, cfg, envVars, opts); err != nil {
+		if err := declareComponentsForMetricPipeline(ctx, b.Reader, &pipeline, queueSize, cfg, envVars, opts); err != nil {
 			return nil, nil, err
 		}
 
@@ -62,6 +61,14 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1alpha1.Metri
 		cfg.Service.Pipelines[inputPipelineID] = makeInputPipelineServiceConfig(&pipeline)
 		cfg.Service.Pipelines[attributesEnrichmentPipelineID] = makeAttributesEnrichmentPipelineServiceConfig(pipeline.Name)
 		cfg.Service.Pipelines[outputPipelineID] = makeOutputPipelineServiceConfig(&pipeline)
+
+		if opts.PendingTestData[pipeline.Name] {
+			cfg.Service.Pipelines[formatTestDataPipelineID(pipeline.Name)] = makeTestDataPipelineServiceConfig(&pipeline)
+		}
+
+		if verbosity := opts.DebugExporterVerbosity[pipeline.Name]; verbosity != "" {
+			declareDebugExporter(pipeline.Name, verbosity, outputPipelineID, cfg)
+		}
 	}
 
 	return cfg, envVars, nil
@@ -70,8 +77,9 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1alpha1.Metri
 // declareComponentsForMetricPipeline enriches a Config (receivers, processors, exporters etc.) with components for a given telemetryv1alpha1.MetricPipeline.
 func declareComponentsForMetricPipeline(
 	ctx context.Context,
-	otlpExporterBuilder *otlpexporter.ConfigBuilder,
+	reader client.Reader,
 	pipeline *telemetryv1alpha1.MetricPipeline,
+	queueSize int,
 	cfg *Config,
 	envVars otlpexporter.EnvVars,
 	opts BuildOptions,
@@ -80,11 +88,27 @@ func declareComponentsForMetricPipeline(
 	declareDiagnosticMetricsDropFilters(pipeline, cfg)
 	declareInputSourceFilters(pipeline, cfg)
 	declareRuntimeResourcesFilters(pipeline, cfg)
-	declareNamespaceFilters(pipeline, cfg)
+	declarePipelineFilters(pipeline, cfg)
 	declareInstrumentationScopeTransform(cfg, opts)
 	declareConnectors(pipeline.Name, cfg)
 
-	return declareOTLPExporter(ctx, otlpExporterBuilder, pipeline, cfg, envVars)
+	var err error
+	if pipeline.Spec.Output.Kafka != nil {
+		err = declareKafkaExporter(ctx, reader, pipeline, queueSize, cfg, envVars)
+	} else {
+		err = declareOTLPExporter(ctx, reader, pipeline, queueSize, cfg, envVars)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if opts.PendingTestData[pipeline.Name] {
+		declareKeepTestDataFilter(pipeline.Name, cfg)
+		declareTestDataExporter(pipeline, cfg)
+	}
+
+	return nil
 }
 
 func declareSingletonKymaStatsReceiverCreator(cfg *Config, opts BuildOptions) {
@@ -143,33 +167,42 @@ func declareRuntimeResourcesFilters(pipeline *telemetryv1alpha1.MetricPipeline,
 	}
 }
 
-func declareNamespaceFilters(pipeline *telemetryv1alpha1.MetricPipeline, cfg *Config) {
-	if cfg.Processors.NamespaceFilters == nil {
-		cfg.Processors.NamespaceFilters = make(NamespaceFilters)
+func declarePipelineFilters(pipeline *telemetryv1alpha1.MetricPipeline, cfg *Config) {
+	if cfg.Processors.PipelineFilters == nil {
+		cfg.Processors.PipelineFilters = make(PipelineFilters)
 	}
 
 	input := pipeline.Spec.Input
 	if metric.IsRuntimeInputEnabled(input) && shouldFilterByNamespace(input.Runtime.Namespaces) {
 		processorID := formatNamespaceFilterID(pipeline.Name, metric.InputSourceRuntime)
-		cfg.Processors.NamespaceFilters[processorID] = makeFilterByNamespaceRuntimeInputConfig(pipeline.Spec.Input.Runtime.Namespaces)
+		cfg.Processors.PipelineFilters[processorID] = makeFilterByNamespaceRuntimeInputConfig(pipeline.Spec.Input.Runtime.Namespaces)
 	}
 
 	if metric.IsPrometheusInputEnabled(input) && shouldFilterByNamespace(input.Prometheus.Namespaces) {
 		processorID := formatNamespaceFilterID(pipeline.Name, metric.InputSourcePrometheus)
-		cfg.Processors.NamespaceFilters[processorID] = makeFilterByNamespacePrometheusInputConfig(pipeline.Spec.Input.Prometheus.Namespaces)
+		cfg.Processors.PipelineFilters[processorID] = makeFilterByNamespacePrometheusInputConfig(pipeline.Spec.Input.Prometheus.Namespaces)
 	}
 
 	if metric.IsIstioInputEnabled(input) && shouldFilterByNamespace(input.Istio.Namespaces) {
 		processorID := formatNamespaceFilterID(pipeline.Name, metric.InputSourceIstio)
-		cfg.Processors.NamespaceFilters[processorID] = makeFilterByNamespaceIstioInputConfig(pipeline.Spec.Input.Istio.Namespaces)
+		cfg.Processors.PipelineFilters[processorID] = makeFilterByNamespaceIstioInputConfig(pipeline.Spec.Input.Istio.Namespaces)
 	}
 
 	if metric.IsOTLPInputEnabled(input) && input.Otlp != nil && shouldFilterByNamespace(input.Otlp.Namespaces) {
 		processorID := formatNamespaceFilterID(pipeline.Name, metric.InputSourceOtlp)
-		cfg.Processors.NamespaceFilters[processorID] = makeFilterByNamespaceOtlpInputConfig(pipeline.Spec.Input.Otlp.Namespaces)
+		cfg.Processors.PipelineFilters[processorID] = makeFilterByNamespaceOtlpInputConfig(pipeline.Spec.Input.Otlp.Namespaces)
 	}
 }
 
+// declareKeepTestDataFilter declares the filter that keeps only the test data of the pipeline in its test data pipeline.
+func declareKeepTestDataFilter(pipelineName string, cfg *Config) {
+	if cfg.Processors.PipelineFilters == nil {
+		cfg.Processors.PipelineFilters = make(PipelineFilters)
+	}
+
+	cfg.Processors.PipelineFilters[formatKeepTestDataFilterID(pipelineName)] = makeKeepTestDataConfig(pipelineName)
+}
+
 func declareInstrumentationScopeTransform(cfg *Config, opts BuildOptions) {
 	cfg.Processors.SetInstrumentationScopeKyma = metric.MakeInstrumentationScopeProcessor(opts.InstrumentationScopeVersion, metric.InputSourceKyma)
 }
@@ -182,7 +215,15 @@ func declareConnectors(pipelineName string, cfg *Config) {
 	cfg.Connectors[routingConnectorID] = makeRoutingConnectorConfig(pipelineName)
 }
 
-func declareOTLPExporter(ctx context.Context, otlpExporterBuilder *otlpexporter.ConfigBuilder, pipeline *telemetryv1alpha1.MetricPipeline, cfg *Config, envVars otlpexporter.EnvVars) error {
+func declareOTLPExporter(ctx context.Context, reader client.Reader, pipeline *telemetryv1alpha1.MetricPipeline, queueSize int, cfg *Config, envVars otlpexporter.EnvVars) error {
+	otlpExporterBuilder := otlpexporter.NewConfigBuilder(
+		reader,
+		pipeline.Spec.Output.Otlp,
+		pipeline.Name,
+		queueSize,
+		otlpexporter.SignalTypeMetric,
+	)
+
 	otlpExporterConfig, otlpExporterEnvVars, err := otlpExporterBuilder.MakeConfig(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to make otlp exporter config: %w", err)
@@ -190,9 +231,60 @@ func declareOTLPExporter(ctx context.Context, otlpExporterBuilder *otlpexporter.
 
 	maps.Copy(envVars, otlpExporterEnvVars)
 
-	exporterID := otlpexporter.ExporterID(pipeline.Spec.Output.Otlp.Protocol, pipeline.Name)
+	exporterID := formatExporterID(pipeline)
 	cfg.Exporters[exporterID] = Exporter{OTLP: otlpExporterConfig}
 
+	declareOAuth2Extension(otlpExporterBuilder, pipeline.Name, cfg)
+
+	return nil
+}
+
+// declareTestDataExporter declares a copy of the exporter of the pipeline, which only exports the test data of the pipeline.
+// The self monitor confirms the delivery of the test data by the export metrics of the copy.
+func declareTestDataExporter(pipeline *telemetryv1alpha1.MetricPipeline, cfg *Config) {
+	exporterID := formatExporterID(pipeline)
+	cfg.Exporters[formatTestDataExporterID(pipeline)] = cfg.Exporters[exporterID]
+}
+
+// declareDebugExporter adds a debug exporter to the output pipeline of a pipeline, so that the collector logs the exported data.
+func declareDebugExporter(pipelineName, verbosity, outputPipelineID string, cfg *Config) {
+	exporterID := config.DebugExporterID(pipelineName)
+	cfg.Exporters[exporterID] = Exporter{Debug: &config.DebugExporter{Verbosity: verbosity}}
+
+	outputPipeline := cfg.Service.Pipelines[outputPipelineID]
+	outputPipeline.Exporters = append(outputPipeline.Exporters, exporterID)
+	cfg.Service.Pipelines[outputPipelineID] = outputPipeline
+}
+
+// declareOAuth2Extension declares the oauth2client extension that the OTLP exporter of a pipeline authenticates with, if any.
+func declareOAuth2Extension(otlpExporterBuilder *otlpexporter.ConfigBuilder, pipelineName string, cfg *Config) {
+	extensionConfig := otlpExporterBuilder.MakeOAuth2ExtensionConfig()
+	if extensionConfig == nil {
+		return
+	}
+
+	if cfg.Extensions.OAuth2Clients == nil {
+		cfg.Extensions.OAuth2Clients = make(config.OAuth2ClientExtensions)
+	}
+
+	extensionID := otlpexporter.OAuth2ExtensionID(pipelineName)
+	cfg.Extensions.OAuth2Clients[extensionID] = extensionConfig
+	cfg.Service.Extensions = append(cfg.Service.Extensions, extensionID)
+}
+
+func declareKafkaExporter(ctx context.Context, reader client.Reader, pipeline *telemetryv1alpha1.MetricPipeline, queueSize int, cfg *Config, envVars otlpexporter.EnvVars) error {
+	kafkaExporterBuilder := kafkaexporter.NewConfigBuilder(reader, pipeline.Spec.Output.Kafka, pipeline.Name, queueSize)
+
+	kafkaExporterConfig, kafkaExporterEnvVars, err := kafkaExporterBuilder.MakeConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to make kafka exporter config: %w", err)
+	}
+
+	maps.Copy(envVars, kafkaExporterEnvVars)
+
+	exporterID := formatExporterID(pipeline)
+	cfg.Exporters[exporterID] = Exporter{Kafka: kafkaExporterConfig}
+
 	return nil
 }
 
@@ -204,6 +296,10 @@ func formatNamespaceFilterID(pipelineName string, inputSourceType metric.InputSo
 	return fmt.Sprintf("filter/%s-filter-by-namespace-%s-input", pipelineName, inputSourceType)
 }
 
+func formatKeepTestDataFilterID(pipelineName string) string {
+	return fmt.Sprintf("filter/%s-keep-test-data", pipelineName)
+}
+
 func formatForwardConnectorID(pipelineName string) string {
 	return fmt.Sprintf("forward/%s", pipelineName)
 }
@@ -223,3 +319,7 @@ func formatAttributesEnrichmentPipelineID(pipelineName string) string {
 func formatOutputPipelineID(pipelineName string) string {
 	return fmt.Sprintf("metrics/%s-output", pipelineName)
 }
+
+func formatTestDataPipelineID(pipelineName string) string {
+	return fmt.Sprintf("metrics/%s", synthetic.TestDataName(pipelineName))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
@@ -229,6 +230,106 @@ func TestMakeConfig(t *testing.T) {
 		require.Contains(t, collectorConfig.Exporters, "otlp/test-3")
 	})
 
+	t.Run("compression, timeout and retry", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(
+			ctx,
+			[]telemetryv1alpha1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("test-tuned").WithOTLPOutput(
+					testutils.OTLPCompression(telemetryv1alpha1.OtlpCompressionSnappy),
+					testutils.OTLPTimeout("45s"),
+					testutils.OTLPRetry(&telemetryv1alpha1.OtlpRetry{MaxInterval: "2m"}),
+				).Build(),
+			},
+			BuildOptions{},
+		)
+		require.NoError(t, err)
+		require.Contains(t, collectorConfig.Exporters, "otlp/test-tuned")
+
+		otlpExporterConfig := collectorConfig.Exporters["otlp/test-tuned"]
+		require.Equal(t, "snappy", otlpExporterConfig.OTLP.Compression)
+		require.Equal(t, "45s", otlpExporterConfig.OTLP.Timeout)
+		require.True(t, otlpExporterConfig.OTLP.RetryOnFailure.Enabled)
+		require.Equal(t, "5s", otlpExporterConfig.OTLP.RetryOnFailure.InitialInterval)
+		require.Equal(t, "2m", otlpExporterConfig.OTLP.RetryOnFailure.MaxInterval)
+		require.Equal(t, "300s", otlpExporterConfig.OTLP.RetryOnFailure.MaxElapsedTime)
+	})
+
+	t.Run("retry disabled", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(
+			ctx,
+			[]telemetryv1alpha1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("test-no-retry").WithOTLPOutput(
+					testutils.OTLPRetry(&telemetryv1alpha1.OtlpRetry{Enabled: ptr.To(false)}),
+				).Build(),
+			},
+			BuildOptions{},
+		)
+		require.NoError(t, err)
+		require.Equal(t, config.RetryOnFailure{Enabled: false}, collectorConfig.Exporters["otlp/test-no-retry"].OTLP.RetryOnFailure)
+
+		configYAML, err := yaml.Marshal(collectorConfig)
+		require.NoError(t, err, "failed to marshal config")
+		require.NotContains(t, string(configYAML), "initial_interval")
+	})
+
+	t.Run("oauth2", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(
+			ctx,
+			[]telemetryv1alpha1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("test-oauth2").WithOTLPOutput(testutils.OTLPOAuth2("https://auth.example.com/token", "client-id", "client-secret")).Build(),
+			},
+			BuildOptions{},
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, "oauth2client/test-oauth2", collectorConfig.Exporters["otlp/test-oauth2"].OTLP.Auth.Authenticator)
+		require.Contains(t, collectorConfig.Extensions.OAuth2Clients, "oauth2client/test-oauth2")
+		require.Contains(t, collectorConfig.Service.Extensions, "oauth2client/test-oauth2")
+	})
+
+	t.Run("kafka exporter", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(
+			ctx,
+			[]telemetryv1alpha1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("test-kafka").WithKafkaOutput(&telemetryv1alpha1.KafkaOutput{
+					Brokers:  []string{"kafka-1:9093", "kafka-2:9093"},
+					Topic:    "metrics",
+					Encoding: telemetryv1alpha1.KafkaEncodingOTLPJSON,
+				}).Build(),
+			},
+			BuildOptions{},
+		)
+		require.NoError(t, err)
+
+		require.Contains(t, collectorConfig.Exporters, "kafka/test-kafka")
+
+		kafkaExporterConfig := collectorConfig.Exporters["kafka/test-kafka"].Kafka
+		require.NotNil(t, kafkaExporterConfig)
+		require.Equal(t, []string{"kafka-1:9093", "kafka-2:9093"}, kafkaExporterConfig.Brokers)
+		require.Equal(t, "metrics", kafkaExporterConfig.Topic)
+		require.Equal(t, "otlp_json", kafkaExporterConfig.Encoding)
+
+		require.Equal(t, []string{"kafka/test-kafka"}, collectorConfig.Service.Pipelines["metrics/test-kafka-output"].Exporters)
+	})
+
+	t.Run("debug exporter", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(
+			ctx,
+			[]telemetryv1alpha1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("test-1").Build(),
+				testutils.NewMetricPipelineBuilder().WithName("test-2").Build(),
+			},
+			BuildOptions{DebugExporterVerbosity: map[string]string{"test-1": "detailed"}},
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, "detailed", collectorConfig.Exporters["debug/test-1"].Debug.Verbosity)
+		require.Equal(t, []string{"otlp/test-1", "debug/test-1"}, collectorConfig.Service.Pipelines["metrics/test-1-output"].Exporters)
+
+		require.NotContains(t, collectorConfig.Exporters, "debug/test-2")
+		require.Equal(t, []string{"otlp/test-2"}, collectorConfig.Service.Pipelines["metrics/test-2-output"].Exporters)
+	})
+
 	t.Run("marshaling", func(t *testing.T) {
 		tests := []struct {
 			name           string
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/gatewayprocs"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/ottlexpr"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 )
 
 func makeProcessorsConfig() Processors {
@@ -21,6 +22,7 @@ func makeProcessorsConfig() Processors {
 		ResolveServiceName:            makeResolveServiceNameConfig(),
 		DropKymaAttributes:            gatewayprocs.DropKymaAttributesProcessorConfig(),
 		DeleteSkipEnrichmentAttribute: makeDeleteSkipEnrichmentAttributeConfig(),
+		DropTestData:                  makeDropTestDataConfig(),
 	}
 }
 
@@ -190,6 +192,29 @@ func makeFilterByNamespaceConfig(namespaceSelector *telemetryv1alpha1.MetricPipe
 	}
 }
 
+// makeDropTestDataConfig drops all test data from the pipelines that export the metrics of the workloads.
+func makeDropTestDataConfig() *FilterProcessor {
+	return &FilterProcessor{
+		Metrics: FilterProcessorMetrics{
+			Metric: []string{
+				ottlexpr.ResourceAttributeNotNil(synthetic.AttributePipeline),
+			},
+		},
+	}
+}
+
+// makeKeepTestDataConfig drops all metrics except the test data of the given pipeline from its test data pipeline.
+func makeKeepTestDataConfig(pipelineName string) *FilterProcessor {
+	return &FilterProcessor{
+		Metrics: FilterProcessorMetrics{
+			Metric: []string{
+				ottlexpr.ResourceAttributeIsNil(synthetic.AttributePipeline),
+				ottlexpr.ResourceAttributeNotEquals(synthetic.AttributePipeline, pipelineName),
+			},
+		},
+	}
+}
+
 func createNamespacesConditions(namespaces []string) []string {
 	var namespacesConditions []string
 	for _, ns := range namespaces {
@@ -158,7 +158,7 @@ func TestProcessors(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		namespaceFilters := collectorConfig.Processors.NamespaceFilters
+		namespaceFilters := collectorConfig.Processors.PipelineFilters
 		require.NotNil(t, namespaceFilters)
 
 		require.Contains(t, namespaceFilters, "filter/test-filter-by-namespace-runtime-input")
@@ -204,7 +204,7 @@ func TestProcessors(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		namespaceFilters := collectorConfig.Processors.NamespaceFilters
+		namespaceFilters := collectorConfig.Processors.PipelineFilters
 		require.NotNil(t, namespaceFilters)
 
 		require.Contains(t, namespaceFilters, "filter/test-filter-by-namespace-runtime-input")
@@ -390,4 +390,34 @@ func TestProcessors(t *testing.T) {
 		require.Equal(t, "set(version, \"main\") where name == \"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver\"", collectorConfig.Processors.SetInstrumentationScopeKyma.MetricStatements[0].Statements[0])
 		require.Equal(t, "set(name, \"io.kyma-project.telemetry/kyma\") where name == \"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver\"", collectorConfig.Processors.SetInstrumentationScopeKyma.MetricStatements[0].Statements[1])
 	})
+
+	t.Run("test data processors", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(
+			ctx,
+			[]telemetryv1alpha1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithName("test").Build(),
+			},
+			BuildOptions{PendingTestData: map[string]bool{"test": true}},
+		)
+		require.NoError(t, err)
+
+		expectedDropTestDataProcessor := FilterProcessor{
+			Metrics: FilterProcessorMetrics{
+				Metric: []string{
+					`resource.attributes["telemetry.test_data.pipeline"] != nil`,
+				},
+			},
+		}
+		require.Equal(t, expectedDropTestDataProcessor, *collectorConfig.Processors.DropTestData)
+
+		expectedKeepTestDataProcessor := FilterProcessor{
+			Metrics: FilterProcessorMetrics{
+				Metric: []string{
+					`resource.attributes["telemetry.test_data.pipeline"] == nil`,
+					`resource.attributes["telemetry.test_data.pipeline"] != "test"`,
+				},
+			},
+		}
+		require.Equal(t, expectedKeepTestDataProcessor, *collectorConfig.Processors.PipelineFilters["filter/test-keep-test-data"])
+	})
 }
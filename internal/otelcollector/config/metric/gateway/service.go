@@ -3,6 +3,7 @@ package gateway
 import (
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/kafkaexporter"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpexporter"
 )
@@ -10,7 +11,7 @@ import (
 func makeInputPipelineServiceConfig(pipeline *telemetryv1alpha1.MetricPipeline) config.Pipeline {
 	return config.Pipeline{
 		Receivers:  makeReceiversIDs(),
-		Processors: []string{"memory_limiter"},
+		Processors: []string{"memory_limiter", "filter/drop-test-data"},
 		Exporters:  []string{formatRoutingConnectorID(pipeline.Name)},
 	}
 }
@@ -40,7 +41,16 @@ func makeOutputPipelineServiceConfig(pipeline *telemetryv1alpha1.MetricPipeline)
 	return config.Pipeline{
 		Receivers:  []string{formatRoutingConnectorID(pipeline.Name), formatForwardConnectorID(pipeline.Name)},
 		Processors: processors,
-		Exporters:  []string{formatOTLPExporterID(pipeline)},
+		Exporters:  []string{formatExporterID(pipeline)},
+	}
+}
+
+// makeTestDataPipelineServiceConfig creates the service pipeline that exports only the test data of a MetricPipeline.
+func makeTestDataPipelineServiceConfig(pipeline *telemetryv1alpha1.MetricPipeline) config.Pipeline {
+	return config.Pipeline{
+		Receivers:  []string{"otlp"},
+		Processors: []string{"memory_limiter", formatKeepTestDataFilterID(pipeline.Name), "resource/insert-cluster-name", "batch"},
+		Exporters:  []string{formatTestDataExporterID(pipeline)},
 	}
 }
 
@@ -134,6 +144,14 @@ func makeDiagnosticMetricFiltersIDs(input telemetryv1alpha1.MetricPipelineInput)
 	return processors
 }
 
-func formatOTLPExporterID(pipeline *telemetryv1alpha1.MetricPipeline) string {
+func formatTestDataExporterID(pipeline *telemetryv1alpha1.MetricPipeline) string {
+	return config.TestDataExporterID(formatExporterID(pipeline), pipeline.Name)
+}
+
+func formatExporterID(pipeline *telemetryv1alpha1.MetricPipeline) string {
+	if pipeline.Spec.Output.Kafka != nil {
+		return kafkaexporter.ExporterID(pipeline.Name)
+	}
+
 	return otlpexporter.ExporterID(pipeline.Spec.Output.Otlp.Protocol, pipeline.Name)
 }
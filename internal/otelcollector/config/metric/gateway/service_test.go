@@ -24,7 +24,7 @@ func TestService(t *testing.T) {
 				[]telemetryv1alpha1.MetricPipeline{
 					testutils.NewMetricPipelineBuilder().WithName("test").WithOTLPInput(false).Build(),
 				},
-				BuildOptions{},
+				BuildOptions{PendingTestData: map[string]bool{"test": true}},
 			)
 			require.NoError(t, err)
 
@@ -33,7 +33,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -52,6 +52,10 @@ func TestService(t *testing.T) {
 				"batch",
 			}, collectorConfig.Service.Pipelines["metrics/test-output"].Processors)
 			require.Equal(t, []string{"otlp/test"}, collectorConfig.Service.Pipelines["metrics/test-output"].Exporters)
+
+			require.Equal(t, []string{"otlp"}, collectorConfig.Service.Pipelines["metrics/test_test-data"].Receivers)
+			require.Equal(t, []string{"memory_limiter", "filter/test-keep-test-data", "resource/insert-cluster-name", "batch"}, collectorConfig.Service.Pipelines["metrics/test_test-data"].Processors)
+			require.Equal(t, []string{"otlp/test_test-data"}, collectorConfig.Service.Pipelines["metrics/test_test-data"].Exporters)
 		})
 
 		t.Run("with prometheus input enabled", func(t *testing.T) {
@@ -67,9 +71,10 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-input")
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-attributes-enrichment")
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
+			require.NotContains(t, collectorConfig.Service.Pipelines, "metrics/test_test-data")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -103,7 +108,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -138,7 +143,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -176,7 +181,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -219,7 +224,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -263,7 +268,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -307,7 +312,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -351,7 +356,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -388,7 +393,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -422,7 +427,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -457,7 +462,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -492,7 +497,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -527,7 +532,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -562,7 +567,7 @@ func TestService(t *testing.T) {
 			require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-output")
 
 			require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-input"].Receivers)
-			require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
+			require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-input"].Processors)
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-input"].Exporters)
 
 			require.Equal(t, []string{"routing/test"}, collectorConfig.Service.Pipelines["metrics/test-attributes-enrichment"].Receivers)
@@ -610,7 +615,7 @@ func TestService(t *testing.T) {
 		require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-1-output")
 
 		require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-1-input"].Receivers)
-		require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-1-input"].Processors)
+		require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-1-input"].Processors)
 		require.Equal(t, []string{"routing/test-1"}, collectorConfig.Service.Pipelines["metrics/test-1-input"].Exporters)
 
 		require.Equal(t, []string{"routing/test-1"}, collectorConfig.Service.Pipelines["metrics/test-1-attributes-enrichment"].Receivers)
@@ -637,7 +642,7 @@ func TestService(t *testing.T) {
 		require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-2-output")
 
 		require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-2-input"].Receivers)
-		require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-2-input"].Processors)
+		require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-2-input"].Processors)
 		require.Equal(t, []string{"routing/test-2"}, collectorConfig.Service.Pipelines["metrics/test-2-input"].Exporters)
 
 		require.Equal(t, []string{"routing/test-2"}, collectorConfig.Service.Pipelines["metrics/test-2-attributes-enrichment"].Receivers)
@@ -663,7 +668,7 @@ func TestService(t *testing.T) {
 		require.Contains(t, collectorConfig.Service.Pipelines, "metrics/test-3-output")
 
 		require.Equal(t, []string{"otlp", "singleton_receiver_creator/kymastats"}, collectorConfig.Service.Pipelines["metrics/test-3-input"].Receivers)
-		require.Equal(t, []string{"memory_limiter"}, collectorConfig.Service.Pipelines["metrics/test-3-input"].Processors)
+		require.Equal(t, []string{"memory_limiter", "filter/drop-test-data"}, collectorConfig.Service.Pipelines["metrics/test-3-input"].Processors)
 		require.Equal(t, []string{"routing/test-3"}, collectorConfig.Service.Pipelines["metrics/test-3-input"].Exporters)
 
 		require.Equal(t, []string{"routing/test-3"}, collectorConfig.Service.Pipelines["metrics/test-3-attributes-enrichment"].Receivers)
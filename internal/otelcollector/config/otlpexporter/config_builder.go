@@ -18,6 +18,12 @@ const (
 	SignalTypeTrace  = "trace"
 )
 
+const (
+	defaultRetryInitialInterval = "5s"
+	defaultRetryMaxInterval     = "30s"
+	defaultRetryMaxElapsedTime  = "300s"
+)
+
 type ConfigBuilder struct {
 	reader       client.Reader
 	otlpOutput   *telemetryv1alpha1.OtlpOutput
@@ -54,19 +60,22 @@ func makeExportersConfig(otlpOutput *telemetryv1alpha1.OtlpOutput, pipelineName
 	tlsConfig := makeTLSConfig(otlpOutput, otlpEndpointValue, pipelineName)
 
 	otlpExporterConfig := config.OTLPExporter{
-		Endpoint: fmt.Sprintf("${%s}", otlpEndpointVariable),
-		Headers:  headers,
-		TLS:      tlsConfig,
+		Endpoint:    fmt.Sprintf("${%s}", otlpEndpointVariable),
+		Headers:     headers,
+		Auth:        makeAuth(otlpOutput, pipelineName),
+		TLS:         tlsConfig,
+		Compression: otlpOutput.Compression,
+		Timeout:     otlpOutput.Timeout,
 		SendingQueue: config.SendingQueue{
 			Enabled:   true,
 			QueueSize: queueSize,
 		},
-		RetryOnFailure: config.RetryOnFailure{
-			Enabled:         true,
-			InitialInterval: "5s",
-			MaxInterval:     "30s",
-			MaxElapsedTime:  "300s",
-		},
+		RetryOnFailure: makeRetryOnFailure(otlpOutput.Retry),
+	}
+
+	// Only the HTTP exporter supports a dedicated proxy, the gRPC exporter always uses the proxy from the environment
+	if otlpOutput.Proxy != nil && otlpOutput.Proxy.URL != "" && otlpOutput.Protocol == telemetryv1alpha1.OtlpProtocolHTTP {
+		otlpExporterConfig.ProxyURL = otlpOutput.Proxy.URL
 	}
 
 	if len(otlpOutput.Path) > 0 && SignalTypeMetric == signalType {
@@ -82,6 +91,65 @@ func makeExportersConfig(otlpOutput *telemetryv1alpha1.OtlpOutput, pipelineName
 	return &otlpExporterConfig
 }
 
+func makeRetryOnFailure(retry *telemetryv1alpha1.OtlpRetry) config.RetryOnFailure {
+	if !retry.IsEnabled() {
+		return config.RetryOnFailure{Enabled: false}
+	}
+
+	retryOnFailure := config.RetryOnFailure{
+		Enabled:         true,
+		InitialInterval: defaultRetryInitialInterval,
+		MaxInterval:     defaultRetryMaxInterval,
+		MaxElapsedTime:  defaultRetryMaxElapsedTime,
+	}
+
+	if retry == nil {
+		return retryOnFailure
+	}
+
+	if retry.InitialInterval != "" {
+		retryOnFailure.InitialInterval = retry.InitialInterval
+	}
+
+	if retry.MaxInterval != "" {
+		retryOnFailure.MaxInterval = retry.MaxInterval
+	}
+
+	if retry.MaxElapsedTime != "" {
+		retryOnFailure.MaxElapsedTime = retry.MaxElapsedTime
+	}
+
+	return retryOnFailure
+}
+
+// MakeOAuth2ExtensionConfig returns the configuration of the oauth2client extension that the exporter authenticates with,
+// or nil if the output does not use OAuth2.
+func (cb *ConfigBuilder) MakeOAuth2ExtensionConfig() *config.OAuth2ClientExtension {
+	if cb.otlpOutput.Authentication == nil || !cb.otlpOutput.Authentication.OAuth2.IsDefined() {
+		return nil
+	}
+
+	oauth2 := cb.otlpOutput.Authentication.OAuth2
+
+	extensionConfig := config.OAuth2ClientExtension{
+		ClientID:     fmt.Sprintf("${%s}", makeOAuth2ClientIDVariable(cb.pipelineName)),
+		ClientSecret: fmt.Sprintf("${%s}", makeOAuth2ClientSecretVariable(cb.pipelineName)),
+		TokenURL:     fmt.Sprintf("${%s}", makeOAuth2TokenURLVariable(cb.pipelineName)),
+		Scopes:       oauth2.Scopes,
+		Timeout:      "10s",
+	}
+
+	if oauth2.Audience != "" {
+		extensionConfig.EndpointParams = map[string][]string{"audience": {oauth2.Audience}}
+	}
+
+	return &extensionConfig
+}
+
+func OAuth2ExtensionID(pipelineName string) string {
+	return fmt.Sprintf("oauth2client/%s", pipelineName)
+}
+
 func ExporterID(protocol string, pipelineName string) string {
 	var outputType string
 	if protocol == telemetryv1alpha1.OtlpProtocolHTTP {
@@ -106,15 +174,19 @@ func makeTLSConfig(output *telemetryv1alpha1.OtlpOutput, otlpEndpointValue, pipe
 	}
 
 	cfg.InsecureSkipVerify = output.TLS.InsecureSkipVerify
-	if output.TLS.CA.IsDefined() {
+	cfg.MinVersion = output.TLS.MinVersion
+	cfg.MaxVersion = output.TLS.MaxVersion
+	cfg.CipherSuites = output.TLS.CipherSuites
+
+	if output.TLS.GetCA().IsDefined() {
 		cfg.CAPem = fmt.Sprintf("${%s}", makeTLSCaVariable(pipelineName))
 	}
 
-	if output.TLS.Cert.IsDefined() {
+	if output.TLS.GetCert().IsDefined() {
 		cfg.CertPem = fmt.Sprintf("${%s}", makeTLSCertVariable(pipelineName))
 	}
 
-	if output.TLS.Key.IsDefined() {
+	if output.TLS.GetKey().IsDefined() {
 		cfg.KeyPem = fmt.Sprintf("${%s}", makeTLSKeyVariable(pipelineName))
 	}
 
@@ -136,6 +208,14 @@ func makeHeaders(output *telemetryv1alpha1.OtlpOutput, pipelineName string) map[
 	return headers
 }
 
+func makeAuth(output *telemetryv1alpha1.OtlpOutput, pipelineName string) *config.Auth {
+	if output.Authentication == nil || !output.Authentication.OAuth2.IsDefined() {
+		return nil
+	}
+
+	return &config.Auth{Authenticator: OAuth2ExtensionID(pipelineName)}
+}
+
 func isInsecureOutput(endpoint string) bool {
 	return len(strings.TrimSpace(endpoint)) > 0 && strings.HasPrefix(endpoint, "http://")
 }
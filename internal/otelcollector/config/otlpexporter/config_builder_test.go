@@ -5,9 +5,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
 )
 
 func TestExporterIDHTTP(t *testing.T) {
@@ -43,6 +47,9 @@ func TestMakeConfig(t *testing.T) {
 	require.Equal(t, "5s", otlpExporterConfig.RetryOnFailure.InitialInterval)
 	require.Equal(t, "30s", otlpExporterConfig.RetryOnFailure.MaxInterval)
 	require.Equal(t, "300s", otlpExporterConfig.RetryOnFailure.MaxElapsedTime)
+
+	require.Empty(t, otlpExporterConfig.Compression)
+	require.Empty(t, otlpExporterConfig.Timeout)
 }
 
 func TestMakeConfigTraceWithPath(t *testing.T) {
@@ -143,6 +150,26 @@ func TestMakeExporterConfigWithTLSInsecureSkipVerify(t *testing.T) {
 	require.Nil(t, envVars["TLS_CONFIG_CA_TEST"])
 }
 
+func TestMakeExporterConfigWithTLSVersionsAndCipherSuites(t *testing.T) {
+	tls := &telemetryv1alpha1.OtlpTLS{
+		MinVersion:   "1.2",
+		MaxVersion:   "1.3",
+		CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+	}
+	output := &telemetryv1alpha1.OtlpOutput{
+		Endpoint: telemetryv1alpha1.ValueType{Value: "otlp-endpoint"},
+		TLS:      tls,
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512, SignalTypeTrace)
+	otlpExporterConfig, _, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "1.2", otlpExporterConfig.TLS.MinVersion)
+	require.Equal(t, "1.3", otlpExporterConfig.TLS.MaxVersion)
+	require.Equal(t, []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}, otlpExporterConfig.TLS.CipherSuites)
+}
+
 func TestMakeExporterConfigWithmTLS(t *testing.T) {
 	tls := &telemetryv1alpha1.OtlpTLS{
 		Insecure:           false,
@@ -180,3 +207,233 @@ func TestMakeExporterConfigWithmTLS(t *testing.T) {
 	require.Equal(t, envVars["OTLP_TLS_CERT_PEM_TEST"], []byte("test client cert pem"))
 	require.Equal(t, envVars["OTLP_TLS_KEY_PEM_TEST"], []byte("test client key pem"))
 }
+
+func TestMakeExporterConfigWithmTLSFromSecretRef(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "client-cert",
+			Namespace: "default",
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			"ca.crt":  []byte("test ca cert pem"),
+			"tls.crt": []byte("test client cert pem"),
+			"tls.key": []byte("test client key pem"),
+		},
+	}
+	output := &telemetryv1alpha1.OtlpOutput{
+		Endpoint: telemetryv1alpha1.ValueType{Value: "otlp-endpoint"},
+		TLS: &telemetryv1alpha1.OtlpTLS{
+			SecretRef: &telemetryv1alpha1.TLSSecretRef{Name: "client-cert", Namespace: "default", UseCA: true},
+		},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().WithObjects(secret).Build(), output, "test", 512, SignalTypeTrace)
+	otlpExporterConfig, envVars, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "${OTLP_TLS_CA_PEM_TEST}", otlpExporterConfig.TLS.CAPem)
+	require.Equal(t, "${OTLP_TLS_CERT_PEM_TEST}", otlpExporterConfig.TLS.CertPem)
+	require.Equal(t, "${OTLP_TLS_KEY_PEM_TEST}", otlpExporterConfig.TLS.KeyPem)
+
+	require.Equal(t, []byte("test ca cert pem"), envVars["OTLP_TLS_CA_PEM_TEST"])
+	require.Equal(t, []byte("test client cert pem"), envVars["OTLP_TLS_CERT_PEM_TEST"])
+	require.Equal(t, []byte("test client key pem"), envVars["OTLP_TLS_KEY_PEM_TEST"])
+}
+
+func TestMakeExporterConfigWithmTLSFromSecretRefWithoutCA(t *testing.T) {
+	// Secrets from public issuers, such as ACME issuers, have no ca.crt key
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "client-cert",
+			Namespace: "default",
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			"tls.crt": []byte("test client cert pem"),
+			"tls.key": []byte("test client key pem"),
+		},
+	}
+	output := &telemetryv1alpha1.OtlpOutput{
+		Endpoint: telemetryv1alpha1.ValueType{Value: "otlp-endpoint"},
+		TLS: &telemetryv1alpha1.OtlpTLS{
+			SecretRef: &telemetryv1alpha1.TLSSecretRef{Name: "client-cert", Namespace: "default"},
+		},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().WithObjects(secret).Build(), output, "test", 512, SignalTypeTrace)
+	otlpExporterConfig, envVars, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+
+	require.Empty(t, otlpExporterConfig.TLS.CAPem)
+	require.Equal(t, "${OTLP_TLS_CERT_PEM_TEST}", otlpExporterConfig.TLS.CertPem)
+	require.NotContains(t, envVars, "OTLP_TLS_CA_PEM_TEST")
+}
+
+func TestMakeConfigWithCompressionAndTimeout(t *testing.T) {
+	output := &telemetryv1alpha1.OtlpOutput{
+		Endpoint:    telemetryv1alpha1.ValueType{Value: "otlp-endpoint"},
+		Compression: telemetryv1alpha1.OtlpCompressionZstd,
+		Timeout:     "1m",
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512, SignalTypeMetric)
+	otlpExporterConfig, _, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "zstd", otlpExporterConfig.Compression)
+	require.Equal(t, "1m", otlpExporterConfig.Timeout)
+}
+
+func TestMakeConfigWithRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		retry    *telemetryv1alpha1.OtlpRetry
+		expected config.RetryOnFailure
+	}{
+		{
+			name:  "enabled without intervals",
+			retry: &telemetryv1alpha1.OtlpRetry{Enabled: ptr.To(true)},
+			expected: config.RetryOnFailure{
+				Enabled:         true,
+				InitialInterval: "5s",
+				MaxInterval:     "30s",
+				MaxElapsedTime:  "300s",
+			},
+		},
+		{
+			name: "custom intervals",
+			retry: &telemetryv1alpha1.OtlpRetry{
+				InitialInterval: "1s",
+				MaxInterval:     "1m",
+				MaxElapsedTime:  "0s",
+			},
+			expected: config.RetryOnFailure{
+				Enabled:         true,
+				InitialInterval: "1s",
+				MaxInterval:     "1m",
+				MaxElapsedTime:  "0s",
+			},
+		},
+		{
+			name: "partially overridden intervals",
+			retry: &telemetryv1alpha1.OtlpRetry{
+				MaxElapsedTime: "15m",
+			},
+			expected: config.RetryOnFailure{
+				Enabled:         true,
+				InitialInterval: "5s",
+				MaxInterval:     "30s",
+				MaxElapsedTime:  "15m",
+			},
+		},
+		{
+			name: "disabled",
+			retry: &telemetryv1alpha1.OtlpRetry{
+				Enabled:         ptr.To(false),
+				InitialInterval: "1s",
+			},
+			expected: config.RetryOnFailure{
+				Enabled: false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := &telemetryv1alpha1.OtlpOutput{
+				Endpoint: telemetryv1alpha1.ValueType{Value: "otlp-endpoint"},
+				Retry:    tt.retry,
+			}
+
+			cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512, SignalTypeTrace)
+			otlpExporterConfig, _, err := cb.MakeConfig(context.Background())
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expected, otlpExporterConfig.RetryOnFailure)
+		})
+	}
+}
+
+func TestMakeConfigWithProxyURL(t *testing.T) {
+	tests := []struct {
+		name             string
+		protocol         string
+		expectedProxyURL string
+	}{
+		{
+			name:             "http",
+			protocol:         telemetryv1alpha1.OtlpProtocolHTTP,
+			expectedProxyURL: "http://proxy.example.com:3128",
+		},
+		{
+			name:             "grpc",
+			protocol:         telemetryv1alpha1.OtlpProtocolGRPC,
+			expectedProxyURL: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := &telemetryv1alpha1.OtlpOutput{
+				Protocol: tt.protocol,
+				Endpoint: telemetryv1alpha1.ValueType{Value: "https://otlp-endpoint:4318"},
+				Proxy:    &telemetryv1alpha1.OutputProxy{URL: "http://proxy.example.com:3128"},
+			}
+
+			cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512, SignalTypeTrace)
+			otlpExporterConfig, _, err := cb.MakeConfig(context.Background())
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedProxyURL, otlpExporterConfig.ProxyURL)
+		})
+	}
+}
+
+func TestMakeConfigWithOAuth2(t *testing.T) {
+	output := &telemetryv1alpha1.OtlpOutput{
+		Endpoint: telemetryv1alpha1.ValueType{Value: "https://otlp-endpoint:4317"},
+		Authentication: &telemetryv1alpha1.AuthenticationOptions{
+			OAuth2: &telemetryv1alpha1.OAuth2Options{
+				TokenURL:     telemetryv1alpha1.ValueType{Value: "https://auth.example.com/oauth2/token"},
+				ClientID:     telemetryv1alpha1.ValueType{Value: "client-id"},
+				ClientSecret: telemetryv1alpha1.ValueType{Value: "client-secret"},
+				Scopes:       []string{"traces.write"},
+				Audience:     "backend",
+			},
+		},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512, SignalTypeTrace)
+	otlpExporterConfig, envVars, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []byte("https://auth.example.com/oauth2/token"), envVars["OAUTH2_TOKEN_URL_TEST"])
+	require.Equal(t, []byte("client-id"), envVars["OAUTH2_CLIENT_ID_TEST"])
+	require.Equal(t, []byte("client-secret"), envVars["OAUTH2_CLIENT_SECRET_TEST"])
+
+	require.NotNil(t, otlpExporterConfig.Auth)
+	require.Equal(t, "oauth2client/test", otlpExporterConfig.Auth.Authenticator)
+	require.NotContains(t, otlpExporterConfig.Headers, "Authorization")
+
+	extensionConfig := cb.MakeOAuth2ExtensionConfig()
+	require.NotNil(t, extensionConfig)
+	require.Equal(t, "${OAUTH2_TOKEN_URL_TEST}", extensionConfig.TokenURL)
+	require.Equal(t, "${OAUTH2_CLIENT_ID_TEST}", extensionConfig.ClientID)
+	require.Equal(t, "${OAUTH2_CLIENT_SECRET_TEST}", extensionConfig.ClientSecret)
+	require.Equal(t, []string{"traces.write"}, extensionConfig.Scopes)
+	require.Equal(t, map[string][]string{"audience": {"backend"}}, extensionConfig.EndpointParams)
+}
+
+func TestMakeOAuth2ExtensionConfigWithoutOAuth2(t *testing.T) {
+	output := &telemetryv1alpha1.OtlpOutput{
+		Endpoint: telemetryv1alpha1.ValueType{Value: "otlp-endpoint"},
+	}
+
+	cb := NewConfigBuilder(fake.NewClientBuilder().Build(), output, "test", 512, SignalTypeTrace)
+	require.Nil(t, cb.MakeOAuth2ExtensionConfig())
+
+	otlpExporterConfig, _, err := cb.MakeConfig(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, otlpExporterConfig.Auth)
+}
@@ -17,11 +17,14 @@ import (
 )
 
 const (
-	basicAuthHeaderVariablePrefix = "BASIC_AUTH_HEADER"
-	otlpEndpointVariablePrefix    = "OTLP_ENDPOINT"
-	tlsConfigCertVariablePrefix   = "OTLP_TLS_CERT_PEM"
-	tlsConfigKeyVariablePrefix    = "OTLP_TLS_KEY_PEM"
-	tlsConfigCaVariablePrefix     = "OTLP_TLS_CA_PEM"
+	basicAuthHeaderVariablePrefix    = "BASIC_AUTH_HEADER"
+	oauth2TokenURLVariablePrefix     = "OAUTH2_TOKEN_URL"
+	oauth2ClientIDVariablePrefix     = "OAUTH2_CLIENT_ID"
+	oauth2ClientSecretVariablePrefix = "OAUTH2_CLIENT_SECRET"
+	otlpEndpointVariablePrefix       = "OTLP_ENDPOINT"
+	tlsConfigCertVariablePrefix      = "OTLP_TLS_CERT_PEM"
+	tlsConfigKeyVariablePrefix       = "OTLP_TLS_KEY_PEM"
+	tlsConfigCaVariablePrefix        = "OTLP_TLS_CA_PEM"
 )
 
 var (
@@ -73,6 +76,23 @@ func makeAuthenticationEnvVar(ctx context.Context, c client.Reader, secretData m
 		secretData[basicAuthHeaderVariable] = []byte(basicAuthHeader)
 	}
 
+	if output.Authentication != nil && output.Authentication.OAuth2.IsDefined() {
+		oauth2 := output.Authentication.OAuth2
+
+		for variable, value := range map[string]telemetryv1alpha1.ValueType{
+			makeOAuth2TokenURLVariable(pipelineName):     oauth2.TokenURL,
+			makeOAuth2ClientIDVariable(pipelineName):     oauth2.ClientID,
+			makeOAuth2ClientSecretVariable(pipelineName): oauth2.ClientSecret,
+		} {
+			resolved, err := resolveValue(ctx, c, value)
+			if err != nil {
+				return err
+			}
+
+			secretData[variable] = resolved
+		}
+	}
+
 	return nil
 }
 
@@ -106,8 +126,8 @@ func makeHeaderEnvVar(ctx context.Context, c client.Reader, secretData map[strin
 
 func makeTLSEnvVar(ctx context.Context, c client.Reader, secretData map[string][]byte, output *telemetryv1alpha1.OtlpOutput, pipelineName string) error {
 	if output.TLS != nil {
-		if output.TLS.CA.IsDefined() {
-			ca, err := resolveValue(ctx, c, *output.TLS.CA)
+		if output.TLS.GetCA().IsDefined() {
+			ca, err := resolveValue(ctx, c, *output.TLS.GetCA())
 			if err != nil {
 				return err
 			}
@@ -116,13 +136,13 @@ func makeTLSEnvVar(ctx context.Context, c client.Reader, secretData map[string][
 			secretData[tlsConfigCaVariable] = ca
 		}
 
-		if output.TLS.Cert.IsDefined() && output.TLS.Key.IsDefined() {
-			cert, err := resolveValue(ctx, c, *output.TLS.Cert)
+		if output.TLS.GetCert().IsDefined() && output.TLS.GetKey().IsDefined() {
+			cert, err := resolveValue(ctx, c, *output.TLS.GetCert())
 			if err != nil {
 				return err
 			}
 
-			key, err := resolveValue(ctx, c, *output.TLS.Key)
+			key, err := resolveValue(ctx, c, *output.TLS.GetKey())
 			if err != nil {
 				return err
 			}
@@ -194,6 +214,18 @@ func makeBasicAuthHeaderVariable(pipelineName string) string {
 	return fmt.Sprintf("%s_%s", basicAuthHeaderVariablePrefix, sanitizeEnvVarName(pipelineName))
 }
 
+func makeOAuth2TokenURLVariable(pipelineName string) string {
+	return fmt.Sprintf("%s_%s", oauth2TokenURLVariablePrefix, sanitizeEnvVarName(pipelineName))
+}
+
+func makeOAuth2ClientIDVariable(pipelineName string) string {
+	return fmt.Sprintf("%s_%s", oauth2ClientIDVariablePrefix, sanitizeEnvVarName(pipelineName))
+}
+
+func makeOAuth2ClientSecretVariable(pipelineName string) string {
+	return fmt.Sprintf("%s_%s", oauth2ClientSecretVariablePrefix, sanitizeEnvVarName(pipelineName))
+}
+
 func makeHeaderVariable(header telemetryv1alpha1.Header, pipelineName string) string {
 	return fmt.Sprintf("HEADER_%s_%s", sanitizeEnvVarName(pipelineName), sanitizeEnvVarName(header.Name))
 }
@@ -21,6 +21,10 @@ func ResourceAttributeNotNil(key string) string {
 	return fmt.Sprintf("resource.attributes[\"%s\"] != nil", key)
 }
 
+func ResourceAttributeIsNil(key string) string {
+	return fmt.Sprintf("resource.attributes[\"%s\"] == nil", key)
+}
+
 func NameAttributeEquals(name string) string {
 	return fmt.Sprintf("name == \"%s\"", name)
 }
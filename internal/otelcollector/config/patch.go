@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ApplyMergePatch applies a JSON merge patch (RFC 7386) to a marshaled collector config and returns the patched config.
+// The patch is given as decoded YAML: maps are merged recursively, null values remove the key, and all other values replace the existing ones.
+// If the patch is empty, the config is returned unchanged.
+func ApplyMergePatch(configYAML []byte, patch map[string]any) ([]byte, error) {
+	if len(patch) == 0 {
+		return configYAML, nil
+	}
+
+	var target map[string]any
+	if err := yaml.Unmarshal(configYAML, &target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal collector config: %w", err)
+	}
+
+	patched, err := yaml.Marshal(mergePatch(target, patch))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patched collector config: %w", err)
+	}
+
+	return patched, nil
+}
+
+func mergePatch(target, patch map[string]any) map[string]any {
+	if target == nil {
+		target = make(map[string]any)
+	}
+
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			delete(target, key)
+			continue
+		}
+
+		patchMap, isMap := patchValue.(map[string]any)
+		if !isMap {
+			target[key] = patchValue
+			continue
+		}
+
+		targetMap, _ := target[key].(map[string]any)
+		target[key] = mergePatch(targetMap, patchMap)
+	}
+
+	return target
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	configYAML := []byte(`exporters:
+    otlp/backend:
+        endpoint: backend:4317
+        sending_queue:
+            enabled: true
+            queue_size: 256
+processors:
+    batch:
+        send_batch_size: 512
+    memory_limiter:
+        check_interval: 1s
+service:
+    pipelines:
+        traces/backend:
+            processors:
+                - memory_limiter
+                - batch
+`)
+
+	t.Run("empty patch", func(t *testing.T) {
+		patched, err := ApplyMergePatch(configYAML, nil)
+		require.NoError(t, err)
+		require.Equal(t, configYAML, patched)
+	})
+
+	t.Run("merge, replace, and remove", func(t *testing.T) {
+		var patch map[string]any
+		require.NoError(t, yaml.Unmarshal([]byte(`exporters:
+  otlp/backend:
+    sending_queue:
+      queue_size: 1024
+processors:
+  memory_limiter:
+  tail_sampling:
+    decision_wait: 10s
+service:
+  pipelines:
+    traces/backend:
+      processors: [batch, tail_sampling]
+`), &patch))
+
+		patched, err := ApplyMergePatch(configYAML, patch)
+		require.NoError(t, err)
+		require.Equal(t, `exporters:
+    otlp/backend:
+        endpoint: backend:4317
+        sending_queue:
+            enabled: true
+            queue_size: 1024
+processors:
+    batch:
+        send_batch_size: 512
+    tail_sampling:
+        decision_wait: 10s
+service:
+    pipelines:
+        traces/backend:
+            processors:
+                - batch
+                - tail_sampling
+`, string(patched))
+	})
+}
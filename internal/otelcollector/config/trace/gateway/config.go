@@ -24,8 +24,15 @@ type Processors struct {
 	DropNoisySpans     FilterProcessor                `yaml:"filter/drop-noisy-spans"`
 	ResolveServiceName *TransformProcessor            `yaml:"transform/resolve-service-name,omitempty"`
 	DropKymaAttributes *config.ResourceProcessor      `yaml:"resource/drop-kyma-attributes,omitempty"`
+	DropTestData       *FilterProcessor               `yaml:"filter/drop-test-data,omitempty"`
+
+	// PipelineFilters contains filter processors, which need different configurations per pipeline,
+	// such as the filters that keep only the test data of a pipeline or drop the spans of namespaces that a pipeline does not select
+	PipelineFilters PipelineFilters `yaml:",inline,omitempty"`
 }
 
+type PipelineFilters map[string]*FilterProcessor
+
 type FilterProcessor struct {
 	Traces Traces `yaml:"traces"`
 }
@@ -42,5 +49,21 @@ type TransformProcessor struct {
 type Exporters map[string]Exporter
 
 type Exporter struct {
-	OTLP *config.OTLPExporter `yaml:",inline,omitempty"`
+	OTLP  *config.OTLPExporter  `yaml:",inline,omitempty"`
+	Kafka *config.KafkaExporter `yaml:",inline,omitempty"`
+	Debug *config.DebugExporter `yaml:",inline,omitempty"`
+}
+
+// MarshalYAML renders the exporter configuration that is set. Inlining several configurations at once is rejected by the YAML encoder
+// because of their overlapping keys.
+func (e Exporter) MarshalYAML() (any, error) {
+	if e.Kafka != nil {
+		return e.Kafka, nil
+	}
+
+	if e.Debug != nil {
+		return e.Debug, nil
+	}
+
+	return e.OTLP, nil
 }
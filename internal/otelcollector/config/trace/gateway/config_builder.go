@@ -10,15 +10,24 @@ import (
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/kafkaexporter"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpexporter"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 )
 
 type Builder struct {
 	Reader client.Reader
 }
 
-func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1alpha1.TracePipeline) (*Config, otlpexporter.EnvVars, error) {
+type BuildOptions struct {
+	// DebugExporterVerbosity is the verbosity of the debug exporter that is added to a pipeline, keyed by pipeline name.
+	DebugExporterVerbosity map[string]string
+	// PendingTestData marks the pipelines, keyed by pipeline name, whose test data is still pending. Only those get a test data pipeline.
+	PendingTestData map[string]bool
+}
+
+func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1alpha1.TracePipeline, opts BuildOptions) (*Config, otlpexporter.EnvVars, error) {
 	cfg := &Config{
 		Base: config.Base{
 			Service:    config.DefaultService(make(config.Pipelines)),
@@ -40,14 +49,7 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1alpha1.Trace
 			continue
 		}
 
-		otlpExporterBuilder := otlpexporter.NewConfigBuilder(
-			b.Reader,
-			pipeline.Spec.Output.Otlp,
-			pipeline.Name,
-			queueSize,
-			otlpexporter.SignalTypeTrace,
-		)
-		if err := addComponentsForTracePipeline(ctx, otlpExporterBuilder, &pipeline, cfg, envVars); err != nil {
+		if err := addComponentsForTracePipeline(ctx, b.Reader, &pipeline, queueSize, cfg, envVars, opts); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -71,10 +73,75 @@ func makeReceiversConfig() Receivers {
 }
 
 // addComponentsForTracePipeline enriches a Config (exporters, processors, etc.) with components for a given telemetryv1alpha1.TracePipeline.
-func addComponentsForTracePipeline(ctx context.Context, otlpExporterBuilder *otlpexporter.ConfigBuilder, pipeline *telemetryv1alpha1.TracePipeline, cfg *Config, envVars otlpexporter.EnvVars) error {
+func addComponentsForTracePipeline(ctx context.Context, reader client.Reader, pipeline *telemetryv1alpha1.TracePipeline, queueSize int, cfg *Config, envVars otlpexporter.EnvVars, opts BuildOptions) error {
+	var (
+		exporterID string
+		err        error
+	)
+
+	if pipeline.Spec.Output.Kafka != nil {
+		exporterID, err = addKafkaExporter(ctx, reader, pipeline, queueSize, cfg, envVars)
+	} else {
+		exporterID, err = addOTLPExporter(ctx, reader, pipeline, queueSize, cfg, envVars)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	exporterIDs := []string{exporterID}
+
+	if verbosity := opts.DebugExporterVerbosity[pipeline.Name]; verbosity != "" {
+		debugExporterID := config.DebugExporterID(pipeline.Name)
+		cfg.Exporters[debugExporterID] = Exporter{Debug: &config.DebugExporter{Verbosity: verbosity}}
+		exporterIDs = append(exporterIDs, debugExporterID)
+	}
+
+	if cfg.Processors.PipelineFilters == nil {
+		cfg.Processors.PipelineFilters = make(PipelineFilters)
+	}
+
+	var namespaceFilterID string
+
+	if otlpInput := pipeline.Spec.Input.Otlp; otlpInput != nil && shouldFilterByNamespace(otlpInput.Namespaces) {
+		namespaceFilterID = fmt.Sprintf("filter/%s-filter-by-namespace", pipeline.Name)
+		cfg.Processors.PipelineFilters[namespaceFilterID] = makeFilterByNamespaceConfig(otlpInput.Namespaces)
+	}
+
+	pipelineID := fmt.Sprintf("traces/%s", pipeline.Name)
+	cfg.Service.Pipelines[pipelineID] = makePipelineConfig(namespaceFilterID, exporterIDs...)
+
+	if opts.PendingTestData[pipeline.Name] {
+		addTestDataComponents(pipeline.Name, exporterID, cfg)
+	}
+
+	return nil
+}
+
+// addTestDataComponents adds the test data pipeline of a TracePipeline, which exports only the test data with a copy of the exporter of the pipeline.
+func addTestDataComponents(pipelineName, exporterID string, cfg *Config) {
+	testDataExporterID := config.TestDataExporterID(exporterID, pipelineName)
+	cfg.Exporters[testDataExporterID] = cfg.Exporters[exporterID]
+
+	testDataFilterID := fmt.Sprintf("filter/%s-keep-test-data", pipelineName)
+	cfg.Processors.PipelineFilters[testDataFilterID] = makeKeepTestDataConfig(pipelineName)
+
+	testDataPipelineID := fmt.Sprintf("traces/%s", synthetic.TestDataName(pipelineName))
+	cfg.Service.Pipelines[testDataPipelineID] = makeTestDataPipelineConfig(testDataFilterID, testDataExporterID)
+}
+
+func addOTLPExporter(ctx context.Context, reader client.Reader, pipeline *telemetryv1alpha1.TracePipeline, queueSize int, cfg *Config, envVars otlpexporter.EnvVars) (string, error) {
+	otlpExporterBuilder := otlpexporter.NewConfigBuilder(
+		reader,
+		pipeline.Spec.Output.Otlp,
+		pipeline.Name,
+		queueSize,
+		otlpexporter.SignalTypeTrace,
+	)
+
 	otlpExporterConfig, otlpExporterEnvVars, err := otlpExporterBuilder.MakeConfig(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to make otlp exporter config: %w", err)
+		return "", fmt.Errorf("failed to make otlp exporter config: %w", err)
 	}
 
 	maps.Copy(envVars, otlpExporterEnvVars)
@@ -82,25 +149,77 @@ func addComponentsForTracePipeline(ctx context.Context, otlpExporterBuilder *otl
 	otlpExporterID := otlpexporter.ExporterID(pipeline.Spec.Output.Otlp.Protocol, pipeline.Name)
 	cfg.Exporters[otlpExporterID] = Exporter{OTLP: otlpExporterConfig}
 
-	pipelineID := fmt.Sprintf("traces/%s", pipeline.Name)
-	cfg.Service.Pipelines[pipelineID] = makePipelineConfig(otlpExporterID)
+	addOAuth2Extension(otlpExporterBuilder, pipeline.Name, cfg)
 
-	return nil
+	return otlpExporterID, nil
+}
+
+func addKafkaExporter(ctx context.Context, reader client.Reader, pipeline *telemetryv1alpha1.TracePipeline, queueSize int, cfg *Config, envVars otlpexporter.EnvVars) (string, error) {
+	kafkaExporterBuilder := kafkaexporter.NewConfigBuilder(reader, pipeline.Spec.Output.Kafka, pipeline.Name, queueSize)
+
+	kafkaExporterConfig, kafkaExporterEnvVars, err := kafkaExporterBuilder.MakeConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to make kafka exporter config: %w", err)
+	}
+
+	maps.Copy(envVars, kafkaExporterEnvVars)
+
+	kafkaExporterID := kafkaexporter.ExporterID(pipeline.Name)
+	cfg.Exporters[kafkaExporterID] = Exporter{Kafka: kafkaExporterConfig}
+
+	return kafkaExporterID, nil
+}
+
+// addOAuth2Extension declares the oauth2client extension that the OTLP exporter of a pipeline authenticates with, if any.
+func addOAuth2Extension(otlpExporterBuilder *otlpexporter.ConfigBuilder, pipelineName string, cfg *Config) {
+	extensionConfig := otlpExporterBuilder.MakeOAuth2ExtensionConfig()
+	if extensionConfig == nil {
+		return
+	}
+
+	if cfg.Extensions.OAuth2Clients == nil {
+		cfg.Extensions.OAuth2Clients = make(config.OAuth2ClientExtensions)
+	}
+
+	extensionID := otlpexporter.OAuth2ExtensionID(pipelineName)
+	cfg.Extensions.OAuth2Clients[extensionID] = extensionConfig
+	cfg.Service.Extensions = append(cfg.Service.Extensions, extensionID)
 }
 
-func makePipelineConfig(exporterIDs ...string) config.Pipeline {
+func shouldFilterByNamespace(namespaceSelector *telemetryv1alpha1.TracePipelineInputNamespaceSelector) bool {
+	return namespaceSelector != nil && (len(namespaceSelector.Include) > 0 || len(namespaceSelector.Exclude) > 0)
+}
+
+// makePipelineConfig creates the service pipeline of a TracePipeline. The namespace filter is optional and runs after the k8sattributes processor, which sets the namespace of the spans.
+func makePipelineConfig(namespaceFilterID string, exporterIDs ...string) config.Pipeline {
 	sort.Strings(exporterIDs)
 
+	processorIDs := []string{"memory_limiter", "filter/drop-test-data", "k8sattributes"}
+	if namespaceFilterID != "" {
+		processorIDs = append(processorIDs, namespaceFilterID)
+	}
+
+	processorIDs = append(processorIDs,
+		"filter/drop-noisy-spans",
+		"resource/insert-cluster-name",
+		"transform/resolve-service-name",
+		"resource/drop-kyma-attributes",
+		"batch",
+	)
+
 	return config.Pipeline{
-		Receivers: []string{"otlp"},
-		Processors: []string{"memory_limiter",
-			"k8sattributes",
-			"filter/drop-noisy-spans",
-			"resource/insert-cluster-name",
-			"transform/resolve-service-name",
-			"resource/drop-kyma-attributes",
-			"batch",
-		},
-		Exporters: exporterIDs,
+		Receivers:  []string{"otlp"},
+		Processors: processorIDs,
+		Exporters:  exporterIDs,
+	}
+}
+
+// makeTestDataPipelineConfig creates the service pipeline that exports only the test data of a TracePipeline, with a copy of the exporter of the pipeline.
+// The self monitor confirms the delivery of the test data by the export metrics of the copy.
+func makeTestDataPipelineConfig(testDataFilterID, testDataExporterID string) config.Pipeline {
+	return config.Pipeline{
+		Receivers:  []string{"otlp"},
+		Processors: []string{"memory_limiter", testDataFilterID, "resource/insert-cluster-name", "batch"},
+		Exporters:  []string{testDataExporterID},
 	}
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
@@ -26,7 +27,7 @@ func TestBuildConfig(t *testing.T) {
 	t.Run("otlp exporter endpoint", func(t *testing.T) {
 		collectorConfig, envVars, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
 			testutils.NewTracePipelineBuilder().WithName("test").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).Build(),
-		})
+		}, BuildOptions{})
 		require.NoError(t, err)
 
 		expectedEndpoint := fmt.Sprintf("${%s}", "OTLP_ENDPOINT_TEST")
@@ -41,7 +42,7 @@ func TestBuildConfig(t *testing.T) {
 	})
 
 	t.Run("secure", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()}, BuildOptions{})
 		require.NoError(t, err)
 		require.Contains(t, collectorConfig.Exporters, "otlp/test")
 
@@ -51,7 +52,7 @@ func TestBuildConfig(t *testing.T) {
 
 	t.Run("insecure", func(t *testing.T) {
 		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
-			testutils.NewTracePipelineBuilder().WithName("test-insecure").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).Build()})
+			testutils.NewTracePipelineBuilder().WithName("test-insecure").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).Build()}, BuildOptions{})
 		require.NoError(t, err)
 		require.Contains(t, collectorConfig.Exporters, "otlp/test-insecure")
 
@@ -62,7 +63,7 @@ func TestBuildConfig(t *testing.T) {
 	t.Run("basic auth", func(t *testing.T) {
 		collectorConfig, envVars, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
 			testutils.NewTracePipelineBuilder().WithName("test-basic-auth").WithOTLPOutput(testutils.OTLPBasicAuth("user", "password")).Build(),
-		})
+		}, BuildOptions{})
 		require.NoError(t, err)
 		require.Contains(t, collectorConfig.Exporters, "otlp/test-basic-auth")
 
@@ -81,7 +82,7 @@ func TestBuildConfig(t *testing.T) {
 	t.Run("custom header", func(t *testing.T) {
 		collectorConfig, envVars, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
 			testutils.NewTracePipelineBuilder().WithName("test-custom-header").WithOTLPOutput(testutils.OTLPCustomHeader("Authorization", "TOKEN_VALUE", "Api-Token")).Build(),
-		})
+		}, BuildOptions{})
 		require.NoError(t, err)
 		require.Contains(t, collectorConfig.Exporters, "otlp/test-custom-header")
 
@@ -98,7 +99,7 @@ func TestBuildConfig(t *testing.T) {
 	t.Run("mtls", func(t *testing.T) {
 		collectorConfig, envVars, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
 			testutils.NewTracePipelineBuilder().WithName("test-mtls").WithOTLPOutput(testutils.OTLPClientTLSFromString("ca", "cert", "key")).Build(),
-		})
+		}, BuildOptions{})
 		require.NoError(t, err)
 		require.Contains(t, collectorConfig.Exporters, "otlp/test-mtls")
 
@@ -114,7 +115,7 @@ func TestBuildConfig(t *testing.T) {
 	})
 
 	t.Run("extensions", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.NotEmpty(t, collectorConfig.Extensions.HealthCheck.Endpoint)
@@ -124,7 +125,7 @@ func TestBuildConfig(t *testing.T) {
 	})
 
 	t.Run("telemetry", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		metricreaders := []config.MetricReader{
@@ -146,7 +147,7 @@ func TestBuildConfig(t *testing.T) {
 	})
 
 	t.Run("single pipeline queue size", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()}, BuildOptions{})
 		require.NoError(t, err)
 		require.Equal(t, 256, collectorConfig.Exporters["otlp/test"].OTLP.SendingQueue.QueueSize, "Pipeline should have the full queue size")
 	})
@@ -155,7 +156,7 @@ func TestBuildConfig(t *testing.T) {
 		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
 			testutils.NewTracePipelineBuilder().WithName("test-1").Build(),
 			testutils.NewTracePipelineBuilder().WithName("test-2").Build(),
-			testutils.NewTracePipelineBuilder().WithName("test-3").Build()})
+			testutils.NewTracePipelineBuilder().WithName("test-3").Build()}, BuildOptions{})
 		require.NoError(t, err)
 		require.Equal(t, 85, collectorConfig.Exporters["otlp/test-1"].OTLP.SendingQueue.QueueSize, "Queue size should be divided by the number of pipelines")
 		require.Equal(t, 85, collectorConfig.Exporters["otlp/test-2"].OTLP.SendingQueue.QueueSize, "Queue size should be divided by the number of pipelines")
@@ -163,27 +164,85 @@ func TestBuildConfig(t *testing.T) {
 	})
 
 	t.Run("single pipeline topology", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.Contains(t, collectorConfig.Service.Pipelines, "traces/test")
 		require.Contains(t, collectorConfig.Service.Pipelines["traces/test"].Receivers, "otlp")
 
 		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[0], "memory_limiter")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[1], "k8sattributes")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[2], "filter/drop-noisy-spans")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[3], "resource/insert-cluster-name")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[4], "transform/resolve-service-name")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[5], "resource/drop-kyma-attributes")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[6], "batch")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[1], "filter/drop-test-data")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[2], "k8sattributes")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[3], "filter/drop-noisy-spans")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[4], "resource/insert-cluster-name")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[5], "transform/resolve-service-name")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[6], "resource/drop-kyma-attributes")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test"].Processors[7], "batch")
 
 		require.Contains(t, collectorConfig.Service.Pipelines["traces/test"].Exporters, "otlp/test")
+
+		require.NotContains(t, collectorConfig.Service.Pipelines, "traces/test_test-data")
+		require.NotContains(t, collectorConfig.Exporters, "otlp/test_test-data")
+	})
+
+	t.Run("test data pipeline while test data is pending", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()}, BuildOptions{
+			PendingTestData: map[string]bool{"test": true},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, config.Pipeline{
+			Receivers:  []string{"otlp"},
+			Processors: []string{"memory_limiter", "filter/test-keep-test-data", "resource/insert-cluster-name", "batch"},
+			Exporters:  []string{"otlp/test_test-data"},
+		}, collectorConfig.Service.Pipelines["traces/test_test-data"])
+		require.Equal(t, collectorConfig.Exporters["otlp/test"], collectorConfig.Exporters["otlp/test_test-data"])
+	})
+
+	t.Run("namespace filter", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
+			testutils.NewTracePipelineBuilder().WithName("test-1").WithOTLPInputNamespaces("team-a").Build(),
+			testutils.NewTracePipelineBuilder().WithName("test-2").Build()},
+			BuildOptions{})
+		require.NoError(t, err)
+
+		require.Equal(t, []string{
+			`not(resource.attributes["k8s.namespace.name"] == "team-a")`,
+		}, collectorConfig.Processors.PipelineFilters["filter/test-1-filter-by-namespace"].Traces.Span)
+		require.NotContains(t, collectorConfig.Processors.PipelineFilters, "filter/test-2-filter-by-namespace")
+
+		require.Equal(t, []string{
+			"memory_limiter",
+			"filter/drop-test-data",
+			"k8sattributes",
+			"filter/test-1-filter-by-namespace",
+			"filter/drop-noisy-spans",
+			"resource/insert-cluster-name",
+			"transform/resolve-service-name",
+			"resource/drop-kyma-attributes",
+			"batch",
+		}, collectorConfig.Service.Pipelines["traces/test-1"].Processors)
+		require.NotContains(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors, "filter/test-1-filter-by-namespace")
+	})
+
+	t.Run("debug exporter", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
+			testutils.NewTracePipelineBuilder().WithName("test-1").Build(),
+			testutils.NewTracePipelineBuilder().WithName("test-2").Build()},
+			BuildOptions{DebugExporterVerbosity: map[string]string{"test-1": "detailed"}})
+		require.NoError(t, err)
+
+		require.Equal(t, "detailed", collectorConfig.Exporters["debug/test-1"].Debug.Verbosity)
+		require.Equal(t, []string{"debug/test-1", "otlp/test-1"}, collectorConfig.Service.Pipelines["traces/test-1"].Exporters)
+
+		require.NotContains(t, collectorConfig.Exporters, "debug/test-2")
+		require.Equal(t, []string{"otlp/test-2"}, collectorConfig.Service.Pipelines["traces/test-2"].Exporters)
 	})
 
 	t.Run("multi pipeline topology", func(t *testing.T) {
 		collectorConfig, envVars, err := sut.Build(context.Background(), []telemetryv1alpha1.TracePipeline{
 			testutils.NewTracePipelineBuilder().WithName("test-1").Build(),
-			testutils.NewTracePipelineBuilder().WithName("test-2").Build()})
+			testutils.NewTracePipelineBuilder().WithName("test-2").Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.Contains(t, collectorConfig.Exporters, "otlp/test-1")
@@ -193,32 +252,126 @@ func TestBuildConfig(t *testing.T) {
 		require.Contains(t, collectorConfig.Service.Pipelines["traces/test-1"].Exporters, "otlp/test-1")
 		require.Contains(t, collectorConfig.Service.Pipelines["traces/test-1"].Receivers, "otlp")
 		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[0], "memory_limiter")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[1], "k8sattributes")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[2], "filter/drop-noisy-spans")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[3], "resource/insert-cluster-name")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[4], "transform/resolve-service-name")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[5], "resource/drop-kyma-attributes")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[6], "batch")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[1], "filter/drop-test-data")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[2], "k8sattributes")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[3], "filter/drop-noisy-spans")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[4], "resource/insert-cluster-name")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[5], "transform/resolve-service-name")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[6], "resource/drop-kyma-attributes")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-1"].Processors[7], "batch")
 
 		require.Contains(t, collectorConfig.Service.Pipelines, "traces/test-2")
 		require.Contains(t, collectorConfig.Service.Pipelines["traces/test-2"].Exporters, "otlp/test-2")
 		require.Contains(t, collectorConfig.Service.Pipelines["traces/test-2"].Receivers, "otlp")
 		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[0], "memory_limiter")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[1], "k8sattributes")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[2], "filter/drop-noisy-spans")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[3], "resource/insert-cluster-name")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[4], "transform/resolve-service-name")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[5], "resource/drop-kyma-attributes")
-		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[6], "batch")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[1], "filter/drop-test-data")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[2], "k8sattributes")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[3], "filter/drop-noisy-spans")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[4], "resource/insert-cluster-name")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[5], "transform/resolve-service-name")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[6], "resource/drop-kyma-attributes")
+		require.Equal(t, collectorConfig.Service.Pipelines["traces/test-2"].Processors[7], "batch")
 
 		require.Contains(t, envVars, "OTLP_ENDPOINT_TEST_1")
 		require.Contains(t, envVars, "OTLP_ENDPOINT_TEST_2")
 	})
 
+	t.Run("compression, timeout and retry", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
+			testutils.NewTracePipelineBuilder().WithName("test-tuned").WithOTLPOutput(
+				testutils.OTLPCompression(telemetryv1alpha1.OtlpCompressionNone),
+				testutils.OTLPTimeout("1m"),
+				testutils.OTLPRetry(&telemetryv1alpha1.OtlpRetry{InitialInterval: "1s", MaxElapsedTime: "10m"}),
+			).Build(),
+		}, BuildOptions{})
+		require.NoError(t, err)
+		require.Contains(t, collectorConfig.Exporters, "otlp/test-tuned")
+
+		otlpExporterConfig := collectorConfig.Exporters["otlp/test-tuned"]
+		require.Equal(t, "none", otlpExporterConfig.OTLP.Compression)
+		require.Equal(t, "1m", otlpExporterConfig.OTLP.Timeout)
+		require.True(t, otlpExporterConfig.OTLP.RetryOnFailure.Enabled)
+		require.Equal(t, "1s", otlpExporterConfig.OTLP.RetryOnFailure.InitialInterval)
+		require.Equal(t, "30s", otlpExporterConfig.OTLP.RetryOnFailure.MaxInterval)
+		require.Equal(t, "10m", otlpExporterConfig.OTLP.RetryOnFailure.MaxElapsedTime)
+	})
+
+	t.Run("retry disabled", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
+			testutils.NewTracePipelineBuilder().WithName("test-no-retry").WithOTLPOutput(
+				testutils.OTLPRetry(&telemetryv1alpha1.OtlpRetry{Enabled: ptr.To(false)}),
+			).Build(),
+		}, BuildOptions{})
+		require.NoError(t, err)
+		require.Equal(t, config.RetryOnFailure{Enabled: false}, collectorConfig.Exporters["otlp/test-no-retry"].OTLP.RetryOnFailure)
+
+		configYAML, err := yaml.Marshal(collectorConfig)
+		require.NoError(t, err, "failed to marshal config")
+		require.NotContains(t, string(configYAML), "initial_interval")
+	})
+
+	t.Run("oauth2", func(t *testing.T) {
+		collectorConfig, envVars, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
+			testutils.NewTracePipelineBuilder().WithName("test-oauth2").WithOTLPOutput(testutils.OTLPOAuth2("https://auth.example.com/token", "client-id", "client-secret", "traces.write")).Build(),
+		}, BuildOptions{})
+		require.NoError(t, err)
+		require.Contains(t, collectorConfig.Exporters, "otlp/test-oauth2")
+
+		otlpExporterConfig := collectorConfig.Exporters["otlp/test-oauth2"]
+		require.Equal(t, "oauth2client/test-oauth2", otlpExporterConfig.OTLP.Auth.Authenticator)
+
+		require.Contains(t, collectorConfig.Extensions.OAuth2Clients, "oauth2client/test-oauth2")
+		require.Contains(t, collectorConfig.Service.Extensions, "oauth2client/test-oauth2")
+
+		extensionConfig := collectorConfig.Extensions.OAuth2Clients["oauth2client/test-oauth2"]
+		require.Equal(t, "${OAUTH2_TOKEN_URL_TEST_OAUTH2}", extensionConfig.TokenURL)
+		require.Equal(t, []string{"traces.write"}, extensionConfig.Scopes)
+
+		require.Equal(t, "client-secret", string(envVars["OAUTH2_CLIENT_SECRET_TEST_OAUTH2"]))
+
+		configYAML, err := yaml.Marshal(collectorConfig)
+		require.NoError(t, err, "failed to marshal config")
+		require.Contains(t, string(configYAML), "oauth2client/test-oauth2:\n        client_id: ${OAUTH2_CLIENT_ID_TEST_OAUTH2}")
+	})
+
+	t.Run("kafka exporter", func(t *testing.T) {
+		collectorConfig, envVars, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{
+			testutils.NewTracePipelineBuilder().WithName("test-kafka").WithKafkaOutput(&telemetryv1alpha1.KafkaOutput{
+				Brokers: []string{"kafka:9093"},
+				Topic:   "traces",
+				Authentication: &telemetryv1alpha1.KafkaAuthenticationOptions{
+					SASL: &telemetryv1alpha1.KafkaSASLOptions{
+						User:     telemetryv1alpha1.ValueType{Value: "user"},
+						Password: telemetryv1alpha1.ValueType{Value: "password"},
+					},
+				},
+			}).Build(),
+		}, BuildOptions{})
+		require.NoError(t, err)
+
+		require.Contains(t, collectorConfig.Exporters, "kafka/test-kafka")
+		require.NotContains(t, collectorConfig.Exporters, "otlp/test-kafka")
+
+		kafkaExporterConfig := collectorConfig.Exporters["kafka/test-kafka"].Kafka
+		require.NotNil(t, kafkaExporterConfig)
+		require.Equal(t, []string{"kafka:9093"}, kafkaExporterConfig.Brokers)
+		require.Equal(t, "traces", kafkaExporterConfig.Topic)
+		require.Equal(t, "${KAFKA_SASL_USERNAME_TEST_KAFKA}", kafkaExporterConfig.Auth.SASL.Username)
+
+		require.Equal(t, []string{"kafka/test-kafka"}, collectorConfig.Service.Pipelines["traces/test-kafka"].Exporters)
+
+		require.Equal(t, "user", string(envVars["KAFKA_SASL_USERNAME_TEST_KAFKA"]))
+		require.Equal(t, "password", string(envVars["KAFKA_SASL_PASSWORD_TEST_KAFKA"]))
+
+		configYAML, err := yaml.Marshal(collectorConfig)
+		require.NoError(t, err, "failed to marshal config")
+		require.Contains(t, string(configYAML), "protocol_version: 2.0.0")
+	})
+
 	t.Run("marshaling", func(t *testing.T) {
 		config, _, err := sut.Build(context.Background(), []telemetryv1alpha1.TracePipeline{
 			testutils.NewTracePipelineBuilder().WithName("test").Build(),
-		})
+		}, BuildOptions{})
 		require.NoError(t, err)
 
 		configYAML, err := yaml.Marshal(config)
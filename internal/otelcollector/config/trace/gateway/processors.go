@@ -1,8 +1,13 @@
 package gateway
 
 import (
+	"fmt"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/gatewayprocs"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/ottlexpr"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 )
 
 func makeProcessorsConfig() Processors {
@@ -16,6 +21,7 @@ func makeProcessorsConfig() Processors {
 		DropNoisySpans:     makeDropNoisySpansConfig(),
 		ResolveServiceName: makeResolveServiceNameConfig(),
 		DropKymaAttributes: gatewayprocs.DropKymaAttributesProcessorConfig(),
+		DropTestData:       makeDropTestDataConfig(),
 	}
 }
 
@@ -43,3 +49,54 @@ func makeResolveServiceNameConfig() *TransformProcessor {
 		TraceStatements: gatewayprocs.ResolveServiceNameStatements(),
 	}
 }
+
+// makeFilterByNamespaceConfig drops the spans of the namespaces that are not selected.
+func makeFilterByNamespaceConfig(namespaceSelector *telemetryv1alpha1.TracePipelineInputNamespaceSelector) *FilterProcessor {
+	var filterExpressions []string
+
+	if len(namespaceSelector.Exclude) > 0 {
+		filterExpressions = append(filterExpressions, namespacesCondition(namespaceSelector.Exclude))
+	}
+
+	if len(namespaceSelector.Include) > 0 {
+		filterExpressions = append(filterExpressions, fmt.Sprintf("not%s", namespacesCondition(namespaceSelector.Include)))
+	}
+
+	return &FilterProcessor{
+		Traces: Traces{
+			Span: filterExpressions,
+		},
+	}
+}
+
+func namespacesCondition(namespaces []string) string {
+	var conditions []string
+	for _, ns := range namespaces {
+		conditions = append(conditions, ottlexpr.NamespaceEquals(ns))
+	}
+
+	return ottlexpr.JoinWithOr(conditions...)
+}
+
+// makeDropTestDataConfig drops all test data from the pipelines that export the spans of the workloads.
+func makeDropTestDataConfig() *FilterProcessor {
+	return &FilterProcessor{
+		Traces: Traces{
+			Span: []string{
+				ottlexpr.ResourceAttributeNotNil(synthetic.AttributePipeline),
+			},
+		},
+	}
+}
+
+// makeKeepTestDataConfig drops all spans except the test data of the given pipeline from its test data pipeline.
+func makeKeepTestDataConfig(pipelineName string) *FilterProcessor {
+	return &FilterProcessor{
+		Traces: Traces{
+			Span: []string{
+				ottlexpr.ResourceAttributeIsNil(synthetic.AttributePipeline),
+				ottlexpr.ResourceAttributeNotEquals(synthetic.AttributePipeline, pipelineName),
+			},
+		},
+	}
+}
@@ -17,7 +17,7 @@ func TestProcessors(t *testing.T) {
 	sut := Builder{Reader: fakeClient}
 
 	t.Run("insert cluster name processor", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, 1, len(collectorConfig.Processors.InsertClusterName.Attributes))
@@ -27,7 +27,7 @@ func TestProcessors(t *testing.T) {
 	})
 
 	t.Run("memory limit processors", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, "1s", collectorConfig.Processors.MemoryLimiter.CheckInterval)
@@ -36,7 +36,7 @@ func TestProcessors(t *testing.T) {
 	})
 
 	t.Run("batch processors", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, 512, collectorConfig.Processors.Batch.SendBatchSize)
@@ -45,7 +45,7 @@ func TestProcessors(t *testing.T) {
 	})
 
 	t.Run("k8s attributes processors", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, "serviceAccount", collectorConfig.Processors.K8sAttributes.AuthType)
@@ -73,7 +73,7 @@ func TestProcessors(t *testing.T) {
 	})
 
 	t.Run("filter processor", func(t *testing.T) {
-		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()})
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().Build()}, BuildOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, 9, len(collectorConfig.Processors.DropNoisySpans.Traces.Span), "Span filter list size is wrong")
@@ -86,4 +86,25 @@ func TestProcessors(t *testing.T) {
 		require.Contains(t, collectorConfig.Processors.DropNoisySpans.Traces.Span, fromVMScrapeAgent, "fromVmScrapeAgent span filter is missing")
 		require.Contains(t, collectorConfig.Processors.DropNoisySpans.Traces.Span, fromTelemetryMetricAgent, "fromTelemetryMetricAgent span filter is missing")
 	})
+
+	t.Run("test data processors", func(t *testing.T) {
+		collectorConfig, _, err := sut.Build(ctx, []telemetryv1alpha1.TracePipeline{testutils.NewTracePipelineBuilder().WithName("test").Build()}, BuildOptions{
+			PendingTestData: map[string]bool{"test": true},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]string{`resource.attributes["telemetry.test_data.pipeline"] != nil`},
+			collectorConfig.Processors.DropTestData.Traces.Span,
+		)
+
+		require.Contains(t, collectorConfig.Processors.PipelineFilters, "filter/test-keep-test-data")
+		require.Equal(t,
+			[]string{
+				`resource.attributes["telemetry.test_data.pipeline"] == nil`,
+				`resource.attributes["telemetry.test_data.pipeline"] != "test"`,
+			},
+			collectorConfig.Processors.PipelineFilters["filter/test-keep-test-data"].Traces.Span,
+		)
+	})
 }
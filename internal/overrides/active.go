@@ -0,0 +1,78 @@
+package overrides
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ActiveOverrides returns a short description of each override that is active, in the order in which they appear in the config.
+// The descriptions use the keys of the configmap, so that an override can easily be found and removed.
+func (c *Config) ActiveOverrides() []string {
+	var active []string
+
+	if c.Global.LogLevel != "" {
+		active = append(active, "global.logLevel="+c.Global.LogLevel)
+	}
+
+	if c.Global.SelfTracing.Enabled {
+		active = append(active, "global.selfTracing")
+	}
+
+	if c.Tracing.Paused {
+		active = append(active, "tracing.paused")
+	}
+
+	if c.Logging.Paused {
+		active = append(active, "logging.paused")
+	}
+
+	if c.Logging.CollectAgentLogs {
+		active = append(active, "logging.collectAgentLogs")
+	}
+
+	if c.Metrics.Paused {
+		active = append(active, "metrics.paused")
+	}
+
+	if c.Telemetry.Paused {
+		active = append(active, "telemetry.paused")
+	}
+
+	if len(c.CollectorPatches.TraceGateway) > 0 {
+		active = append(active, "collectorPatches.traceGateway")
+	}
+
+	if len(c.CollectorPatches.MetricGateway) > 0 {
+		active = append(active, "collectorPatches.metricGateway")
+	}
+
+	if len(c.CollectorPatches.MetricAgent) > 0 {
+		active = append(active, "collectorPatches.metricAgent")
+	}
+
+	for _, p := range c.Pipelines {
+		if settings := p.activeSettings(); len(settings) > 0 {
+			active = append(active, fmt.Sprintf("%s %s (%s)", p.Kind, p.Name, strings.Join(settings, ", ")))
+		}
+	}
+
+	return active
+}
+
+func (p PipelineConfig) activeSettings() []string {
+	var settings []string
+
+	if p.Paused {
+		settings = append(settings, "paused")
+	}
+
+	if p.LogLevel != "" {
+		settings = append(settings, "logLevel="+p.LogLevel)
+	}
+
+	if p.ExporterDebugLevel != "" {
+		settings = append(settings, "exporterDebugLevel="+p.ExporterDebugLevel)
+	}
+
+	return settings
+}
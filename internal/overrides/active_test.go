@@ -0,0 +1,38 @@
+package overrides
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveOverrides(t *testing.T) {
+	require.Empty(t, (&Config{}).ActiveOverrides())
+
+	config := Config{
+		Global: GlobalConfig{
+			LogLevel:    "debug",
+			SelfTracing: SelfTracingConfig{Enabled: true},
+		},
+		Tracing:   TracingConfig{Paused: true},
+		Logging:   LoggingConfig{CollectAgentLogs: true},
+		Telemetry: TelemetryConfig{Paused: true},
+		CollectorPatches: CollectorPatchesConfig{
+			MetricAgent: map[string]any{"processors": nil},
+		},
+		Pipelines: []PipelineConfig{
+			{Kind: KindTracePipeline, Name: "backend", Paused: true, LogLevel: "debug", ExporterDebugLevel: "detailed"},
+			{Kind: KindLogPipeline, Name: "no-settings"},
+		},
+	}
+
+	require.Equal(t, []string{
+		"global.logLevel=debug",
+		"global.selfTracing",
+		"tracing.paused",
+		"logging.collectAgentLogs",
+		"telemetry.paused",
+		"collectorPatches.metricAgent",
+		"TracePipeline backend (paused, logLevel=debug, exporterDebugLevel=detailed)",
+	}, config.ActiveOverrides())
+}
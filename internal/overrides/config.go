@@ -1,15 +1,30 @@
 package overrides
 
 type Config struct {
-	Global    GlobalConfig    `yaml:"global,omitempty"`
-	Tracing   TracingConfig   `yaml:"tracing,omitempty"`
-	Logging   LoggingConfig   `yaml:"logging,omitempty"`
-	Metrics   MetricConfig    `yaml:"metrics,omitempty"`
-	Telemetry TelemetryConfig `yaml:"telemetry,omitempty"`
+	Global    GlobalConfig     `yaml:"global,omitempty"`
+	Tracing   TracingConfig    `yaml:"tracing,omitempty"`
+	Logging   LoggingConfig    `yaml:"logging,omitempty"`
+	Metrics   MetricConfig     `yaml:"metrics,omitempty"`
+	Telemetry TelemetryConfig  `yaml:"telemetry,omitempty"`
+	Pipelines []PipelineConfig `yaml:"pipelines,omitempty"`
+
+	CollectorPatches CollectorPatchesConfig `yaml:"collectorPatches,omitempty"`
+
+	// Warnings describe the parts of the overrides config that are ignored, such as unknown keys or invalid pipeline overrides.
+	// They are not read from the configmap, but set when the config is loaded.
+	Warnings []string `yaml:"-"`
 }
 
 type GlobalConfig struct {
-	LogLevel string `yaml:"logLevel,omitempty"`
+	LogLevel    string            `yaml:"logLevel,omitempty"`
+	SelfTracing SelfTracingConfig `yaml:"selfTracing,omitempty"`
+}
+
+// SelfTracingConfig controls the tracing of the reconcile loops of the Telemetry Manager.
+type SelfTracingConfig struct {
+	Enabled bool `yaml:"enabled,omitempty"`
+	// Endpoint is the OTLP/HTTP endpoint URL to which the spans are exported. If empty, the trace gateway of the module is used.
+	Endpoint string `yaml:"endpoint,omitempty"`
 }
 
 type TracingConfig struct {
@@ -28,3 +43,22 @@ type MetricConfig struct {
 type TelemetryConfig struct {
 	Paused bool `yaml:"paused,omitempty"`
 }
+
+// CollectorPatchesConfig holds JSON merge patches (RFC 7386) for the generated OTel Collector configs, written as YAML.
+// Patching is not supported: a patch can break the collector, so the pipelines that use a patched collector are marked with status.unsupportedMode.
+type CollectorPatchesConfig struct {
+	TraceGateway  map[string]any `yaml:"traceGateway,omitempty"`
+	MetricGateway map[string]any `yaml:"metricGateway,omitempty"`
+	MetricAgent   map[string]any `yaml:"metricAgent,omitempty"`
+}
+
+// PipelineConfig overrides the reconciliation of a single pipeline, which is identified by its kind and name.
+type PipelineConfig struct {
+	Kind   string `yaml:"kind"`
+	Name   string `yaml:"name"`
+	Paused bool   `yaml:"paused,omitempty"`
+	// LogLevel is the log level of the Telemetry Manager while it reconciles the pipeline.
+	LogLevel string `yaml:"logLevel,omitempty"`
+	// ExporterDebugLevel adds a debug exporter with the given verbosity (basic, normal, or detailed) to the collector pipeline of the pipeline.
+	ExporterDebugLevel string `yaml:"exporterDebugLevel,omitempty"`
+}
@@ -2,7 +2,11 @@ package overrides
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"regexp"
+	"strings"
 	"sync"
 
 	"go.uber.org/zap"
@@ -12,18 +16,27 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 )
 
 const (
-	// name of the configmap where the overrides config is stored, the namespace is provided using HandlerConfig
-	configMapName = "telemetry-override-config"
+	// ConfigMapName is the name of the configmap where the overrides config is stored, the namespace is provided using HandlerConfig
+	ConfigMapName = "telemetry-override-config"
 	// config key in the overrides configmap
 	configKey = "override-config"
+	// name of the OTLP service of the trace gateway, to which self-tracing exports by default
+	traceOTLPServiceName = "telemetry-otlp-traces"
 )
 
 var (
 	atomicLevel zap.AtomicLevel
 	once        sync.Once
+
+	// unknownKeyPattern matches the errors that a strict yaml decode reports for unknown keys
+	unknownKeyPattern = regexp.MustCompile(`^line (\d+): field (.+) not found in type `)
 )
 
 type Handler struct {
@@ -31,6 +44,7 @@ type Handler struct {
 	config       HandlerConfig
 	atomicLevel  zap.AtomicLevel
 	defaultLevel zapcore.Level
+	selfTracing  *selftracing.Provider
 }
 
 type HandlerConfig struct {
@@ -46,6 +60,13 @@ func WithAtomicLevel(level zap.AtomicLevel) Option {
 	}
 }
 
+// WithSelfTracingProvider sets the provider that is configured with the self-tracing overrides. By default, the global provider is used.
+func WithSelfTracingProvider(provider *selftracing.Provider) Option {
+	return func(h *Handler) {
+		h.selfTracing = provider
+	}
+}
+
 // AtomicLevel returns a global atomic log level shared by all Handler instances and the root controller runtime logger.
 // This enables the log level to be changed globally if the user overrides it.
 func AtomicLevel() zap.AtomicLevel {
@@ -63,6 +84,7 @@ func New(client client.Reader, config HandlerConfig, opts ...Option) *Handler {
 	}
 
 	WithAtomicLevel(AtomicLevel())(h)
+	WithSelfTracingProvider(selftracing.Global())(h)
 
 	for _, opt := range opts {
 		opt(h)
@@ -81,6 +103,28 @@ func (h *Handler) LoadOverrides(ctx context.Context) (*Config, error) {
 		return nil, fmt.Errorf("failed to sync log level: %w", err)
 	}
 
+	// An invalid self-tracing override must not prevent the reconciliation, so self-tracing is disabled and the override is reported and ignored
+	if err := h.syncSelfTracing(ctx, overrideConfig.Global.SelfTracing); err != nil {
+		overrideConfig.Warnings = append(overrideConfig.Warnings, fmt.Sprintf("failed to sync self-tracing: %v", err))
+		overrideConfig.Global.SelfTracing = SelfTracingConfig{}
+
+		if err := h.selfTracing.Sync(ctx, ""); err != nil {
+			return nil, fmt.Errorf("failed to disable self-tracing: %w", err)
+		}
+	}
+
+	// An invalid pipeline override must not prevent the reconciliation of the other pipelines, so it is reported and ignored
+	validPipelines, errs := validatePipelines(overrideConfig.Pipelines)
+	for _, err := range errs {
+		overrideConfig.Warnings = append(overrideConfig.Warnings, err.Error())
+	}
+
+	overrideConfig.Pipelines = validPipelines
+
+	for _, warning := range overrideConfig.Warnings {
+		logf.FromContext(ctx).Error(errors.New(warning), "Ignoring invalid override")
+	}
+
 	return overrideConfig, nil
 }
 
@@ -96,19 +140,51 @@ func (h *Handler) loadOverridesConfig(ctx context.Context) (*Config, error) {
 		return &overrideConfig, nil
 	}
 
-	err = yaml.Unmarshal([]byte(config), &overrideConfig)
-	if err != nil {
-		return &overrideConfig, err
+	// Unknown keys, which are usually typos, are decoded strictly so that they can be reported, but they do not invalidate the rest of the config
+	decoder := yaml.NewDecoder(strings.NewReader(config))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(&overrideConfig)
+	if errors.Is(err, io.EOF) {
+		return &overrideConfig, nil
 	}
 
-	return &overrideConfig, nil
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		unknownKeys, ok := unknownKeyWarnings(typeErr)
+		if !ok {
+			return &overrideConfig, err
+		}
+
+		overrideConfig.Warnings = unknownKeys
+
+		return &overrideConfig, nil
+	}
+
+	return &overrideConfig, err
+}
+
+// unknownKeyWarnings converts the errors of a strict decode into warnings. It returns false if any error is caused by something else than an unknown key.
+func unknownKeyWarnings(typeErr *yaml.TypeError) ([]string, bool) {
+	var warnings []string
+
+	for _, msg := range typeErr.Errors {
+		match := unknownKeyPattern.FindStringSubmatch(msg)
+		if match == nil {
+			return nil, false
+		}
+
+		warnings = append(warnings, fmt.Sprintf("unknown key %q in line %s", match[2], match[1]))
+	}
+
+	return warnings, true
 }
 
 func (h *Handler) readConfigMapOrEmpty(ctx context.Context) (string, error) {
 	var cm corev1.ConfigMap
 
 	cmName := types.NamespacedName{
-		Name:      configMapName,
+		Name:      ConfigMapName,
 		Namespace: h.config.SystemNamespace,
 	}
 	if err := h.client.Get(ctx, cmName, &cm); err != nil {
@@ -143,3 +219,16 @@ func (h *Handler) syncLogLevel(config GlobalConfig) error {
 
 	return nil
 }
+
+func (h *Handler) syncSelfTracing(ctx context.Context, config SelfTracingConfig) error {
+	if !config.Enabled {
+		return h.selfTracing.Sync(ctx, "")
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("http://%s.%s:%d", traceOTLPServiceName, h.config.SystemNamespace, ports.OTLPHTTP)
+	}
+
+	return h.selfTracing.Sync(ctx, endpoint)
+}
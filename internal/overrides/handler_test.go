@@ -11,6 +11,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 )
 
 func TestLoadOverrides(t *testing.T) {
@@ -57,6 +59,74 @@ func TestLoadOverrides(t *testing.T) {
 			expectError:       true,
 			expectedLogLevel:  zapcore.InfoLevel,
 		},
+		{
+			name:              "configmap with comments only",
+			configMapData:     map[string]string{configKey: "# tracing:\n#   paused: true\n"},
+			defaultLevel:      zapcore.InfoLevel,
+			expectedOverrides: &Config{},
+			expectError:       false,
+			expectedLogLevel:  zapcore.InfoLevel,
+		},
+		{
+			name: "unknown keys",
+			configMapData: map[string]string{
+				configKey: `global:
+  loglevel: debug
+tracing:
+  pause: true
+metrics:
+  paused: true`,
+			},
+			defaultLevel: zapcore.InfoLevel,
+			expectedOverrides: &Config{
+				Metrics: MetricConfig{
+					Paused: true,
+				},
+				Warnings: []string{
+					`unknown key "loglevel" in line 2`,
+					`unknown key "pause" in line 4`,
+				},
+			},
+			expectError:      false,
+			expectedLogLevel: zapcore.InfoLevel,
+		},
+		{
+			name: "collector patches",
+			configMapData: map[string]string{
+				configKey: `collectorPatches:
+  traceGateway:
+    processors:
+      batch:
+        send_batch_size: 1024
+      memory_limiter:`,
+			},
+			defaultLevel: zapcore.InfoLevel,
+			expectedOverrides: &Config{
+				CollectorPatches: CollectorPatchesConfig{
+					TraceGateway: map[string]any{
+						"processors": map[string]any{
+							"batch":          map[string]any{"send_batch_size": 1024},
+							"memory_limiter": nil,
+						},
+					},
+				},
+			},
+			expectError:      false,
+			expectedLogLevel: zapcore.InfoLevel,
+		},
+		{
+			name: "invalid value",
+			configMapData: map[string]string{
+				configKey: `tracing:
+  paused: yes please
+metrics:
+  pause: true`,
+			},
+			defaultLevel:      zapcore.InfoLevel,
+			expectedOverrides: nil,
+			expectError:       true,
+			expectedLogLevel:  zapcore.InfoLevel,
+		},
 		{
 			name: "valid configmap",
 			configMapData: map[string]string{
@@ -86,7 +156,7 @@ tracing:
 			if tt.configMapData != nil {
 				configMap := &corev1.ConfigMap{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      configMapName,
+						Name:      ConfigMapName,
 						Namespace: "test-namespace",
 					},
 					Data: tt.configMapData,
@@ -116,7 +186,7 @@ func TestLoadOverridesResetsLogLevelIfNoConfigMapFound(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().Build()
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      configMapName,
+			Name:      ConfigMapName,
 			Namespace: "test-namespace",
 		},
 		Data: map[string]string{
@@ -143,3 +213,91 @@ tracing:
 	require.NoError(t, err)
 	require.Equal(t, atomicLevel.Level(), zapcore.InfoLevel, "Should reset log level back to info after loading empty overrides")
 }
+
+func TestLoadOverridesSyncsSelfTracing(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           string
+		initialEndpoint  string
+		expectWarning    bool
+		expectedEndpoint string
+	}{
+		{
+			name:             "disabled",
+			config:           "",
+			expectedEndpoint: "",
+		},
+		{
+			name: "enabled with default endpoint",
+			config: `global:
+  selfTracing:
+    enabled: true`,
+			expectedEndpoint: "http://telemetry-otlp-traces.test-namespace:4318",
+		},
+		{
+			name: "enabled with custom endpoint",
+			config: `global:
+  selfTracing:
+    enabled: true
+    endpoint: https://otlp.example.com:4318`,
+			expectedEndpoint: "https://otlp.example.com:4318",
+		},
+		{
+			name: "endpoint without enabled flag",
+			config: `global:
+  selfTracing:
+    endpoint: https://otlp.example.com:4318`,
+			expectedEndpoint: "",
+		},
+		{
+			name: "invalid endpoint",
+			config: `global:
+  selfTracing:
+    enabled: true
+    endpoint: otlp.example.com:4318`,
+			expectWarning:    true,
+			expectedEndpoint: "",
+		},
+		{
+			name: "invalid endpoint disables previously enabled self-tracing",
+			config: `global:
+  selfTracing:
+    enabled: true
+    endpoint: otlp.example.com:4318`,
+			initialEndpoint:  "https://otlp.example.com:4318",
+			expectWarning:    true,
+			expectedEndpoint: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ConfigMapName,
+					Namespace: "test-namespace",
+				},
+				Data: map[string]string{configKey: tt.config},
+			}).Build()
+
+			provider := selftracing.NewProvider()
+			defer provider.Shutdown(context.Background()) //nolint:errcheck // nothing to flush in tests
+
+			require.NoError(t, provider.Sync(context.Background(), tt.initialEndpoint))
+
+			handler := New(fakeClient, HandlerConfig{SystemNamespace: "test-namespace"}, WithAtomicLevel(zap.NewAtomicLevel()), WithSelfTracingProvider(provider))
+			overrideConfig, err := handler.LoadOverrides(context.Background())
+			require.NoError(t, err)
+
+			if tt.expectWarning {
+				require.Len(t, overrideConfig.Warnings, 1)
+				require.Contains(t, overrideConfig.Warnings[0], "failed to sync self-tracing")
+				require.False(t, overrideConfig.Global.SelfTracing.Enabled)
+			} else {
+				require.Empty(t, overrideConfig.Warnings)
+			}
+
+			require.Equal(t, tt.expectedEndpoint, provider.Endpoint())
+		})
+	}
+}
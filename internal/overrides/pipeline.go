@@ -0,0 +1,115 @@
+package overrides
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap/zapcore"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/telemetry-manager/internal/logger"
+)
+
+const (
+	KindTracePipeline  = "TracePipeline"
+	KindMetricPipeline = "MetricPipeline"
+	KindLogPipeline    = "LogPipeline"
+)
+
+var exporterDebugLevels = []string{"basic", "normal", "detailed"}
+
+// Pipeline returns the overrides of the pipeline with the given kind and name. If there are none, the returned config is empty.
+func (c *Config) Pipeline(kind, name string) PipelineConfig {
+	for _, p := range c.Pipelines {
+		if p.Kind == kind && p.Name == name {
+			return p
+		}
+	}
+
+	return PipelineConfig{}
+}
+
+// ExporterDebugLevels returns the debug exporter verbosity of all pipelines of the given kind that override it, keyed by pipeline name.
+func (c *Config) ExporterDebugLevels(kind string) map[string]string {
+	levels := make(map[string]string)
+
+	for _, p := range c.Pipelines {
+		if p.Kind == kind && p.ExporterDebugLevel != "" {
+			levels[p.Name] = p.ExporterDebugLevel
+		}
+	}
+
+	return levels
+}
+
+// ContextWithLogLevel returns a context whose logger uses the log level that is overridden for the pipeline. If the log level is not overridden, ctx is returned unchanged.
+func (p PipelineConfig) ContextWithLogLevel(ctx context.Context) context.Context {
+	if p.LogLevel == "" {
+		return ctx
+	}
+
+	level, err := zapcore.ParseLevel(p.LogLevel)
+	if err != nil {
+		return ctx
+	}
+
+	return logf.IntoContext(ctx, logger.WithLevel(logf.FromContext(ctx), level))
+}
+
+// validatePipelines returns the pipeline overrides that are valid, and an error for each invalid one.
+func validatePipelines(configs []PipelineConfig) ([]PipelineConfig, []error) {
+	var (
+		valid []PipelineConfig
+		errs  []error
+	)
+
+	seen := make(map[string]bool)
+
+	for _, p := range configs {
+		if err := validatePipeline(p); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		key := p.Kind + "/" + p.Name
+		if seen[key] {
+			errs = append(errs, fmt.Errorf("duplicate override for %s %s", p.Kind, p.Name))
+			continue
+		}
+
+		seen[key] = true
+
+		valid = append(valid, p)
+	}
+
+	return valid, errs
+}
+
+func validatePipeline(p PipelineConfig) error {
+	if !slices.Contains([]string{KindTracePipeline, KindMetricPipeline, KindLogPipeline}, p.Kind) {
+		return fmt.Errorf("invalid override for pipeline %q: unknown kind %q", p.Name, p.Kind)
+	}
+
+	if p.Name == "" {
+		return fmt.Errorf("invalid override for %s: name is missing", p.Kind)
+	}
+
+	if p.LogLevel != "" {
+		if _, err := zapcore.ParseLevel(p.LogLevel); err != nil {
+			return fmt.Errorf("invalid override for %s %s: %w", p.Kind, p.Name, err)
+		}
+	}
+
+	if p.ExporterDebugLevel != "" {
+		if p.Kind == KindLogPipeline {
+			return fmt.Errorf("invalid override for %s %s: exporterDebugLevel is not supported for LogPipelines", p.Kind, p.Name)
+		}
+
+		if !slices.Contains(exporterDebugLevels, p.ExporterDebugLevel) {
+			return fmt.Errorf("invalid override for %s %s: unknown exporterDebugLevel %q", p.Kind, p.Name, p.ExporterDebugLevel)
+		}
+	}
+
+	return nil
+}
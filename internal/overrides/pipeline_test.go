@@ -0,0 +1,105 @@
+package overrides
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/zapr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestLoadOverridesValidatesPipelines(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName,
+			Namespace: "test-namespace",
+		},
+		Data: map[string]string{configKey: `pipelines:
+- kind: TracePipeline
+  name: valid
+  paused: true
+  logLevel: debug
+  exporterDebugLevel: detailed
+- kind: TracePipeline
+  name: valid
+  paused: false
+- kind: Tracepipeline
+  name: unknown-kind
+- kind: MetricPipeline
+- kind: MetricPipeline
+  name: invalid-log-level
+  logLevel: verbose
+- kind: MetricPipeline
+  name: invalid-debug-level
+  exporterDebugLevel: verbose
+- kind: LogPipeline
+  name: unsupported-debug-level
+  exporterDebugLevel: basic
+- kind: LogPipeline
+  name: valid
+  paused: true`},
+	}).Build()
+
+	core, logs := observer.New(zapcore.ErrorLevel)
+	ctx := logf.IntoContext(context.Background(), zapr.NewLogger(zap.New(core)))
+
+	handler := New(fakeClient, HandlerConfig{SystemNamespace: "test-namespace"}, WithAtomicLevel(zap.NewAtomicLevel()))
+	config, err := handler.LoadOverrides(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, []PipelineConfig{
+		{Kind: KindTracePipeline, Name: "valid", Paused: true, LogLevel: "debug", ExporterDebugLevel: "detailed"},
+		{Kind: KindLogPipeline, Name: "valid", Paused: true},
+	}, config.Pipelines)
+
+	var messages []string
+	for _, entry := range logs.AllUntimed() {
+		messages = append(messages, entry.ContextMap()["error"].(string))
+	}
+
+	require.Equal(t, []string{
+		"duplicate override for TracePipeline valid",
+		`invalid override for pipeline "unknown-kind": unknown kind "Tracepipeline"`,
+		"invalid override for MetricPipeline: name is missing",
+		`invalid override for MetricPipeline invalid-log-level: unrecognized level: "verbose"`,
+		`invalid override for MetricPipeline invalid-debug-level: unknown exporterDebugLevel "verbose"`,
+		"invalid override for LogPipeline unsupported-debug-level: exporterDebugLevel is not supported for LogPipelines",
+	}, messages)
+	require.Equal(t, messages, config.Warnings)
+}
+
+func TestPipeline(t *testing.T) {
+	config := Config{
+		Pipelines: []PipelineConfig{
+			{Kind: KindTracePipeline, Name: "a", Paused: true},
+			{Kind: KindMetricPipeline, Name: "a", ExporterDebugLevel: "basic"},
+			{Kind: KindMetricPipeline, Name: "b", LogLevel: "debug"},
+		},
+	}
+
+	require.True(t, config.Pipeline(KindTracePipeline, "a").Paused)
+	require.False(t, config.Pipeline(KindMetricPipeline, "a").Paused)
+	require.Equal(t, PipelineConfig{}, config.Pipeline(KindLogPipeline, "a"))
+
+	require.Equal(t, map[string]string{"a": "basic"}, config.ExporterDebugLevels(KindMetricPipeline))
+	require.Empty(t, config.ExporterDebugLevels(KindTracePipeline))
+}
+
+func TestContextWithLogLevel(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	ctx := logf.IntoContext(context.Background(), zapr.NewLogger(zap.New(core)))
+
+	logf.FromContext(PipelineConfig{}.ContextWithLogLevel(ctx)).V(1).Info("not logged")
+	logf.FromContext(PipelineConfig{LogLevel: "debug"}.ContextWithLogLevel(ctx)).V(1).Info("logged")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 1)
+	require.Equal(t, "logged", entries[0].Message)
+}
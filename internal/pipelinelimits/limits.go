@@ -0,0 +1,91 @@
+package pipelinelimits
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+)
+
+// Default maximum numbers of pipelines that apply if the Telemetry resource does not configure them.
+const (
+	DefaultMaxTracePipelines  = 3
+	DefaultMaxMetricPipelines = 3
+	DefaultMaxLogPipelines    = 5
+
+	DefaultMaxTracePipelinesPerNamespace = 1
+	DefaultMaxLogPipelinesPerNamespace   = 1
+)
+
+// MaxTracePipelines returns the maximum number of TracePipelines that is configured in the Telemetry resource, or the default if none is found.
+func MaxTracePipelines(ctx context.Context, c client.Reader) int {
+	return maxPipelines(ctx, c, DefaultMaxTracePipelines, func(spec *operatorv1alpha1.TelemetrySpec) *int32 {
+		if spec.Trace == nil {
+			return nil
+		}
+
+		return spec.Trace.MaxPipelines
+	})
+}
+
+// MaxMetricPipelines returns the maximum number of MetricPipelines that is configured in the Telemetry resource, or the default if none is found.
+func MaxMetricPipelines(ctx context.Context, c client.Reader) int {
+	return maxPipelines(ctx, c, DefaultMaxMetricPipelines, func(spec *operatorv1alpha1.TelemetrySpec) *int32 {
+		if spec.Metric == nil {
+			return nil
+		}
+
+		return spec.Metric.MaxPipelines
+	})
+}
+
+// MaxLogPipelines returns the maximum number of LogPipelines that is configured in the Telemetry resource, or the default if none is found.
+func MaxLogPipelines(ctx context.Context, c client.Reader) int {
+	return maxPipelines(ctx, c, DefaultMaxLogPipelines, func(spec *operatorv1alpha1.TelemetrySpec) *int32 {
+		if spec.Log == nil {
+			return nil
+		}
+
+		return spec.Log.MaxPipelines
+	})
+}
+
+// MaxTracePipelinesPerNamespace returns the maximum number of NamespacedTracePipelines per namespace that is configured in the Telemetry resource, or the default if none is found.
+func MaxTracePipelinesPerNamespace(ctx context.Context, c client.Reader) int {
+	return maxPipelines(ctx, c, DefaultMaxTracePipelinesPerNamespace, func(spec *operatorv1alpha1.TelemetrySpec) *int32 {
+		if spec.Trace == nil {
+			return nil
+		}
+
+		return spec.Trace.MaxPipelinesPerNamespace
+	})
+}
+
+// MaxLogPipelinesPerNamespace returns the maximum number of NamespacedLogPipelines per namespace that is configured in the Telemetry resource, or the default if none is found.
+func MaxLogPipelinesPerNamespace(ctx context.Context, c client.Reader) int {
+	return maxPipelines(ctx, c, DefaultMaxLogPipelinesPerNamespace, func(spec *operatorv1alpha1.TelemetrySpec) *int32 {
+		if spec.Log == nil {
+			return nil
+		}
+
+		return spec.Log.MaxPipelinesPerNamespace
+	})
+}
+
+func maxPipelines(ctx context.Context, c client.Reader, defaultValue int, configured func(spec *operatorv1alpha1.TelemetrySpec) *int32) int {
+	var telemetries operatorv1alpha1.TelemetryList
+	if err := c.List(ctx, &telemetries); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to list telemetry, using default maximum number of pipelines", "error", err)
+		return defaultValue
+	}
+
+	for i := range telemetries.Items {
+		if value := configured(&telemetries.Items[i].Spec); value != nil {
+			return int(*value)
+		}
+	}
+
+	return defaultValue
+}
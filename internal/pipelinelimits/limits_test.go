@@ -0,0 +1,49 @@
+package pipelinelimits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+)
+
+func TestMaxPipelines(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, operatorv1alpha1.AddToScheme(scheme))
+
+	ctx := context.Background()
+
+	t.Run("no telemetry", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		require.Equal(t, DefaultMaxTracePipelines, MaxTracePipelines(ctx, fakeClient))
+		require.Equal(t, DefaultMaxMetricPipelines, MaxMetricPipelines(ctx, fakeClient))
+		require.Equal(t, DefaultMaxLogPipelines, MaxLogPipelines(ctx, fakeClient))
+		require.Equal(t, DefaultMaxTracePipelinesPerNamespace, MaxTracePipelinesPerNamespace(ctx, fakeClient))
+		require.Equal(t, DefaultMaxLogPipelinesPerNamespace, MaxLogPipelinesPerNamespace(ctx, fakeClient))
+	})
+
+	t.Run("partially configured", func(t *testing.T) {
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				Trace:  &operatorv1alpha1.TraceSpec{MaxPipelines: ptr.To[int32](5)},
+				Metric: &operatorv1alpha1.MetricSpec{},
+				Log:    &operatorv1alpha1.LogSpec{MaxPipelines: ptr.To[int32](8), MaxPipelinesPerNamespace: ptr.To[int32](0)},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(telemetry).Build()
+
+		require.Equal(t, 5, MaxTracePipelines(ctx, fakeClient))
+		require.Equal(t, DefaultMaxMetricPipelines, MaxMetricPipelines(ctx, fakeClient))
+		require.Equal(t, 8, MaxLogPipelines(ctx, fakeClient))
+		require.Equal(t, DefaultMaxTracePipelinesPerNamespace, MaxTracePipelinesPerNamespace(ctx, fakeClient))
+		require.Zero(t, MaxLogPipelinesPerNamespace(ctx, fakeClient))
+	})
+}
@@ -0,0 +1,214 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+const (
+	EnvVarHTTPProxy  = "HTTP_PROXY"
+	EnvVarHTTPSProxy = "HTTPS_PROXY"
+	EnvVarNoProxy    = "NO_PROXY"
+)
+
+// defaultNoProxy excludes destinations inside the cluster from proxying, including the Kubernetes API server,
+// which is addressed by the IP from the KUBERNETES_SERVICE_HOST service environment variable.
+var defaultNoProxy = []string{
+	"localhost",
+	"127.0.0.1",
+	".svc",
+	".cluster.local",
+	"$(KUBERNETES_SERVICE_HOST)",
+}
+
+// Output is the endpoint of a pipeline output together with the proxy settings of the output.
+type Output struct {
+	PipelineName string
+	Endpoint     telemetryv1alpha1.ValueType
+	Proxy        *telemetryv1alpha1.OutputProxy
+}
+
+// EnvVarsMaker makes the proxy environment variables of a component, see MakeEnvVars and MakeFluentBitEnvVars.
+type EnvVarsMaker func(settings *operatorv1alpha1.ProxySpec, bypassHosts ...string) []corev1.EnvVar
+
+// GetSettings returns the cluster-wide proxy settings from the Telemetry resource, or nil if no proxy is configured.
+func GetSettings(ctx context.Context, c client.Reader) (*operatorv1alpha1.ProxySpec, error) {
+	var telemetries operatorv1alpha1.TelemetryList
+	if err := c.List(ctx, &telemetries); err != nil {
+		return nil, fmt.Errorf("failed to list telemetry: %w", err)
+	}
+
+	for i := range telemetries.Items {
+		if proxySpec := telemetries.Items[i].Spec.Proxy; proxySpec.IsDefined() {
+			return proxySpec, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetEnvVars returns the proxy environment variables of a component for the cluster-wide proxy settings. Hosts of outputs that disable the proxy are excluded from proxying.
+// If the proxy settings cannot be read, the component uses no proxy.
+func GetEnvVars(ctx context.Context, c client.Reader, outputs []Output, makeEnvVars EnvVarsMaker) []corev1.EnvVar {
+	settings, err := GetSettings(ctx, c)
+	if err != nil {
+		logf.FromContext(ctx).V(1).Error(err, "Failed to get proxy settings: using no proxy")
+		return nil
+	}
+
+	if settings == nil {
+		return nil
+	}
+
+	var bypassHosts []string
+
+	for _, output := range outputs {
+		if !IsBypassed(output.Proxy) {
+			continue
+		}
+
+		host, err := ResolveHost(ctx, c, output.Endpoint)
+		if err != nil {
+			logf.FromContext(ctx).V(1).Error(err, "Failed to resolve output host: output uses the proxy", "pipeline", output.PipelineName)
+			continue
+		}
+
+		bypassHosts = append(bypassHosts, host)
+	}
+
+	return makeEnvVars(settings, bypassHosts...)
+}
+
+// MakeEnvVars returns the proxy environment variables for an OpenTelemetry Collector. The bypassHosts are added to the default NO_PROXY list,
+// so that outputs that disable the proxy connect to their backend directly.
+func MakeEnvVars(settings *operatorv1alpha1.ProxySpec, bypassHosts ...string) []corev1.EnvVar {
+	if !settings.IsDefined() {
+		return nil
+	}
+
+	var envVars []corev1.EnvVar
+	if settings.HTTPProxy != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: EnvVarHTTPProxy, Value: settings.HTTPProxy})
+	}
+
+	if settings.HTTPSProxy != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: EnvVarHTTPSProxy, Value: settings.HTTPSProxy})
+	}
+
+	return append(envVars, corev1.EnvVar{Name: EnvVarNoProxy, Value: makeNoProxy(settings, bypassHosts)})
+}
+
+// MakeFluentBitEnvVars returns the proxy environment variables for Fluent Bit. Fluent Bit sends both HTTP and HTTPS traffic through
+// the proxy defined in HTTP_PROXY, so the HTTPS proxy is used if no dedicated HTTP proxy is configured.
+func MakeFluentBitEnvVars(settings *operatorv1alpha1.ProxySpec, bypassHosts ...string) []corev1.EnvVar {
+	if !settings.IsDefined() {
+		return nil
+	}
+
+	httpProxy := settings.HTTPProxy
+	if httpProxy == "" {
+		httpProxy = settings.HTTPSProxy
+	}
+
+	return []corev1.EnvVar{
+		{Name: EnvVarHTTPProxy, Value: httpProxy},
+		{Name: EnvVarNoProxy, Value: makeNoProxy(settings, bypassHosts)},
+	}
+}
+
+func makeNoProxy(settings *operatorv1alpha1.ProxySpec, bypassHosts []string) string {
+	noProxy := slices.Clone(defaultNoProxy)
+	noProxy = append(noProxy, settings.NoProxy...)
+
+	for _, host := range bypassHosts {
+		if host != "" && !slices.Contains(noProxy, host) {
+			noProxy = append(noProxy, host)
+		}
+	}
+
+	return strings.Join(noProxy, ",")
+}
+
+// URLFor returns the URL of the proxy that is used for a request with the given scheme to the given host, or nil if the request is not proxied.
+// It follows the semantics of the proxy environment variables returned by MakeEnvVars.
+func URLFor(settings *operatorv1alpha1.ProxySpec, scheme, host string) (*url.URL, error) {
+	if !settings.IsDefined() {
+		return nil, nil
+	}
+
+	config := httpproxy.Config{
+		HTTPProxy:  settings.HTTPProxy,
+		HTTPSProxy: settings.HTTPSProxy,
+		NoProxy:    makeNoProxy(settings, nil),
+	}
+
+	return config.ProxyFunc()(&url.URL{Scheme: scheme, Host: host})
+}
+
+// FluentBitURLFor returns the URL of the proxy that Fluent Bit uses for requests to the given host, or nil if the requests are not proxied.
+// It follows the semantics of the proxy environment variables returned by MakeFluentBitEnvVars.
+func FluentBitURLFor(settings *operatorv1alpha1.ProxySpec, host string) (*url.URL, error) {
+	if !settings.IsDefined() {
+		return nil, nil
+	}
+
+	httpProxy := settings.HTTPProxy
+	if httpProxy == "" {
+		httpProxy = settings.HTTPSProxy
+	}
+
+	return URLFor(&operatorv1alpha1.ProxySpec{HTTPProxy: httpProxy, NoProxy: settings.NoProxy}, "http", host)
+}
+
+// IsBypassed returns whether an output disables the cluster-wide proxy.
+func IsBypassed(outputProxy *telemetryv1alpha1.OutputProxy) bool {
+	return outputProxy != nil && outputProxy.Disabled
+}
+
+// ResolveHost returns the host name of an output endpoint, which can be a URL or a host with an optional port.
+func ResolveHost(ctx context.Context, c client.Reader, endpoint telemetryv1alpha1.ValueType) (string, error) {
+	value := endpoint.Value
+
+	if endpoint.ValueFrom != nil && endpoint.ValueFrom.IsSecretKeyRef() {
+		secretValue, err := secretref.GetValue(ctx, c, *endpoint.ValueFrom.SecretKeyRef)
+		if err != nil {
+			return "", err
+		}
+
+		value = string(secretValue)
+	}
+
+	return hostOf(strings.TrimSpace(value)), nil
+}
+
+func hostOf(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return ""
+		}
+
+		return u.Hostname()
+	}
+
+	if host, _, err := net.SplitHostPort(endpoint); err == nil {
+		return host
+	}
+
+	host, _, _ := strings.Cut(endpoint, "/")
+
+	return host
+}
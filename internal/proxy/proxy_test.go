@@ -0,0 +1,234 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestGetSettings(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, operatorv1alpha1.AddToScheme(scheme))
+
+	t.Run("no telemetry", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		settings, err := GetSettings(context.Background(), fakeClient)
+		require.NoError(t, err)
+		require.Nil(t, settings)
+	})
+
+	t.Run("telemetry without proxy", func(t *testing.T) {
+		telemetry := &operatorv1alpha1.Telemetry{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"}}
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(telemetry).Build()
+
+		settings, err := GetSettings(context.Background(), fakeClient)
+		require.NoError(t, err)
+		require.Nil(t, settings)
+	})
+
+	t.Run("telemetry with proxy", func(t *testing.T) {
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				Proxy: &operatorv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(telemetry).Build()
+
+		settings, err := GetSettings(context.Background(), fakeClient)
+		require.NoError(t, err)
+		require.Equal(t, "http://proxy.example.com:3128", settings.HTTPSProxy)
+	})
+}
+
+func TestGetEnvVars(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, operatorv1alpha1.AddToScheme(scheme))
+
+	outputs := []Output{
+		{PipelineName: "proxied", Endpoint: telemetryv1alpha1.ValueType{Value: "https://proxied.example.com:4317"}},
+		{PipelineName: "other-proxy", Endpoint: telemetryv1alpha1.ValueType{Value: "https://other.example.com:4317"}, Proxy: &telemetryv1alpha1.OutputProxy{URL: "http://other-proxy.example.com:3128"}},
+		{PipelineName: "bypassed", Endpoint: telemetryv1alpha1.ValueType{Value: "https://direct.example.com:4317"}, Proxy: &telemetryv1alpha1.OutputProxy{Disabled: true}},
+		{PipelineName: "missing-secret", Endpoint: telemetryv1alpha1.ValueType{
+			ValueFrom: &telemetryv1alpha1.ValueFromSource{
+				SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{Name: "missing", Namespace: "default", Key: "endpoint"},
+			},
+		}, Proxy: &telemetryv1alpha1.OutputProxy{Disabled: true}},
+	}
+
+	t.Run("no proxy", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		require.Nil(t, GetEnvVars(context.Background(), fakeClient, outputs, MakeEnvVars))
+	})
+
+	t.Run("proxy with bypassed outputs", func(t *testing.T) {
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				Proxy: &operatorv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(telemetry).Build()
+
+		require.Equal(t, []corev1.EnvVar{
+			{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "localhost,127.0.0.1,.svc,.cluster.local,$(KUBERNETES_SERVICE_HOST),direct.example.com"},
+		}, GetEnvVars(context.Background(), fakeClient, outputs, MakeEnvVars))
+
+		require.Equal(t, []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "localhost,127.0.0.1,.svc,.cluster.local,$(KUBERNETES_SERVICE_HOST),direct.example.com"},
+		}, GetEnvVars(context.Background(), fakeClient, outputs, MakeFluentBitEnvVars))
+	})
+}
+
+func TestMakeEnvVars(t *testing.T) {
+	t.Run("no proxy", func(t *testing.T) {
+		require.Nil(t, MakeEnvVars(nil))
+		require.Nil(t, MakeEnvVars(&operatorv1alpha1.ProxySpec{NoProxy: []string{".example.com"}}))
+	})
+
+	t.Run("proxy with bypass hosts", func(t *testing.T) {
+		envVars := MakeEnvVars(&operatorv1alpha1.ProxySpec{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://secure-proxy.example.com:3128",
+			NoProxy:    []string{".internal.example.com", "10.0.0.0/8"},
+		}, "backend.example.com", "", "backend.example.com")
+
+		require.Equal(t, []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "HTTPS_PROXY", Value: "http://secure-proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "localhost,127.0.0.1,.svc,.cluster.local,$(KUBERNETES_SERVICE_HOST),.internal.example.com,10.0.0.0/8,backend.example.com"},
+		}, envVars)
+	})
+}
+
+func TestMakeFluentBitEnvVars(t *testing.T) {
+	t.Run("no proxy", func(t *testing.T) {
+		require.Nil(t, MakeFluentBitEnvVars(nil))
+	})
+
+	t.Run("https proxy only", func(t *testing.T) {
+		envVars := MakeFluentBitEnvVars(&operatorv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"}, "logs.example.com")
+
+		require.Equal(t, []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "localhost,127.0.0.1,.svc,.cluster.local,$(KUBERNETES_SERVICE_HOST),logs.example.com"},
+		}, envVars)
+	})
+
+	t.Run("http proxy takes precedence", func(t *testing.T) {
+		envVars := MakeFluentBitEnvVars(&operatorv1alpha1.ProxySpec{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://secure-proxy.example.com:3128",
+		})
+
+		require.Equal(t, "http://proxy.example.com:3128", envVars[0].Value)
+	})
+}
+
+func TestURLFor(t *testing.T) {
+	settings := &operatorv1alpha1.ProxySpec{
+		HTTPProxy:  "http://proxy.example.com:3128",
+		HTTPSProxy: "http://secure-proxy.example.com:3128",
+		NoProxy:    []string{".internal.example.com"},
+	}
+
+	tests := []struct {
+		name     string
+		settings *operatorv1alpha1.ProxySpec
+		scheme   string
+		host     string
+		expected string
+	}{
+		{name: "no proxy", scheme: "https", host: "backend.example.com:4317"},
+		{name: "https", settings: settings, scheme: "https", host: "backend.example.com:4317", expected: "http://secure-proxy.example.com:3128"},
+		{name: "http", settings: settings, scheme: "http", host: "backend.example.com:80", expected: "http://proxy.example.com:3128"},
+		{name: "excluded host", settings: settings, scheme: "https", host: "backend.internal.example.com:4317"},
+		{name: "cluster-internal host", settings: settings, scheme: "https", host: "backend.default.svc:4317"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxyURL, err := URLFor(tt.settings, tt.scheme, tt.host)
+			require.NoError(t, err)
+
+			if tt.expected == "" {
+				require.Nil(t, proxyURL)
+				return
+			}
+
+			require.Equal(t, tt.expected, proxyURL.String())
+		})
+	}
+}
+
+func TestFluentBitURLFor(t *testing.T) {
+	proxyURL, err := FluentBitURLFor(&operatorv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"}, "logs.example.com:443")
+	require.NoError(t, err)
+	require.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+
+	proxyURL, err = FluentBitURLFor(nil, "logs.example.com:443")
+	require.NoError(t, err)
+	require.Nil(t, proxyURL)
+}
+
+func TestResolveHost(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+		Data:       map[string][]byte{"endpoint": []byte("https://secret-backend.example.com:4318/otlp")},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	tests := []struct {
+		name     string
+		endpoint telemetryv1alpha1.ValueType
+		expected string
+	}{
+		{
+			name:     "url",
+			endpoint: telemetryv1alpha1.ValueType{Value: "https://backend.example.com:4317"},
+			expected: "backend.example.com",
+		},
+		{
+			name:     "host and port",
+			endpoint: telemetryv1alpha1.ValueType{Value: "backend.example.com:4317"},
+			expected: "backend.example.com",
+		},
+		{
+			name:     "host only",
+			endpoint: telemetryv1alpha1.ValueType{Value: "backend.example.com"},
+			expected: "backend.example.com",
+		},
+		{
+			name: "from secret",
+			endpoint: telemetryv1alpha1.ValueType{
+				ValueFrom: &telemetryv1alpha1.ValueFromSource{
+					SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{Name: "backend", Namespace: "default", Key: "endpoint"},
+				},
+			},
+			expected: "secret-backend.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, err := ResolveHost(context.Background(), fakeClient, tt.endpoint)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, host)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package commonstatus
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+)
+
+// warningReasons are reasons of conditions that report a problem although the condition status is True.
+var warningReasons = []string{
+	conditions.ReasonTLSCertificateAboutToExpire,
+}
+
+// RecordConditionEvents records a Kubernetes Event for every condition that changed its status or reason, for example when the flow gets degraded or recovers.
+// Changes of the message only, such as updated counts, do not lead to an Event, and repeated Events with the same reason and message are aggregated by the recorder.
+// Conditions that start healthy do not lead to an Event either.
+func RecordConditionEvents(recorder record.EventRecorder, obj runtime.Object, oldConditions, newConditions []metav1.Condition) {
+	if recorder == nil {
+		return
+	}
+
+	for _, newCondition := range newConditions {
+		oldCondition := meta.FindStatusCondition(oldConditions, newCondition.Type)
+		if oldCondition != nil && oldCondition.Status == newCondition.Status && oldCondition.Reason == newCondition.Reason {
+			continue
+		}
+
+		eventType := conditionEventType(newCondition)
+		if oldCondition == nil && eventType == corev1.EventTypeNormal {
+			continue
+		}
+
+		recorder.Eventf(obj, eventType, newCondition.Reason, "%s: %s", newCondition.Type, newCondition.Message)
+	}
+}
+
+func conditionEventType(condition metav1.Condition) string {
+	if condition.Reason == conditions.ReasonRolloutInProgress {
+		return corev1.EventTypeNormal
+	}
+
+	if condition.Status == metav1.ConditionFalse || slices.Contains(warningReasons, condition.Reason) {
+		return corev1.EventTypeWarning
+	}
+
+	return corev1.EventTypeNormal
+}
@@ -0,0 +1,112 @@
+package commonstatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+)
+
+func TestRecordConditionEvents(t *testing.T) {
+	flowHealthy := metav1.Condition{
+		Type:    conditions.TypeFlowHealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  conditions.ReasonSelfMonFlowHealthy,
+		Message: "No problems detected in the telemetry flow",
+	}
+	allDataDropped := metav1.Condition{
+		Type:    conditions.TypeFlowHealthy,
+		Status:  metav1.ConditionFalse,
+		Reason:  conditions.ReasonSelfMonAllDataDropped,
+		Message: "All data dropped",
+	}
+	certAboutToExpire := metav1.Condition{
+		Type:    conditions.TypeConfigurationGenerated,
+		Status:  metav1.ConditionTrue,
+		Reason:  conditions.ReasonTLSCertificateAboutToExpire,
+		Message: "TLS certificate is about to expire",
+	}
+	rolloutInProgress := metav1.Condition{
+		Type:    conditions.TypeGatewayHealthy,
+		Status:  metav1.ConditionFalse,
+		Reason:  conditions.ReasonRolloutInProgress,
+		Message: "Pods are being started/updated",
+	}
+
+	tests := []struct {
+		name           string
+		oldConditions  []metav1.Condition
+		newConditions  []metav1.Condition
+		expectedEvents []string
+	}{
+		{
+			name:          "initially healthy",
+			newConditions: []metav1.Condition{flowHealthy},
+		},
+		{
+			name:           "initially unhealthy",
+			newConditions:  []metav1.Condition{allDataDropped},
+			expectedEvents: []string{"Warning AllTelemetryDataDropped TelemetryFlowHealthy: All data dropped"},
+		},
+		{
+			name:           "flow degraded",
+			oldConditions:  []metav1.Condition{flowHealthy},
+			newConditions:  []metav1.Condition{allDataDropped},
+			expectedEvents: []string{"Warning AllTelemetryDataDropped TelemetryFlowHealthy: All data dropped"},
+		},
+		{
+			name:           "flow recovered",
+			oldConditions:  []metav1.Condition{allDataDropped},
+			newConditions:  []metav1.Condition{flowHealthy},
+			expectedEvents: []string{"Normal FlowHealthy TelemetryFlowHealthy: No problems detected in the telemetry flow"},
+		},
+		{
+			name:          "only message changed",
+			oldConditions: []metav1.Condition{allDataDropped},
+			newConditions: []metav1.Condition{{
+				Type:    conditions.TypeFlowHealthy,
+				Status:  metav1.ConditionFalse,
+				Reason:  conditions.ReasonSelfMonAllDataDropped,
+				Message: "All data dropped, other message",
+			}},
+		},
+		{
+			name:           "certificate about to expire",
+			newConditions:  []metav1.Condition{certAboutToExpire},
+			expectedEvents: []string{"Warning TLSCertificateAboutToExpire ConfigurationGenerated: TLS certificate is about to expire"},
+		},
+		{
+			name:           "rollout in progress",
+			oldConditions:  []metav1.Condition{{Type: conditions.TypeGatewayHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonGatewayReady}},
+			newConditions:  []metav1.Condition{rolloutInProgress},
+			expectedEvents: []string{"Normal RolloutInProgress GatewayHealthy: Pods are being started/updated"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+
+			RecordConditionEvents(recorder, &telemetryv1alpha1.MetricPipeline{}, tc.oldConditions, tc.newConditions)
+
+			close(recorder.Events)
+
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+
+			require.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func TestRecordConditionEventsWithoutRecorder(t *testing.T) {
+	require.NotPanics(t, func() {
+		RecordConditionEvents(nil, &telemetryv1alpha1.MetricPipeline{}, nil, []metav1.Condition{{Type: conditions.TypeFlowHealthy, Status: metav1.ConditionFalse}})
+	})
+}
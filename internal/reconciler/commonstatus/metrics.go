@@ -0,0 +1,98 @@
+package commonstatus
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricPrefix = "telemetry_"
+
+const (
+	labelKind = "kind"
+	labelName = "name"
+)
+
+var (
+	pipelineCondition = promauto.With(metrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: metricPrefix + "pipeline_condition",
+			Help: "The current conditions of the pipelines. The value is always 1, the state is encoded in the status and reason labels.",
+		},
+		[]string{labelKind, labelName, "type", "status", "reason"},
+	)
+
+	pipelineInfo = promauto.With(metrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: metricPrefix + "pipeline_info",
+			Help: "Information about the pipelines. The value is always 1.",
+		},
+		[]string{labelKind, labelName, "output_type", "unsupported_mode"},
+	)
+
+	gatewayReplicas = promauto.With(metrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: metricPrefix + "gateway_replicas",
+			Help: "The number of replicas that the Telemetry Manager configured for a gateway.",
+		},
+		[]string{labelName},
+	)
+
+	tlsCertExpiry = promauto.With(metrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: metricPrefix + "tls_cert_expiry_timestamp_seconds",
+			Help: "The earliest expiry of the TLS certificates configured for the output of a pipeline, as Unix timestamp in seconds.",
+		},
+		[]string{labelKind, labelName},
+	)
+)
+
+// PipelineMetrics describes the state of a pipeline that is exposed as metrics of the Telemetry Manager.
+type PipelineMetrics struct {
+	Kind            string
+	Name            string
+	Conditions      []metav1.Condition
+	OutputType      string
+	UnsupportedMode bool
+	// TLSCertExpiry is the earliest expiry of the TLS certificates of the output. The zero time means that no certificate is configured.
+	TLSCertExpiry time.Time
+}
+
+// RecordPipelineMetrics replaces the metrics of a pipeline with its current state.
+// It is called after each status update, so that the metrics match the status of the pipeline.
+func RecordPipelineMetrics(m PipelineMetrics) {
+	DeletePipelineMetrics(m.Kind, m.Name)
+
+	for _, condition := range m.Conditions {
+		pipelineCondition.WithLabelValues(m.Kind, m.Name, condition.Type, string(condition.Status), condition.Reason).Set(1)
+	}
+
+	pipelineInfo.WithLabelValues(m.Kind, m.Name, m.OutputType, strconv.FormatBool(m.UnsupportedMode)).Set(1)
+
+	if !m.TLSCertExpiry.IsZero() {
+		tlsCertExpiry.WithLabelValues(m.Kind, m.Name).Set(float64(m.TLSCertExpiry.Unix()))
+	}
+}
+
+// DeletePipelineMetrics removes all metrics of a pipeline, for example after the pipeline was deleted.
+func DeletePipelineMetrics(kind, name string) {
+	labels := prometheus.Labels{labelKind: kind, labelName: name}
+
+	pipelineCondition.DeletePartialMatch(labels)
+	pipelineInfo.DeletePartialMatch(labels)
+	tlsCertExpiry.DeletePartialMatch(labels)
+}
+
+// RecordGatewayReplicas records the number of replicas that are configured for a gateway.
+func RecordGatewayReplicas(name string, replicas int32) {
+	gatewayReplicas.WithLabelValues(name).Set(float64(replicas))
+}
+
+// DeleteGatewayReplicas removes the replica metric of a gateway after the gateway was deleted.
+func DeleteGatewayReplicas(name string) {
+	gatewayReplicas.DeleteLabelValues(name)
+}
@@ -0,0 +1,67 @@
+package commonstatus
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+)
+
+func TestRecordPipelineMetrics(t *testing.T) {
+	expiry := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	RecordPipelineMetrics(PipelineMetrics{
+		Kind: "MetricPipeline",
+		Name: "test",
+		Conditions: []metav1.Condition{
+			{Type: conditions.TypeFlowHealthy, Status: metav1.ConditionFalse, Reason: conditions.ReasonSelfMonAllDataDropped},
+		},
+		OutputType:    "otlp",
+		TLSCertExpiry: expiry,
+	})
+
+	// A reason change must replace the previous series instead of adding a new one
+	RecordPipelineMetrics(PipelineMetrics{
+		Kind: "MetricPipeline",
+		Name: "test",
+		Conditions: []metav1.Condition{
+			{Type: conditions.TypeFlowHealthy, Status: metav1.ConditionTrue, Reason: conditions.ReasonSelfMonFlowHealthy},
+		},
+		OutputType:    "otlp",
+		TLSCertExpiry: expiry,
+	})
+
+	expected := `
+# HELP telemetry_pipeline_condition The current conditions of the pipelines. The value is always 1, the state is encoded in the status and reason labels.
+# TYPE telemetry_pipeline_condition gauge
+telemetry_pipeline_condition{kind="MetricPipeline",name="test",reason="FlowHealthy",status="True",type="TelemetryFlowHealthy"} 1
+# HELP telemetry_pipeline_info Information about the pipelines. The value is always 1.
+# TYPE telemetry_pipeline_info gauge
+telemetry_pipeline_info{kind="MetricPipeline",name="test",output_type="otlp",unsupported_mode="false"} 1
+# HELP telemetry_tls_cert_expiry_timestamp_seconds The earliest expiry of the TLS certificates configured for the output of a pipeline, as Unix timestamp in seconds.
+# TYPE telemetry_tls_cert_expiry_timestamp_seconds gauge
+telemetry_tls_cert_expiry_timestamp_seconds{kind="MetricPipeline",name="test"} 1.893456e+09
+`
+	require.NoError(t, testutil.CollectAndCompare(pipelineCondition, strings.NewReader(expected), "telemetry_pipeline_condition"))
+	require.NoError(t, testutil.CollectAndCompare(pipelineInfo, strings.NewReader(expected), "telemetry_pipeline_info"))
+	require.NoError(t, testutil.CollectAndCompare(tlsCertExpiry, strings.NewReader(expected), "telemetry_tls_cert_expiry_timestamp_seconds"))
+
+	DeletePipelineMetrics("MetricPipeline", "test")
+
+	require.Zero(t, testutil.CollectAndCount(pipelineCondition))
+	require.Zero(t, testutil.CollectAndCount(pipelineInfo))
+	require.Zero(t, testutil.CollectAndCount(tlsCertExpiry))
+}
+
+func TestRecordGatewayReplicas(t *testing.T) {
+	RecordGatewayReplicas("telemetry-trace-gateway", 3)
+	require.InDelta(t, 3, testutil.ToFloat64(gatewayReplicas.WithLabelValues("telemetry-trace-gateway")), 0)
+
+	DeleteGatewayReplicas("telemetry-trace-gateway")
+	require.Zero(t, testutil.CollectAndCount(gatewayReplicas))
+}
@@ -0,0 +1,64 @@
+package commonstatus
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+// StatisticsUpdateInterval is the minimum time between two updates of the statistics of a pipeline.
+// Every status update triggers another reconciliation, so statistics that change on every reconciliation would never settle.
+const StatisticsUpdateInterval = time.Minute
+
+type StatisticsProber interface {
+	ProbeStatistics(ctx context.Context, pipelineName string) (prober.StatisticsProbeResult, error)
+}
+
+// GetStatistics returns the statistics of the given pipeline. Recent statistics are returned unchanged, and so are the current statistics if probing fails.
+func GetStatistics(ctx context.Context, statisticsProber StatisticsProber, pipelineName string, current *telemetryv1alpha1.PipelineStatistics, now time.Time) *telemetryv1alpha1.PipelineStatistics {
+	if current != nil && now.Sub(current.UpdateTime.Time) < StatisticsUpdateInterval {
+		return current
+	}
+
+	result, err := statisticsProber.ProbeStatistics(ctx, pipelineName)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to probe statistics")
+		return current
+	}
+
+	statistics := &telemetryv1alpha1.PipelineStatistics{
+		SentPerSecond:    formatRate(result.SentPerSecond),
+		DroppedPerSecond: formatRate(result.DroppedPerSecond),
+		RefusedPerSecond: formatRate(result.RefusedPerSecond),
+		UpdateTime:       metav1.NewTime(now),
+	}
+
+	if result.Queued != nil {
+		queued := int64(math.Round(*result.Queued))
+		statistics.Queued = &queued
+	}
+
+	if result.SentPerSecond != nil && *result.SentPerSecond > 0 {
+		statistics.LastExportTime = &statistics.UpdateTime
+	} else if current != nil {
+		statistics.LastExportTime = current.LastExportTime
+	}
+
+	return statistics
+}
+
+// formatRate formats a rate with at most two decimal places, so that small fluctuations do not show up in the status.
+func formatRate(rate *float64) string {
+	if rate == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(math.Round(*rate*100)/100, 'f', -1, 64) //nolint:mnd // two decimal places
+}
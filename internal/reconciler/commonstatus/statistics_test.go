@@ -0,0 +1,103 @@
+package commonstatus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+type statisticsProberStub struct {
+	result prober.StatisticsProbeResult
+	err    error
+}
+
+func (s statisticsProberStub) ProbeStatistics(context.Context, string) (prober.StatisticsProbeResult, error) {
+	return s.result, s.err
+}
+
+func TestGetStatistics(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastExport := metav1.NewTime(now.Add(-time.Hour))
+
+	tests := []struct {
+		name     string
+		prober   statisticsProberStub
+		current  *telemetryv1alpha1.PipelineStatistics
+		expected *telemetryv1alpha1.PipelineStatistics
+	}{
+		{
+			name: "first update",
+			prober: statisticsProberStub{result: prober.StatisticsProbeResult{
+				SentPerSecond:    ptr.To(12.3456),
+				DroppedPerSecond: ptr.To(0.0),
+				RefusedPerSecond: ptr.To(0.5),
+				Queued:           ptr.To(3.0),
+			}},
+			expected: &telemetryv1alpha1.PipelineStatistics{
+				SentPerSecond:    "12.35",
+				DroppedPerSecond: "0",
+				RefusedPerSecond: "0.5",
+				Queued:           ptr.To[int64](3),
+				LastExportTime:   ptr.To(metav1.NewTime(now)),
+				UpdateTime:       metav1.NewTime(now),
+			},
+		},
+		{
+			name: "nothing sent keeps the last export time",
+			prober: statisticsProberStub{result: prober.StatisticsProbeResult{
+				SentPerSecond:    ptr.To(0.0),
+				DroppedPerSecond: ptr.To(4.0),
+			}},
+			current: &telemetryv1alpha1.PipelineStatistics{
+				SentPerSecond:  "1",
+				LastExportTime: &lastExport,
+				UpdateTime:     metav1.NewTime(now.Add(-StatisticsUpdateInterval)),
+			},
+			expected: &telemetryv1alpha1.PipelineStatistics{
+				SentPerSecond:    "0",
+				DroppedPerSecond: "4",
+				LastExportTime:   &lastExport,
+				UpdateTime:       metav1.NewTime(now),
+			},
+		},
+		{
+			name:   "recent statistics are not updated",
+			prober: statisticsProberStub{result: prober.StatisticsProbeResult{SentPerSecond: ptr.To(5.0)}},
+			current: &telemetryv1alpha1.PipelineStatistics{
+				SentPerSecond: "1",
+				UpdateTime:    metav1.NewTime(now.Add(-time.Second)),
+			},
+			expected: &telemetryv1alpha1.PipelineStatistics{
+				SentPerSecond: "1",
+				UpdateTime:    metav1.NewTime(now.Add(-time.Second)),
+			},
+		},
+		{
+			name:   "probing fails",
+			prober: statisticsProberStub{err: errors.New("prometheus unavailable")},
+			current: &telemetryv1alpha1.PipelineStatistics{
+				SentPerSecond: "1",
+				UpdateTime:    metav1.NewTime(now.Add(-time.Hour)),
+			},
+			expected: &telemetryv1alpha1.PipelineStatistics{
+				SentPerSecond: "1",
+				UpdateTime:    metav1.NewTime(now.Add(-time.Hour)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statistics := GetStatistics(context.Background(), tt.prober, "cls", tt.current, now)
+			require.Equal(t, tt.expected, statistics)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package stubs
+
+import (
+	"context"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+type OAuth2TokenProber struct {
+	err error
+}
+
+func NewOAuth2TokenProber(err error) *OAuth2TokenProber {
+	return &OAuth2TokenProber{
+		err: err,
+	}
+}
+
+func (p *OAuth2TokenProber) Probe(ctx context.Context, pipelineName string, output *telemetryv1alpha1.OtlpOutput) error {
+	return p.err
+}
@@ -0,0 +1,21 @@
+package stubs
+
+import (
+	"context"
+
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+)
+
+type OutputReachabilityProber struct {
+	err error
+}
+
+func NewOutputReachabilityProber(err error) *OutputReachabilityProber {
+	return &OutputReachabilityProber{
+		err: err,
+	}
+}
+
+func (p *OutputReachabilityProber) Probe(ctx context.Context, pipelineName string, target *prober.OutputTarget) error {
+	return p.err
+}
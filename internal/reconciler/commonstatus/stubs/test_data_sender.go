@@ -0,0 +1,19 @@
+package stubs
+
+import (
+	"context"
+)
+
+type TestDataSender struct {
+	err error
+}
+
+func NewTestDataSender(err error) *TestDataSender {
+	return &TestDataSender{
+		err: err,
+	}
+}
+
+func (s *TestDataSender) Send(ctx context.Context, pipelineName, request string) error {
+	return s.err
+}
@@ -0,0 +1,84 @@
+package commonstatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
+)
+
+const troubleshootingMessagePrefix = " See troubleshooting"
+
+// GetSelfMonitorThresholds returns the self-monitor thresholds that are configured in the Telemetry resource, or the defaults if none is found.
+func GetSelfMonitorThresholds(ctx context.Context, c client.Reader) config.Thresholds {
+	var telemetries operatorv1alpha1.TelemetryList
+	if err := c.List(ctx, &telemetries); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to list telemetry, using default self-monitor thresholds", "error", err)
+		return config.DefaultThresholds()
+	}
+
+	for i := range telemetries.Items {
+		if spec := telemetries.Items[i].Spec.SelfMonitor; spec != nil {
+			return config.ThresholdsFor(spec)
+		}
+	}
+
+	return config.DefaultThresholds()
+}
+
+// FlowHealthMessage extends the message of a flow health condition with the self-monitor thresholds that differ from the defaults,
+// so that users can tell why an alert fired or why it did not fire yet.
+func FlowHealthMessage(message, reason string, thresholds config.Thresholds, signalType string) string {
+	var details []string
+
+	if reason == conditions.ReasonSelfMonBufferFillingUp && signalType != SignalTypeLogs && thresholds.IsQueueAlmostFullCustomized() {
+		details = append(details, fmt.Sprintf("The buffer is considered nearing capacity when it is more than %d%% full.", thresholds.QueueAlmostFullPercentage))
+	}
+
+	if reason == conditions.ReasonSelfMonSomeDataDropped && thresholds.DroppedDataTolerancePercentage > 0 {
+		details = append(details, fmt.Sprintf("Up to %d%% of dropped data is tolerated.", thresholds.DroppedDataTolerancePercentage))
+	}
+
+	if isFlowProblemReason(reason) && thresholds.AlertFor > 0 {
+		details = append(details, fmt.Sprintf("Problems are reported when they persist for %s.", thresholds.AlertFor))
+	}
+
+	if len(details) == 0 {
+		return message
+	}
+
+	detail := strings.Join(details, " ")
+
+	if before, after, found := strings.Cut(message, troubleshootingMessagePrefix); found {
+		return before + " " + detail + troubleshootingMessagePrefix + after
+	}
+
+	if !strings.HasSuffix(message, ".") {
+		message += "."
+	}
+
+	return message + " " + detail
+}
+
+func isFlowProblemReason(reason string) bool {
+	switch reason {
+	case conditions.ReasonSelfMonAgentExportFailing,
+		conditions.ReasonSelfMonAgentThrottling,
+		conditions.ReasonSelfMonAllDataDropped,
+		conditions.ReasonSelfMonSomeDataDropped,
+		conditions.ReasonSelfMonBufferFillingUp,
+		conditions.ReasonSelfMonGatewayThrottling,
+		conditions.ReasonSelfMonNoLogsDelivered,
+		conditions.ReasonSelfMonOAuth2TokenFailed,
+		conditions.ReasonSelfMonScrapeTargetsDown:
+		return true
+	}
+
+	return false
+}
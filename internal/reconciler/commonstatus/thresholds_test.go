@@ -0,0 +1,115 @@
+package commonstatus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
+)
+
+func TestGetSelfMonitorThresholds(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, operatorv1alpha1.AddToScheme(scheme))
+
+	t.Run("no telemetry", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+		require.Equal(t, config.DefaultThresholds(), GetSelfMonitorThresholds(context.Background(), fakeClient))
+	})
+
+	t.Run("configured thresholds", func(t *testing.T) {
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				SelfMonitor: &operatorv1alpha1.SelfMonitorSpec{
+					QueueAlmostFullPercentage: ptr.To[int32](90),
+				},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(telemetry).Build()
+
+		thresholds := GetSelfMonitorThresholds(context.Background(), fakeClient)
+		require.EqualValues(t, 90, thresholds.QueueAlmostFullPercentage)
+	})
+}
+
+func TestFlowHealthMessage(t *testing.T) {
+	thresholds := config.Thresholds{
+		QueueAlmostFullPercentage:      90,
+		DroppedDataTolerancePercentage: 5,
+		AlertFor:                       5 * time.Minute,
+	}
+
+	tests := []struct {
+		name       string
+		reason     string
+		thresholds config.Thresholds
+		signalType string
+		expected   string
+	}{
+		{
+			name:       "default thresholds",
+			reason:     conditions.ReasonSelfMonBufferFillingUp,
+			thresholds: config.DefaultThresholds(),
+			signalType: SignalTypeTraces,
+			expected:   conditions.MessageForTracePipeline(conditions.ReasonSelfMonBufferFillingUp),
+		},
+		{
+			name:       "buffer filling up",
+			reason:     conditions.ReasonSelfMonBufferFillingUp,
+			thresholds: thresholds,
+			signalType: SignalTypeTraces,
+			expected: "Buffer nearing capacity. Incoming span rate exceeds export rate. " +
+				"The buffer is considered nearing capacity when it is more than 90% full. Problems are reported when they persist for 5m0s. " +
+				"See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=gateway-buffer-filling-up",
+		},
+		{
+			name:       "some data dropped",
+			reason:     conditions.ReasonSelfMonSomeDataDropped,
+			thresholds: config.Thresholds{QueueAlmostFullPercentage: 80, DroppedDataTolerancePercentage: 5},
+			signalType: SignalTypeLogs,
+			expected: "Backend is reachable, but rejecting logs. Some logs are dropped. Up to 5% of dropped data is tolerated. " +
+				"See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/02-logs?id=not-all-logs-arrive-at-the-backend",
+		},
+		{
+			name:       "message without troubleshooting link",
+			reason:     conditions.ReasonSelfMonAgentThrottling,
+			thresholds: thresholds,
+			signalType: SignalTypeLogs,
+			expected: "Incoming log rate of some Namespaces or containers exceeds the configured throttle limit. Logs above the limit are dropped. " +
+				"Problems are reported when they persist for 5m0s.",
+		},
+		{
+			name:       "healthy flow",
+			reason:     conditions.ReasonSelfMonFlowHealthy,
+			thresholds: thresholds,
+			signalType: SignalTypeMetrics,
+			expected:   conditions.MessageForMetricPipeline(conditions.ReasonSelfMonFlowHealthy),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var message string
+
+			switch tt.signalType {
+			case SignalTypeTraces:
+				message = conditions.MessageForTracePipeline(tt.reason)
+			case SignalTypeMetrics:
+				message = conditions.MessageForMetricPipeline(tt.reason)
+			case SignalTypeLogs:
+				message = conditions.MessageForLogPipeline(tt.reason)
+			}
+
+			require.Equal(t, tt.expected, FlowHealthMessage(message, tt.reason, tt.thresholds, tt.signalType))
+		})
+	}
+}
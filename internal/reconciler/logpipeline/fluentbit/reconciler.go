@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -22,13 +23,20 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/fluentbit/ports"
 	"github.com/kyma-project/telemetry-manager/internal/k8sutils"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/proxy"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline"
+	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 	"github.com/kyma-project/telemetry-manager/internal/resources/fluentbit"
+	selfmonitorprober "github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 )
 
+const pipelineKind = "LogPipeline"
+
 type Config struct {
 	DaemonSet             types.NamespacedName
 	SectionsConfigMap     types.NamespacedName
@@ -41,6 +49,8 @@ type Config struct {
 	Overrides             overrides.Config
 	DaemonSetConfig       fluentbit.DaemonSetConfig
 	RestConfig            rest.Config
+	// EventRecorder records Events on condition transitions of the pipelines. No Events are recorded if it is nil.
+	EventRecorder record.EventRecorder
 }
 
 var _ logpipeline.LogPipelineReconciler = &Reconciler{}
@@ -52,26 +62,32 @@ type Reconciler struct {
 	syncer syncer
 
 	// Dependencies
-	agentProber        commonstatus.DaemonSetProber
-	flowHealthProber   logpipeline.FlowHealthProber
-	istioStatusChecker logpipeline.IstioStatusChecker
-	pipelineValidator  *Validator
-	errToMsgConverter  commonstatus.ErrorToMessageConverter
+	agentProber              commonstatus.DaemonSetProber
+	flowHealthProber         logpipeline.FlowHealthProber
+	istioStatusChecker       logpipeline.IstioStatusChecker
+	outputReachabilityProber logpipeline.OutputReachabilityProber
+	pipelineLock             logpipeline.PipelineLock
+	pipelineValidator        *Validator
+	errToMsgConverter        commonstatus.ErrorToMessageConverter
+	testDataSender           logpipeline.TestDataSender
 }
 
 func (r *Reconciler) SupportedOutput() logpipeline.OutputType {
 	return logpipeline.FluentBit
 }
 
-func New(client client.Client, config Config, prober commonstatus.DaemonSetProber, healthProber logpipeline.FlowHealthProber, checker logpipeline.IstioStatusChecker, validator *Validator, converter commonstatus.ErrorToMessageConverter) *Reconciler {
+func New(client client.Client, config Config, prober commonstatus.DaemonSetProber, healthProber logpipeline.FlowHealthProber, checker logpipeline.IstioStatusChecker, pipelineLock logpipeline.PipelineLock, validator *Validator, converter commonstatus.ErrorToMessageConverter) *Reconciler {
 	return &Reconciler{
-		Client:             client,
-		config:             config,
-		agentProber:        prober,
-		flowHealthProber:   healthProber,
-		istioStatusChecker: checker,
-		pipelineValidator:  validator,
-		errToMsgConverter:  converter,
+		Client:                   client,
+		config:                   config,
+		agentProber:              prober,
+		flowHealthProber:         healthProber,
+		istioStatusChecker:       checker,
+		outputReachabilityProber: selfmonitorprober.NewOutputReachabilityProber(),
+		pipelineLock:             pipelineLock,
+		pipelineValidator:        validator,
+		errToMsgConverter:        converter,
+		testDataSender:           synthetic.NewLogSender(types.NamespacedName{Name: config.DaemonSet.Name + "-test-data", Namespace: config.DaemonSet.Namespace}),
 		syncer: syncer{
 			Client: client,
 			config: config,
@@ -96,6 +112,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, pipeline *telemetryv1alpha1.
 }
 
 func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) error {
+	// A pipeline that exceeds the maximum number of pipelines is reconciled nevertheless, so that its section is removed from the Fluent Bit configuration
+	if pipeline.DeletionTimestamp.IsZero() {
+		if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil && !errors.Is(err, resourcelock.ErrMaxPipelinesExceeded) {
+			return err
+		}
+	}
+
 	allPipelines, err := logpipeline.GetPipelinesForType(ctx, r.Client, r.SupportedOutput())
 	if err != nil {
 		return err
@@ -106,7 +129,10 @@ func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha
 		return err
 	}
 
-	reconcilablePipelines, err := r.getReconcilablePipelines(ctx, allPipelines)
+	resolveCtx, resolveSpan := selftracing.Start(ctx, selftracing.SpanResolveSecrets)
+	reconcilablePipelines, err := r.getReconcilablePipelines(resolveCtx, allPipelines)
+	selftracing.End(resolveSpan, err)
+
 	if err != nil {
 		return fmt.Errorf("failed to fetch reconcilable log pipelines: %w", err)
 	}
@@ -114,16 +140,28 @@ func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha
 	if len(reconcilablePipelines) == 0 {
 		logf.FromContext(ctx).V(1).Info("cleaning up log pipeline resources: all log pipelines are non-reconcilable")
 
-		if err = r.deleteFluentBitResources(ctx); err != nil {
+		deleteCtx, deleteSpan := selftracing.Start(ctx, selftracing.SpanDeleteResources)
+		err = r.deleteFluentBitResources(deleteCtx)
+		selftracing.End(deleteSpan, err)
+
+		if err != nil {
 			return fmt.Errorf("failed to delete log pipeline resources: %w", err)
 		}
 	}
 
-	if err = r.syncer.syncFluentBitConfig(ctx, pipeline, reconcilablePipelines); err != nil {
+	buildCtx, buildSpan := selftracing.Start(ctx, selftracing.SpanBuildConfig)
+	err = r.syncer.syncFluentBitConfig(buildCtx, pipeline, reconcilablePipelines)
+	selftracing.End(buildSpan, err)
+
+	if err != nil {
 		return err
 	}
 
-	if err = r.createOrUpdateFluentBitResources(ctx, pipeline, reconcilablePipelines); err != nil {
+	applyCtx, applySpan := selftracing.Start(ctx, selftracing.SpanApplyResources)
+	err = r.createOrUpdateFluentBitResources(applyCtx, pipeline, reconcilablePipelines)
+	selftracing.End(applySpan, err)
+
+	if err != nil {
 		return err
 	}
 
@@ -166,6 +204,11 @@ func (r *Reconciler) createOrUpdateFluentBitResources(ctx context.Context, pipel
 		return fmt.Errorf("failed to reconcile fluent bit metrics service: %w", err)
 	}
 
+	testDataService := fluentbit.MakeTestDataService(r.config.DaemonSet)
+	if err := k8sutils.CreateOrUpdateService(ctx, ownerRefSetter, testDataService); err != nil {
+		return fmt.Errorf("failed to reconcile fluent bit test data service: %w", err)
+	}
+
 	cm := fluentbit.MakeConfigMap(r.config.DaemonSet)
 	if err := k8sutils.CreateOrUpdateConfigMap(ctx, ownerRefSetter, cm); err != nil {
 		return fmt.Errorf("failed to reconcile fluent bit configmap: %w", err)
@@ -188,7 +231,7 @@ func (r *Reconciler) createOrUpdateFluentBitResources(ctx context.Context, pipel
 		return fmt.Errorf("failed to calculate config checksum: %w", err)
 	}
 
-	daemonSet := fluentbit.MakeDaemonSet(r.config.DaemonSet, checksum, r.config.DaemonSetConfig)
+	daemonSet := fluentbit.MakeDaemonSet(r.config.DaemonSet, checksum, r.config.DaemonSetConfig, proxy.GetEnvVars(ctx, r.Client, proxyOutputs(pipelines), proxy.MakeFluentBitEnvVars))
 	if err := k8sutils.CreateOrUpdateDaemonSet(ctx, ownerRefSetter, daemonSet); err != nil {
 		return fmt.Errorf("failed to reconcile fluent bit daemonset: %w", err)
 	}
@@ -199,6 +242,7 @@ func (r *Reconciler) createOrUpdateFluentBitResources(ctx context.Context, pipel
 	}
 
 	networkPolicy := commonresources.MakeNetworkPolicy(r.config.DaemonSet, allowedPorts, fluentbit.Labels())
+	networkPolicy.Spec.Ingress = append(networkPolicy.Spec.Ingress, fluentbit.MakeTestDataIngressRule())
 	if err := k8sutils.CreateOrUpdateNetworkPolicy(ctx, ownerRefSetter, networkPolicy); err != nil {
 		return fmt.Errorf("failed to create fluent bit network policy: %w", err)
 	}
@@ -242,6 +286,11 @@ func (r *Reconciler) deleteFluentBitResources(ctx context.Context) error {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete metric service: %w", err))
 	}
 
+	testDataService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-test-data", name.Name), Namespace: name.Namespace}}
+	if err := k8sutils.DeleteObject(ctx, r.Client, &testDataService); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete test data service: %w", err))
+	}
+
 	cm := corev1.ConfigMap{ObjectMeta: objectMeta}
 	if err := k8sutils.DeleteObject(ctx, r.Client, &cm); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete configmap: %w", err))
@@ -331,6 +380,19 @@ func (r *Reconciler) calculateChecksum(ctx context.Context) (string, error) {
 	return configchecksum.Calculate([]corev1.ConfigMap{baseCm, parsersCm, luaCm, sectionsCm, filesCm}, []corev1.Secret{envSecret, tlsSecret}), nil
 }
 
+// proxyOutputs returns the HTTP outputs of the pipelines, which are the only Fluent Bit outputs that support a proxy.
+func proxyOutputs(pipelines []telemetryv1alpha1.LogPipeline) []proxy.Output {
+	var outputs []proxy.Output
+
+	for i := range pipelines {
+		if output := pipelines[i].Spec.Output.HTTP; output != nil {
+			outputs = append(outputs, proxy.Output{PipelineName: pipelines[i].Name, Endpoint: output.Host, Proxy: output.Proxy})
+		}
+	}
+
+	return outputs
+}
+
 // getReconcilablePipelines returns the list of log pipelines that are ready to be rendered into the Fluent Bit configuration.
 // A pipeline is deployable if it is not being deleted, and all secret references exist.
 func (r *Reconciler) getReconcilablePipelines(ctx context.Context, allPipelines []telemetryv1alpha1.LogPipeline) ([]telemetryv1alpha1.LogPipeline, error) {
@@ -22,6 +22,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
@@ -29,6 +30,7 @@ import (
 	commonStatusStubs "github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/mocks"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/stubs"
+	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/fluentbit"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	"github.com/kyma-project/telemetry-manager/internal/testutils"
@@ -47,6 +49,10 @@ func TestReconcile(t *testing.T) {
 
 	istioStatusCheckerStub := &stubs.IstioStatusChecker{IsActive: false}
 
+	pipelineLockStub := &mocks.PipelineLock{}
+	pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+	pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
 	testConfig := Config{
 		DaemonSet:             types.NamespacedName{Name: "test-telemetry-fluent-bit", Namespace: "default"},
 		SectionsConfigMap:     types.NamespacedName{Name: "test-telemetry-fluent-bit-sections", Namespace: "default"},
@@ -68,17 +74,19 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -99,17 +107,19 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -130,17 +140,19 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -161,18 +173,20 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(workloadstatus.ErrDaemonSetNotFound)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 		errToMsgStub.On("Convert", mock.Anything).Return("DaemonSet is not yet created")
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -203,17 +217,19 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -244,17 +260,19 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(workloadstatus.ErrDaemonSetFetching)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &conditions.ErrorToMessageConverter{}
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -278,6 +296,62 @@ func TestReconcile(t *testing.T) {
 		require.Contains(t, cm.Data[pipeline.Name+".conf"], pipeline.Name, "sections configmap must contain pipeline name")
 	})
 
+	t.Run("max pipelines exceeded", func(t *testing.T) {
+		pipeline := testutils.NewLogPipelineBuilder().
+			WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").
+			Build()
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
+
+		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
+
+		exceededPipelineLockStub := &mocks.PipelineLock{}
+		exceededPipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(resourcelock.ErrMaxPipelinesExceeded)
+		exceededPipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(resourcelock.ErrMaxPipelinesExceeded)
+
+		pipelineValidatorWithStubs := &Validator{
+			EndpointValidator:  stubs.NewEndpointValidator(nil),
+			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       exceededPipelineLockStub,
+		}
+
+		errToMsgStub := &mocks.ErrorToMessageConverter{}
+		errToMsgStub.On("Convert", mock.Anything).Return("")
+
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, exceededPipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
+
+		var pl1 telemetryv1alpha1.LogPipeline
+
+		require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &pl1))
+		err := sut.Reconcile(context.Background(), &pl1)
+		require.NoError(t, err)
+
+		var updatedPipeline telemetryv1alpha1.LogPipeline
+		_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+		requireHasStatusCondition(t, updatedPipeline,
+			conditions.TypeConfigurationGenerated,
+			metav1.ConditionFalse,
+			conditions.ReasonMaxPipelinesExceeded,
+			"Maximum pipeline count limit exceeded",
+		)
+
+		requireHasStatusCondition(t, updatedPipeline,
+			conditions.TypeFlowHealthy,
+			metav1.ConditionFalse,
+			conditions.ReasonSelfMonConfigNotGenerated,
+			"No logs delivered to backend because LogPipeline specification is not applied to the configuration of Fluent Bit agent. Check the 'ConfigurationGenerated' condition for more details",
+		)
+
+		var cm corev1.ConfigMap
+		err = fakeClient.Get(context.Background(), testConfig.SectionsConfigMap, &cm)
+		require.Error(t, err, "sections configmap should not exist")
+	})
+
 	t.Run("referenced secret missing", func(t *testing.T) {
 		pipeline := testutils.NewLogPipelineBuilder().
 			WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").
@@ -287,18 +361,20 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(fmt.Errorf("%w: Secret 'some-secret' of Namespace 'some-namespace'", secretref.ErrSecretRefNotFound)),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 		errToMsgStub.On("Convert", mock.Anything).Return("")
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -376,18 +452,20 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 		errToMsgStub.On("Convert", mock.Anything).Return("")
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -409,6 +487,13 @@ func TestReconcile(t *testing.T) {
 		err = fakeClient.Get(context.Background(), testConfig.SectionsConfigMap, &cm)
 		require.NoError(t, err, "sections configmap must exist")
 		require.Contains(t, cm.Data[pipeline.Name+".conf"], pipeline.Name, "sections configmap must contain pipeline name")
+
+		var networkPolicy networkingv1.NetworkPolicy
+		require.NoError(t, fakeClient.Get(context.Background(), testConfig.DaemonSet, &networkPolicy))
+		require.Len(t, networkPolicy.Spec.Ingress, 2)
+		require.Len(t, networkPolicy.Spec.Ingress[0].Ports, 2, "test data port must not be open to all sources")
+		require.Equal(t, int32(2022), networkPolicy.Spec.Ingress[1].Ports[0].Port.IntVal)
+		require.NotNil(t, networkPolicy.Spec.Ingress[1].From[0].PodSelector, "test data port must only be open to the manager")
 	})
 
 	t.Run("flow healthy", func(t *testing.T) {
@@ -445,6 +530,25 @@ func TestReconcile(t *testing.T) {
 				expectedReason:  conditions.ReasonSelfMonBufferFillingUp,
 				expectedMessage: "Buffer nearing capacity. Incoming log rate exceeds export rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/02-logs?id=agent-buffer-filling-up",
 			},
+			{
+				name: "throttling",
+				probe: prober.LogPipelineProbeResult{
+					Throttling: true,
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonAgentThrottling,
+				expectedMessage: "Incoming log rate of some Namespaces or containers exceeds the configured throttle limit. Logs above the limit are dropped",
+			},
+			{
+				name: "buffer filling up shadows throttling",
+				probe: prober.LogPipelineProbeResult{
+					BufferFillingUp: true,
+					Throttling:      true,
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonBufferFillingUp,
+				expectedMessage: "Buffer nearing capacity. Incoming log rate exceeds export rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/02-logs?id=agent-buffer-filling-up",
+			},
 			{
 				name: "no logs delivered",
 				probe: prober.LogPipelineProbeResult{
@@ -514,18 +618,20 @@ func TestReconcile(t *testing.T) {
 				proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, tt.probeErr)
 
 				pipelineValidatorWithStubs := &Validator{
 					EndpointValidator:  stubs.NewEndpointValidator(nil),
 					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 					SecretRefValidator: stubs.NewSecretRefValidator(nil),
+					PipelineLock:       pipelineLockStub,
 				}
 
 				errToMsgStub := &mocks.ErrorToMessageConverter{}
 				errToMsgStub.On("Convert", mock.Anything).Return("")
 
-				sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+				sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 				var pl1 telemetryv1alpha1.LogPipeline
 
@@ -551,6 +657,252 @@ func TestReconcile(t *testing.T) {
 		}
 	})
 
+	t.Run("output reachable", func(t *testing.T) {
+		probeScheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(probeScheme))
+		require.NoError(t, telemetryv1alpha1.AddToScheme(probeScheme))
+		require.NoError(t, operatorv1alpha1.AddToScheme(probeScheme))
+
+		tests := []struct {
+			name            string
+			secretRefErr    error
+			probeErr        error
+			expectedStatus  metav1.ConditionStatus
+			expectedReason  string
+			expectedMessage string
+		}{
+			{
+				name:            "endpoint reachable",
+				expectedStatus:  metav1.ConditionTrue,
+				expectedReason:  conditions.ReasonEndpointReachable,
+				expectedMessage: "Output endpoint is reachable",
+			},
+			{
+				name:            "connection refused",
+				probeErr:        fmt.Errorf("%w: dial tcp 127.0.0.1:443: connect: connection refused", prober.ErrConnectionRefused),
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonConnectionRefused,
+				expectedMessage: "Output endpoint refused the connection: dial tcp 127.0.0.1:443: connect: connection refused",
+			},
+			{
+				name:            "custom output",
+				probeErr:        fmt.Errorf("%w: custom outputs cannot be probed", prober.ErrOutputProbeNotSupported),
+				expectedStatus:  metav1.ConditionUnknown,
+				expectedReason:  conditions.ReasonOutputProbeNotSupported,
+				expectedMessage: "Output reachability cannot be probed: custom outputs cannot be probed",
+			},
+			{
+				name:            "configuration not generated",
+				secretRefErr:    fmt.Errorf("%w: Secret 'some-secret' of Namespace 'some-namespace'", secretref.ErrSecretRefNotFound),
+				expectedStatus:  metav1.ConditionUnknown,
+				expectedReason:  conditions.ReasonOutputNotProbed,
+				expectedMessage: "Output reachability is not probed because the pipeline configuration is not generated. Check the 'ConfigurationGenerated' condition for more details",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := testutils.NewLogPipelineBuilder().WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").Build()
+				fakeClient := fake.NewClientBuilder().WithScheme(probeScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				proberStub := commonStatusStubs.NewDaemonSetProber(nil)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(tt.secretRefErr),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsgStub := &mocks.ErrorToMessageConverter{}
+				errToMsgStub.On("Convert", mock.Anything).Return("")
+
+				sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
+				sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(tt.probeErr)
+
+				var pl1 telemetryv1alpha1.LogPipeline
+
+				require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &pl1))
+				err := sut.Reconcile(context.Background(), &pl1)
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.LogPipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				requireHasStatusCondition(t, updatedPipeline,
+					conditions.TypeOutputReachable,
+					tt.expectedStatus,
+					tt.expectedReason,
+					tt.expectedMessage,
+				)
+			})
+		}
+	})
+
+	t.Run("test data delivered", func(t *testing.T) {
+		t.Run("test data is sent once the test data output is rolled out", func(t *testing.T) {
+			pipeline := testutils.NewLogPipelineBuilder().
+				WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").
+				WithHTTPOutput(testutils.HTTPHost("host")).
+				WithAnnotations(map[string]string{telemetryv1alpha1.AnnotationSendTestData: "req-1"}).
+				Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+			flowHealthProberStub := &mocks.FlowHealthProber{}
+			flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+			flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
+			flowHealthProberStub.On("ProbeTestData", mock.Anything, pipeline.Name, mock.Anything).Return(prober.TestDataProbeResult{Exported: true}, nil)
+
+			pipelineValidatorWithStubs := &Validator{
+				EndpointValidator:  stubs.NewEndpointValidator(nil),
+				TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+				SecretRefValidator: stubs.NewSecretRefValidator(nil),
+				PipelineLock:       pipelineLockStub,
+			}
+
+			sut := New(fakeClient, testConfig, commonStatusStubs.NewDaemonSetProber(nil), flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, &mocks.ErrorToMessageConverter{})
+			sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(nil)
+			sut.testDataSender = commonStatusStubs.NewTestDataSender(nil)
+
+			reconcile := func() (telemetryv1alpha1.LogPipeline, string) {
+				var pl telemetryv1alpha1.LogPipeline
+
+				require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &pl))
+				require.NoError(t, sut.Reconcile(context.Background(), &pl))
+
+				var updatedPipeline telemetryv1alpha1.LogPipeline
+				require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline))
+
+				var cm corev1.ConfigMap
+				require.NoError(t, fakeClient.Get(context.Background(), testConfig.SectionsConfigMap, &cm))
+
+				return updatedPipeline, cm.Data[pipeline.Name+".conf"]
+			}
+
+			// the test data output is rendered, and the test data waits for its rollout
+			updatedPipeline, sections := reconcile()
+			require.Contains(t, sections, pipeline.Name+"_test-data")
+			require.Nil(t, updatedPipeline.Status.TestData)
+			requireHasStatusCondition(t, updatedPipeline,
+				conditions.TypeTestDataDelivered,
+				metav1.ConditionUnknown,
+				conditions.ReasonTestDataRollingOut,
+				"Test data is sent once the pipeline configuration with the test data output is rolled out",
+			)
+
+			// the test data is sent and the request is recorded
+			updatedPipeline, sectionsBefore := reconcile()
+			require.Equal(t, sections, sectionsBefore)
+			require.NotNil(t, updatedPipeline.Status.TestData)
+			require.Equal(t, "req-1", updatedPipeline.Status.TestData.Request)
+			requireHasStatusCondition(t, updatedPipeline,
+				conditions.TypeTestDataDelivered,
+				metav1.ConditionUnknown,
+				conditions.ReasonTestDataPending,
+				"Test data was sent, waiting for the export result",
+			)
+
+			// the pending request is probed, and the sections stay the same
+			updatedPipeline, sections = reconcile()
+			require.Equal(t, sectionsBefore, sections)
+			requireHasStatusCondition(t, updatedPipeline,
+				conditions.TypeTestDataDelivered,
+				metav1.ConditionTrue,
+				conditions.ReasonTestDataExported,
+				"Test data was sent and the pipeline exported data to the backend",
+			)
+
+			// the test data output is removed once the export result is known
+			_, sections = reconcile()
+			require.NotContains(t, sections, pipeline.Name+"_test-data")
+		})
+
+		t.Run("test data cannot be sent", func(t *testing.T) {
+			pipeline := testutils.NewLogPipelineBuilder().
+				WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").
+				WithHTTPOutput(testutils.HTTPHost("host")).
+				WithAnnotations(map[string]string{telemetryv1alpha1.AnnotationSendTestData: "req-1"}).
+				Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+			flowHealthProberStub := &mocks.FlowHealthProber{}
+			flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+			flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
+
+			pipelineValidatorWithStubs := &Validator{
+				EndpointValidator:  stubs.NewEndpointValidator(nil),
+				TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+				SecretRefValidator: stubs.NewSecretRefValidator(nil),
+				PipelineLock:       pipelineLockStub,
+			}
+
+			sut := New(fakeClient, testConfig, commonStatusStubs.NewDaemonSetProber(nil), flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, &mocks.ErrorToMessageConverter{})
+			sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(nil)
+			sut.testDataSender = commonStatusStubs.NewTestDataSender(errors.New("connection refused"))
+
+			// the first reconciliation renders the test data output, the second one sends the test data
+			for range 2 {
+				var pl telemetryv1alpha1.LogPipeline
+
+				require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &pl))
+				require.NoError(t, sut.Reconcile(context.Background(), &pl))
+			}
+
+			var updatedPipeline telemetryv1alpha1.LogPipeline
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline))
+
+			require.Nil(t, updatedPipeline.Status.TestData)
+			requireHasStatusCondition(t, updatedPipeline,
+				conditions.TypeTestDataDelivered,
+				metav1.ConditionFalse,
+				conditions.ReasonTestDataSendFailed,
+				"Test data cannot be sent: connection refused",
+			)
+		})
+
+		t.Run("configuration not generated", func(t *testing.T) {
+			pipeline := testutils.NewLogPipelineBuilder().
+				WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").
+				WithAnnotations(map[string]string{telemetryv1alpha1.AnnotationSendTestData: "req-1"}).
+				Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+			flowHealthProberStub := &mocks.FlowHealthProber{}
+			flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+			flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
+
+			pipelineValidatorWithStubs := &Validator{
+				EndpointValidator:  stubs.NewEndpointValidator(nil),
+				TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+				SecretRefValidator: stubs.NewSecretRefValidator(fmt.Errorf("%w: Secret 'some-secret' of Namespace 'some-namespace'", secretref.ErrSecretRefNotFound)),
+				PipelineLock:       pipelineLockStub,
+			}
+
+			sut := New(fakeClient, testConfig, commonStatusStubs.NewDaemonSetProber(nil), flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, &mocks.ErrorToMessageConverter{})
+			sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(nil)
+
+			var pl telemetryv1alpha1.LogPipeline
+
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &pl))
+			require.NoError(t, sut.Reconcile(context.Background(), &pl))
+
+			var updatedPipeline telemetryv1alpha1.LogPipeline
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline))
+
+			require.Nil(t, updatedPipeline.Status.TestData)
+			requireHasStatusCondition(t, updatedPipeline,
+				conditions.TypeTestDataDelivered,
+				metav1.ConditionUnknown,
+				conditions.ReasonTestDataNotSent,
+				"Test data is not sent because the pipeline configuration is not generated. Check the 'ConfigurationGenerated' condition for more details",
+			)
+		})
+	})
+
 	t.Run("tls conditions", func(t *testing.T) {
 		tests := []struct {
 			name                  string
@@ -625,6 +977,13 @@ func TestReconcile(t *testing.T) {
 				expectedReason:  conditions.ReasonTLSConfigurationInvalid,
 				expectedMessage: "TLS configuration invalid: certificate and private key do not match",
 			},
+			{
+				name:            "unknown cipher suite",
+				tlsCertErr:      fmt.Errorf("%w: %s", tlscert.ErrUnknownCipherSuite, "TLS_RSA_WITH_RC4_128_SHA"),
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonTLSConfigurationInvalid,
+				expectedMessage: "TLS configuration invalid: unknown or insecure cipher suite: TLS_RSA_WITH_RC4_128_SHA",
+			},
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
@@ -637,18 +996,20 @@ func TestReconcile(t *testing.T) {
 				proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 				pipelineValidatorWithStubs := &Validator{
 					EndpointValidator:  stubs.NewEndpointValidator(nil),
 					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 					SecretRefValidator: stubs.NewSecretRefValidator(tt.tlsCertErr),
+					PipelineLock:       pipelineLockStub,
 				}
 
 				errToMsgStub := &mocks.ErrorToMessageConverter{}
 				errToMsgStub.On("Convert", mock.Anything).Return("")
 
-				sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+				sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 				var pl1 telemetryv1alpha1.LogPipeline
 
@@ -733,17 +1094,19 @@ func TestReconcile(t *testing.T) {
 				proberStub := commonStatusStubs.NewDaemonSetProber(tt.probeErr)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 				pipelineValidatorWithStubs := &Validator{
 					EndpointValidator:  stubs.NewEndpointValidator(nil),
 					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 					SecretRefValidator: stubs.NewSecretRefValidator(nil),
+					PipelineLock:       pipelineLockStub,
 				}
 
 				errToMsgStub := &conditions.ErrorToMessageConverter{}
 
-				sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+				sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 				var pl1 telemetryv1alpha1.LogPipeline
 
@@ -776,6 +1139,7 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
 		serverErr := errors.New("failed to get secret: server error")
@@ -783,11 +1147,12 @@ func TestReconcile(t *testing.T) {
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(&errortypes.APIRequestFailedError{Err: serverErr}),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -817,6 +1182,55 @@ func TestReconcile(t *testing.T) {
 		require.Error(t, err, "sections configmap should not exist")
 	})
 
+	t.Run("proxy settings from telemetry", func(t *testing.T) {
+		schemeWithTelemetry := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(schemeWithTelemetry))
+		require.NoError(t, telemetryv1alpha1.AddToScheme(schemeWithTelemetry))
+		require.NoError(t, operatorv1alpha1.AddToScheme(schemeWithTelemetry))
+
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				Proxy: &operatorv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"},
+			},
+		}
+		proxiedPipeline := testutils.NewLogPipelineBuilder().
+			WithName("proxied").
+			WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").
+			WithHTTPOutput(testutils.HTTPHost("proxied.example.com")).
+			Build()
+		directPipeline := testutils.NewLogPipelineBuilder().
+			WithName("direct").
+			WithFinalizer("FLUENT_BIT_SECTIONS_CONFIG_MAP").
+			WithHTTPOutput(testutils.HTTPHost("direct.example.com"), testutils.HTTPProxy(&telemetryv1alpha1.OutputProxy{Disabled: true})).
+			Build()
+		fakeClient := fake.NewClientBuilder().WithScheme(schemeWithTelemetry).
+			WithObjects(telemetry, &proxiedPipeline, &directPipeline).
+			WithStatusSubresource(&proxiedPipeline, &directPipeline).
+			Build()
+
+		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+		flowHealthProberStub.On("Probe", mock.Anything, mock.Anything).Return(prober.LogPipelineProbeResult{}, nil)
+
+		pipelineValidatorWithStubs := &Validator{
+			EndpointValidator:  stubs.NewEndpointValidator(nil),
+			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
+		}
+
+		sut := New(fakeClient, testConfig, commonStatusStubs.NewDaemonSetProber(nil), flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, &mocks.ErrorToMessageConverter{})
+		require.NoError(t, sut.Reconcile(context.Background(), &proxiedPipeline))
+
+		var daemonSet appsv1.DaemonSet
+		require.NoError(t, fakeClient.Get(context.Background(), testConfig.DaemonSet, &daemonSet))
+		require.Equal(t, []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "localhost,127.0.0.1,.svc,.cluster.local,$(KUBERNETES_SERVICE_HOST),direct.example.com"},
+		}, daemonSet.Spec.Template.Spec.Containers[0].Env)
+	})
+
 	t.Run("create 2 pipelines and delete 1 should update sections configmap properly", func(t *testing.T) {
 		pipeline1 := testutils.NewLogPipelineBuilder().
 			WithName("pipeline1").
@@ -832,6 +1246,7 @@ func TestReconcile(t *testing.T) {
 		proberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline1.Name).Return(prober.LogPipelineProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline2.Name).Return(prober.LogPipelineProbeResult{}, nil)
 
@@ -839,11 +1254,12 @@ func TestReconcile(t *testing.T) {
 			EndpointValidator:  stubs.NewEndpointValidator(nil),
 			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
 			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
 		}
 
 		errToMsgStub := &mocks.ErrorToMessageConverter{}
 
-		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineValidatorWithStubs, errToMsgStub)
+		sut := New(fakeClient, testConfig, proberStub, flowHealthProberStub, istioStatusCheckerStub, pipelineLockStub, pipelineValidatorWithStubs, errToMsgStub)
 
 		var pl1 telemetryv1alpha1.LogPipeline
 
@@ -990,7 +1406,7 @@ func TestCalculateChecksum(t *testing.T) {
 
 	client := fake.NewClientBuilder().WithObjects(&dsConfig, &sectionsConfig, &filesConfig, &luaConfig, &parsersConfig, &envSecret, &certSecret).Build()
 
-	r := New(client, config, nil, nil, nil, nil, nil)
+	r := New(client, config, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	checksum, err := r.calculateChecksum(ctx)
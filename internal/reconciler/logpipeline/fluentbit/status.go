@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -15,7 +17,9 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
+	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
@@ -33,6 +37,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 
 	if pipeline.DeletionTimestamp != nil {
 		logf.FromContext(ctx).V(1).Info("Skipping status update for LogPipeline - marked for deletion")
+		commonstatus.DeletePipelineMetrics(pipelineKind, pipeline.Name)
+
 		return nil
 	}
 
@@ -40,14 +46,24 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		return err
 	}
 
-	r.setAgentHealthyCondition(ctx, &pipeline)
-	r.setFluentBitConfigGeneratedCondition(ctx, &pipeline)
-	r.setFlowHealthCondition(ctx, &pipeline)
+	oldConditions := slices.Clone(pipeline.Status.Conditions)
+
+	probeCtx, probeSpan := selftracing.Start(ctx, selftracing.SpanProbe)
+	r.setAgentHealthyCondition(probeCtx, &pipeline)
+	r.setFluentBitConfigGeneratedCondition(probeCtx, &pipeline)
+	r.setFlowHealthCondition(probeCtx, &pipeline)
+	r.setOutputReachableCondition(probeCtx, &pipeline)
+	r.setTestDataCondition(probeCtx, &pipeline)
+	r.setStatistics(probeCtx, &pipeline)
+	probeSpan.End()
 
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		return fmt.Errorf("failed to update LogPipeline status: %w", err)
 	}
 
+	commonstatus.RecordConditionEvents(r.config.EventRecorder, &pipeline, oldConditions, pipeline.Status.Conditions)
+	r.recordMetrics(ctx, &pipeline)
+
 	return nil
 }
 
@@ -92,6 +108,10 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 		return metav1.ConditionTrue, conditions.ReasonAgentConfigured, conditions.MessageForLogPipeline(conditions.ReasonAgentConfigured)
 	}
 
+	if errors.Is(err, resourcelock.ErrMaxPipelinesExceeded) {
+		return metav1.ConditionFalse, conditions.ReasonMaxPipelinesExceeded, conditions.ConvertErrToMsg(err)
+	}
+
 	if errors.Is(err, secretref.ErrSecretRefNotFound) || errors.Is(err, secretref.ErrSecretKeyNotFound) {
 		return metav1.ConditionFalse, conditions.ReasonReferencedSecretMissing, conditions.ConvertErrToMsg(err)
 	}
@@ -112,12 +132,13 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 
 func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) {
 	status, reason := r.evaluateFlowHealthCondition(ctx, pipeline)
+	thresholds := commonstatus.GetSelfMonitorThresholds(ctx, r.Client)
 
 	condition := metav1.Condition{
 		Type:               conditions.TypeFlowHealthy,
 		Status:             status,
 		Reason:             reason,
-		Message:            conditions.MessageForLogPipeline(reason),
+		Message:            commonstatus.FlowHealthMessage(conditions.MessageForLogPipeline(reason), reason, thresholds, commonstatus.SignalTypeLogs),
 		ObservedGeneration: pipeline.Generation,
 	}
 
@@ -146,6 +167,100 @@ func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *
 	return metav1.ConditionFalse, reason
 }
 
+func (r *Reconciler) setOutputReachableCondition(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) {
+	status, reason, message := r.evaluateOutputReachableCondition(ctx, pipeline)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeOutputReachable,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: pipeline.Generation,
+	}
+
+	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+}
+
+func (r *Reconciler) evaluateOutputReachableCondition(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) (status metav1.ConditionStatus, reason string, message string) {
+	// The ConfigurationGenerated condition is already set, so the pipeline is not validated again
+	if meta.IsStatusConditionFalse(pipeline.Status.Conditions, conditions.TypeConfigurationGenerated) {
+		return metav1.ConditionUnknown, conditions.ReasonOutputNotProbed, conditions.MessageForLogPipeline(conditions.ReasonOutputNotProbed)
+	}
+
+	target, err := prober.LogOutputTarget(ctx, r.Client, &pipeline.Spec.Output)
+	if err == nil {
+		err = r.outputReachabilityProber.Probe(ctx, pipeline.Name, target)
+	}
+
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("Output is not reachable", "error", err.Error())
+	}
+
+	return conditions.EvaluateOutputReachableCondition(err)
+}
+
+func (r *Reconciler) setStatistics(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) {
+	pipeline.Status.Statistics = commonstatus.GetStatistics(ctx, r.flowHealthProber, pipeline.Name, pipeline.Status.Statistics, time.Now())
+}
+
+func (r *Reconciler) setTestDataCondition(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) {
+	request := pipeline.Annotations[telemetryv1alpha1.AnnotationSendTestData]
+	if request == "" {
+		meta.RemoveStatusCondition(&pipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+		return
+	}
+
+	status, reason, message := r.evaluateTestDataCondition(ctx, pipeline, request)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeTestDataDelivered,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: pipeline.Generation,
+	}
+
+	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+}
+
+// evaluateTestDataCondition sends test data for a new request, and probes the export result of a pending one.
+func (r *Reconciler) evaluateTestDataCondition(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline, request string) (status metav1.ConditionStatus, reason string, message string) {
+	testData := pipeline.Status.TestData
+	current := meta.FindStatusCondition(pipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+
+	if testData == nil || testData.Request != request {
+		configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
+		if configGeneratedStatus == metav1.ConditionFalse {
+			return metav1.ConditionUnknown, conditions.ReasonTestDataNotSent, conditions.MessageForLogPipeline(conditions.ReasonTestDataNotSent)
+		}
+
+		// The test data output is only rendered while the test data is pending, so the test data is sent in a later reconciliation, once the agent with that output is rolled out
+		if !conditions.IsTestDataRolloutAwaited(current) || r.agentProber.IsReady(ctx, types.NamespacedName{Name: r.config.DaemonSet.Name, Namespace: r.config.DaemonSet.Namespace}) != nil {
+			return metav1.ConditionUnknown, conditions.ReasonTestDataRollingOut, conditions.MessageForLogPipeline(conditions.ReasonTestDataRollingOut)
+		}
+
+		if err := r.testDataSender.Send(ctx, pipeline.Name, request); err != nil {
+			logf.FromContext(ctx).V(1).Info("Failed to send test data", "error", err.Error())
+			return metav1.ConditionFalse, conditions.ReasonTestDataSendFailed, fmt.Sprintf(conditions.MessageForLogPipeline(conditions.ReasonTestDataSendFailed), err)
+		}
+
+		pipeline.Status.TestData = &telemetryv1alpha1.TestDataStatus{Request: request, SentAt: metav1.Now()}
+
+		return metav1.ConditionUnknown, conditions.ReasonTestDataPending, conditions.MessageForLogPipeline(conditions.ReasonTestDataPending)
+	}
+
+	if current != nil && current.Reason != conditions.ReasonTestDataPending {
+		return current.Status, current.Reason, current.Message
+	}
+
+	result, err := r.flowHealthProber.ProbeTestData(ctx, pipeline.Name, testData.SentAt.Time)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to probe test data export")
+	}
+
+	return conditions.EvaluateTestDataCondition(result, err, testData.SentAt.Time, time.Now())
+}
+
 func flowHealthReasonFor(probeResult prober.LogPipelineProbeResult) string {
 	switch {
 	case probeResult.AllDataDropped:
@@ -156,7 +271,43 @@ func flowHealthReasonFor(probeResult prober.LogPipelineProbeResult) string {
 		return conditions.ReasonSelfMonNoLogsDelivered
 	case probeResult.BufferFillingUp:
 		return conditions.ReasonSelfMonBufferFillingUp
+	case probeResult.Throttling:
+		return conditions.ReasonSelfMonAgentThrottling
 	default:
 		return conditions.ReasonSelfMonFlowHealthy
 	}
 }
+
+func (r *Reconciler) recordMetrics(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) {
+	commonstatus.RecordPipelineMetrics(commonstatus.PipelineMetrics{
+		Kind:            pipelineKind,
+		Name:            pipeline.Name,
+		Conditions:      pipeline.Status.Conditions,
+		OutputType:      outputType(pipeline),
+		UnsupportedMode: pipeline.ContainsCustomPlugin(),
+		TLSCertExpiry:   r.pipelineValidator.tlsCertExpiry(ctx, pipeline),
+	})
+}
+
+func outputType(pipeline *telemetryv1alpha1.LogPipeline) string {
+	output := pipeline.Spec.Output
+
+	switch {
+	case output.IsCustomDefined():
+		return "custom"
+	case output.IsHTTPDefined():
+		return "http"
+	case output.IsLokiDefined():
+		return "loki"
+	case output.IsElasticsearchDefined():
+		return "elasticsearch"
+	case output.IsSyslogDefined():
+		return "syslog"
+	case output.IsGELFDefined():
+		return "gelf"
+	case output.IsKafkaDefined():
+		return "kafka"
+	default:
+		return "unknown"
+	}
+}
@@ -12,6 +12,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/fluentbit/config/builder"
 	"github.com/kyma-project/telemetry-manager/internal/k8sutils"
 )
@@ -71,6 +72,7 @@ func (s *syncer) syncSectionsConfigMap(ctx context.Context, pipeline *telemetryv
 		builderConfig := builder.BuilderConfig{
 			PipelineDefaults: s.config.PipelineDefaults,
 			CollectAgentLogs: s.config.Overrides.Logging.CollectAgentLogs,
+			TestDataPending:  conditions.IsTestDataPending(pipeline.Annotations, pipeline.Status.TestData, pipeline.Status.Conditions),
 		}
 
 		newConfig, err := builder.BuildFluentBitConfig(pipeline, builderConfig)
@@ -114,6 +116,17 @@ func (s *syncer) syncFilesConfigMap(ctx context.Context, pipeline *telemetryv1al
 		}
 	}
 
+	upstreamFileName := builder.ElasticsearchUpstreamFileName(pipeline.Name)
+	if upstream := builder.BuildElasticsearchUpstreamFile(pipeline); upstream != "" && pipeline.DeletionTimestamp.IsZero() {
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+
+		cm.Data[upstreamFileName] = upstream
+	} else {
+		delete(cm.Data, upstreamFileName)
+	}
+
 	if pipeline.DeletionTimestamp.IsZero() {
 		if err = controllerutil.SetOwnerReference(pipeline, &cm, s.Scheme()); err != nil {
 			return fmt.Errorf("unable to set owner reference for files configmap: %w", err)
@@ -148,6 +161,12 @@ func (s *syncer) syncEnvSecret(ctx context.Context, logPipelines []telemetryv1al
 			}
 		}
 
+		// The validator rejects pipelines with an invalid Loki URL, so this only happens if the Secret changed since.
+		// The other pipelines must not be affected, so only the URL components of this pipeline are left out.
+		if splitErr := splitLokiURLSecret(&logPipelines[i], newSecret.Data); splitErr != nil {
+			logf.FromContext(ctx).Error(splitErr, "Skipping invalid Loki URL")
+		}
+
 		// we also store the variables in the env secret
 		for _, ref := range logPipelines[i].Spec.Variables {
 			if ref.ValueFrom.IsSecretKeyRef() {
@@ -169,6 +188,30 @@ func (s *syncer) syncEnvSecret(ctx context.Context, logPipelines []telemetryv1al
 	return nil
 }
 
+// splitLokiURLSecret derives the host, port, URI and TLS variables from a Loki URL that is referenced from a secret,
+// since the Fluent Bit loki output does not accept a URL but requires the single components.
+func splitLokiURLSecret(pipeline *telemetryv1alpha1.LogPipeline, target map[string][]byte) error {
+	output := pipeline.Spec.Output
+	if !output.IsLokiDefined() || output.Loki.URL.Value != "" {
+		return nil
+	}
+
+	ref := *output.Loki.URL.ValueFrom.SecretKeyRef
+	urlKey := builder.FormatEnvVarName(pipeline.Name, ref.Namespace, ref.Name, ref.Key)
+
+	components, err := builder.SplitLokiURL(string(target[urlKey]))
+	if err != nil {
+		return fmt.Errorf("unable to parse loki url of pipeline '%s': %w", pipeline.Name, err)
+	}
+
+	target[builder.FormatLokiURLEnvVarName(pipeline.Name, ref, builder.LokiURLHost)] = []byte(components.Host)
+	target[builder.FormatLokiURLEnvVarName(pipeline.Name, ref, builder.LokiURLPort)] = []byte(components.Port)
+	target[builder.FormatLokiURLEnvVarName(pipeline.Name, ref, builder.LokiURLURI)] = []byte(components.URI)
+	target[builder.FormatLokiURLEnvVarName(pipeline.Name, ref, builder.LokiURLTLS)] = []byte(components.TLS)
+
+	return nil
+}
+
 func (s *syncer) syncTLSConfigSecret(ctx context.Context, logPipelines []telemetryv1alpha1.LogPipeline) error {
 	oldSecret, err := k8sutils.GetOrCreateSecret(ctx, s, s.config.OutputTLSConfigSecret)
 	if err != nil {
@@ -183,27 +226,26 @@ func (s *syncer) syncTLSConfigSecret(ctx context.Context, logPipelines []telemet
 			continue
 		}
 
-		output := logPipelines[i].Spec.Output
-		if !output.IsHTTPDefined() {
+		tlsConfig := logPipelines[i].Spec.Output.GetTLSConfig()
+		if tlsConfig == nil {
 			continue
 		}
 
-		tlsConfig := output.HTTP.TLSConfig
-		if tlsConfig.CA.IsDefined() {
+		if tlsConfig.GetCA().IsDefined() {
 			targetKey := fmt.Sprintf("%s-ca.crt", logPipelines[i].Name)
-			if err := s.copyFromValueOrSecret(ctx, *tlsConfig.CA, targetKey, newSecret.Data); err != nil {
+			if err := s.copyFromValueOrSecret(ctx, *tlsConfig.GetCA(), targetKey, newSecret.Data); err != nil {
 				return err
 			}
 		}
 
-		if tlsConfig.Cert.IsDefined() && tlsConfig.Key.IsDefined() {
+		if tlsConfig.GetCert().IsDefined() && tlsConfig.GetKey().IsDefined() {
 			targetCertVariable := fmt.Sprintf("%s-cert.crt", logPipelines[i].Name)
-			if err := s.copyFromValueOrSecret(ctx, *tlsConfig.Cert, targetCertVariable, newSecret.Data); err != nil {
+			if err := s.copyFromValueOrSecret(ctx, *tlsConfig.GetCert(), targetCertVariable, newSecret.Data); err != nil {
 				return err
 			}
 
 			targetKeyVariable := fmt.Sprintf("%s-key.key", logPipelines[i].Name)
-			if err := s.copyFromValueOrSecret(ctx, *tlsConfig.Key, targetKeyVariable, newSecret.Data); err != nil {
+			if err := s.copyFromValueOrSecret(ctx, *tlsConfig.GetKey(), targetKeyVariable, newSecret.Data); err != nil {
 				return err
 			}
 
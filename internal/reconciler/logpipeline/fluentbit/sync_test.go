@@ -252,6 +252,74 @@ alias foo`,
 		require.NotContains(t, filesCm.Data, "lua-script")
 	})
 
+	t.Run("should add and remove elasticsearch upstream file", func(t *testing.T) {
+		sut := syncer{fakeClient, Config{FilesConfigMap: filesCmName}}
+
+		pipeline := &telemetryv1alpha1.LogPipeline{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "es",
+			},
+			Spec: telemetryv1alpha1.LogPipelineSpec{
+				Output: telemetryv1alpha1.Output{
+					Elasticsearch: &telemetryv1alpha1.ElasticsearchOutput{
+						Hosts: []string{"es-0.example.com", "es-1.example.com"},
+					},
+				},
+			},
+		}
+
+		err := sut.syncFilesConfigMap(context.Background(), pipeline)
+		require.NoError(t, err)
+
+		var filesCm corev1.ConfigMap
+		err = fakeClient.Get(context.Background(), filesCmName, &filesCm)
+		require.NoError(t, err)
+		require.Contains(t, filesCm.Data, "es-elasticsearch-upstream.conf")
+		require.Contains(t, filesCm.Data["es-elasticsearch-upstream.conf"], "es-1.example.com")
+
+		pipeline.Spec.Output.Elasticsearch.Hosts = []string{"es-0.example.com"}
+		err = sut.syncFilesConfigMap(context.Background(), pipeline)
+		require.NoError(t, err)
+
+		err = fakeClient.Get(context.Background(), filesCmName, &filesCm)
+		require.NoError(t, err)
+		require.NotContains(t, filesCm.Data, "es-elasticsearch-upstream.conf")
+	})
+
+	t.Run("should add and remove elasticsearch upstream file", func(t *testing.T) {
+		sut := syncer{fakeClient, Config{FilesConfigMap: filesCmName}}
+
+		pipeline := &telemetryv1alpha1.LogPipeline{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "es",
+			},
+			Spec: telemetryv1alpha1.LogPipelineSpec{
+				Output: telemetryv1alpha1.Output{
+					Elasticsearch: &telemetryv1alpha1.ElasticsearchOutput{
+						Hosts: []string{"es-0.example.com", "es-1.example.com"},
+					},
+				},
+			},
+		}
+
+		err := sut.syncFilesConfigMap(context.Background(), pipeline)
+		require.NoError(t, err)
+
+		var filesCm corev1.ConfigMap
+		err = fakeClient.Get(context.Background(), filesCmName, &filesCm)
+		require.NoError(t, err)
+		require.Contains(t, filesCm.Data, "es-elasticsearch-upstream.conf")
+		require.Contains(t, filesCm.Data["es-elasticsearch-upstream.conf"], "es-1.example.com")
+
+		pipeline.Spec.Output.Elasticsearch.Hosts = []string{"es-0.example.com"}
+		err = sut.syncFilesConfigMap(context.Background(), pipeline)
+		require.NoError(t, err)
+
+		err = fakeClient.Get(context.Background(), filesCmName, &filesCm)
+		require.NoError(t, err)
+		require.NotContains(t, filesCm.Data, "es-elasticsearch-upstream.conf")
+	})
+
 	t.Run("should fail if client fails", func(t *testing.T) {
 		badReqClient := &mocks.Client{}
 		badReqErr := apierrors.NewBadRequest("")
@@ -372,6 +440,84 @@ func TestSyncEnvSecret(t *testing.T) {
 	})
 }
 
+func TestSyncEnvSecretWithLokiURLSecretReference(t *testing.T) {
+	pipeline := telemetryv1alpha1.LogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "loki"},
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				Loki: &telemetryv1alpha1.LokiOutput{
+					URL: telemetryv1alpha1.ValueType{
+						ValueFrom: &telemetryv1alpha1.ValueFromSource{
+							SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+								Name:      "loki",
+								Namespace: "default",
+								Key:       "url",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("should split loki url into host, port, uri and tls", func(t *testing.T) {
+		urlSecret := corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "loki",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{"url": []byte("http://loki.loki:3100/loki/api/v1/push")},
+		}
+		fakeClient := fake.NewClientBuilder().WithObjects(&urlSecret).Build()
+
+		envSecretName := types.NamespacedName{Name: "env", Namespace: "telemetry-system"}
+		sut := syncer{fakeClient, Config{EnvSecret: envSecretName}}
+		err := sut.syncEnvSecret(context.Background(), []telemetryv1alpha1.LogPipeline{pipeline})
+		require.NoError(t, err)
+
+		var envSecret corev1.Secret
+		err = fakeClient.Get(context.Background(), envSecretName, &envSecret)
+		require.NoError(t, err)
+		require.Equal(t, []byte("http://loki.loki:3100/loki/api/v1/push"), envSecret.Data["LOKI_DEFAULT_LOKI_URL"])
+		require.Equal(t, []byte("loki.loki"), envSecret.Data["LOKI_DEFAULT_LOKI_URL_HOST"])
+		require.Equal(t, []byte("3100"), envSecret.Data["LOKI_DEFAULT_LOKI_URL_PORT"])
+		require.Equal(t, []byte("/loki/api/v1/push"), envSecret.Data["LOKI_DEFAULT_LOKI_URL_URI"])
+		require.Equal(t, []byte("off"), envSecret.Data["LOKI_DEFAULT_LOKI_URL_TLS"])
+	})
+
+	t.Run("should skip an invalid loki url without affecting other pipelines", func(t *testing.T) {
+		urlSecret := corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "loki",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{"url": []byte("http://:3100")},
+		}
+		otherSecret := corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{"url": []byte("http://loki.other:3100")},
+		}
+		fakeClient := fake.NewClientBuilder().WithObjects(&urlSecret, &otherSecret).Build()
+
+		otherPipeline := pipeline.DeepCopy()
+		otherPipeline.Name = "other"
+		otherPipeline.Spec.Output.Loki.URL.ValueFrom.SecretKeyRef.Name = "other"
+
+		envSecretName := types.NamespacedName{Name: "env", Namespace: "telemetry-system"}
+		sut := syncer{fakeClient, Config{EnvSecret: envSecretName}}
+		err := sut.syncEnvSecret(context.Background(), []telemetryv1alpha1.LogPipeline{pipeline, *otherPipeline})
+		require.NoError(t, err)
+
+		var envSecret corev1.Secret
+		require.NoError(t, fakeClient.Get(context.Background(), envSecretName, &envSecret))
+		require.NotContains(t, envSecret.Data, "LOKI_DEFAULT_LOKI_URL_HOST")
+		require.Equal(t, []byte("loki.other"), envSecret.Data["OTHER_DEFAULT_OTHER_URL_HOST"])
+	})
+}
+
 func TestSyncTLSConfigSecret(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
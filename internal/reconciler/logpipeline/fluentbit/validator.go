@@ -2,8 +2,10 @@ package fluentbit
 
 import (
 	"context"
+	"time"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
@@ -15,6 +17,7 @@ type EndpointValidator interface {
 
 type TLSCertValidator interface {
 	Validate(ctx context.Context, config tlscert.TLSBundle) error
+	Expiry(ctx context.Context, config tlscert.TLSBundle) time.Time
 }
 
 type SecretRefValidator interface {
@@ -25,6 +28,7 @@ type Validator struct {
 	EndpointValidator  EndpointValidator
 	TLSCertValidator   TLSCertValidator
 	SecretRefValidator SecretRefValidator
+	PipelineLock       logpipeline.PipelineLock
 }
 
 func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) error {
@@ -38,26 +42,70 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1alpha1.Lo
 		}
 	}
 
-	if tlsValidationRequired(pipeline) {
-		tlsConfig := tlscert.TLSBundle{
-			Cert: pipeline.Spec.Output.HTTP.TLSConfig.Cert,
-			Key:  pipeline.Spec.Output.HTTP.TLSConfig.Key,
-			CA:   pipeline.Spec.Output.HTTP.TLSConfig.CA,
+	if pipeline.Spec.Output.Loki != nil {
+		if err := v.EndpointValidator.Validate(ctx, &pipeline.Spec.Output.Loki.URL, endpoint.FluentBitProtocolLoki); err != nil {
+			return err
+		}
+	}
+
+	if pipeline.Spec.Output.Syslog != nil {
+		if err := v.EndpointValidator.Validate(ctx, &pipeline.Spec.Output.Syslog.Host, endpoint.FluentBitProtocolHost); err != nil {
+			return err
+		}
+	}
+
+	if pipeline.Spec.Output.GELF != nil {
+		if err := v.EndpointValidator.Validate(ctx, &pipeline.Spec.Output.GELF.Host, endpoint.FluentBitProtocolHost); err != nil {
+			return err
+		}
+	}
+
+	if pipeline.Spec.Output.Kafka != nil {
+		for _, broker := range pipeline.Spec.Output.Kafka.Brokers {
+			if err := v.EndpointValidator.Validate(ctx, &telemetryv1alpha1.ValueType{Value: broker}, endpoint.KafkaProtocolBroker); err != nil {
+				return err
+			}
 		}
+	}
 
-		if err := v.TLSCertValidator.Validate(ctx, tlsConfig); err != nil {
+	if tlsValidationRequired(pipeline) {
+		if err := v.TLSCertValidator.Validate(ctx, tlsBundle(pipeline)); err != nil {
 			return err
 		}
 	}
 
+	if err := v.PipelineLock.IsLockHolder(ctx, pipeline); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// tlsCertExpiry returns the earliest expiry of the certificates configured for the output, or the zero time if there is none.
+func (v *Validator) tlsCertExpiry(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) time.Time {
+	if !tlsValidationRequired(pipeline) {
+		return time.Time{}
+	}
+
+	return v.TLSCertValidator.Expiry(ctx, tlsBundle(pipeline))
+}
+
+func tlsBundle(pipeline *telemetryv1alpha1.LogPipeline) tlscert.TLSBundle {
+	outputTLS := pipeline.Spec.Output.GetTLSConfig()
+
+	return tlscert.TLSBundle{
+		Cert:         outputTLS.GetCert(),
+		Key:          outputTLS.GetKey(),
+		CA:           outputTLS.GetCA(),
+		CipherSuites: pipeline.Spec.Output.GetTLSCipherSuites(),
+	}
+}
+
 func tlsValidationRequired(pipeline *telemetryv1alpha1.LogPipeline) bool {
-	http := pipeline.Spec.Output.HTTP
-	if http == nil {
+	tlsConfig := pipeline.Spec.Output.GetTLSConfig()
+	if tlsConfig == nil {
 		return false
 	}
 
-	return http.TLSConfig.Cert != nil || http.TLSConfig.Key != nil || http.TLSConfig.CA != nil
+	return tlsConfig.GetCert() != nil || tlsConfig.GetKey() != nil || tlsConfig.GetCA() != nil || len(pipeline.Spec.Output.GetTLSCipherSuites()) > 0
 }
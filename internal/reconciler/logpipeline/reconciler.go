@@ -19,7 +19,11 @@ package logpipeline
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,7 +31,9 @@ import (
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 )
 
 var (
@@ -41,6 +47,8 @@ const (
 	FluentBit
 )
 
+const pipelineKind = "LogPipeline"
+
 type LogPipelineReconciler interface {
 	Reconcile(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) error
 	SupportedOutput() OutputType
@@ -52,6 +60,16 @@ type DaemonSetAnnotator interface {
 
 type FlowHealthProber interface {
 	Probe(ctx context.Context, pipelineName string) (prober.LogPipelineProbeResult, error)
+	ProbeStatistics(ctx context.Context, pipelineName string) (prober.StatisticsProbeResult, error)
+	ProbeTestData(ctx context.Context, pipelineName string, sentAt time.Time) (prober.TestDataProbeResult, error)
+}
+
+type TestDataSender interface {
+	Send(ctx context.Context, pipelineName, request string) error
+}
+
+type OutputReachabilityProber interface {
+	Probe(ctx context.Context, pipelineName string, target *prober.OutputTarget) error
 }
 
 type OverridesHandler interface {
@@ -62,6 +80,11 @@ type IstioStatusChecker interface {
 	IsIstioActive(ctx context.Context) bool
 }
 
+type PipelineLock interface {
+	TryAcquireLock(ctx context.Context, owner metav1.Object) error
+	IsLockHolder(ctx context.Context, owner metav1.Object) error
+}
+
 type Reconciler struct {
 	client.Client
 
@@ -87,7 +110,7 @@ func New(
 	}
 }
 
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	logf.FromContext(ctx).V(1).Info("Reconciling")
 
 	overrideConfig, err := r.overridesHandler.LoadOverrides(ctx)
@@ -100,8 +123,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	pipelineOverrides := overrideConfig.Pipeline(pipelineKind, req.Name)
+	if pipelineOverrides.Paused {
+		logf.FromContext(ctx).V(1).Info("Skipping reconciliation: pipeline paused using override config")
+		return ctrl.Result{}, nil
+	}
+
+	ctx = pipelineOverrides.ContextWithLogLevel(ctx)
+
+	ctx, span := selftracing.Start(ctx, selftracing.ReconcileSpanName(pipelineKind), attribute.String("name", req.Name))
+	defer func() { selftracing.End(span, err) }()
+
 	var pipeline telemetryv1alpha1.LogPipeline
 	if err := r.Get(ctx, req.NamespacedName, &pipeline); err != nil {
+		if apierrors.IsNotFound(err) {
+			commonstatus.DeletePipelineMetrics(pipelineKind, req.Name)
+		}
+
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
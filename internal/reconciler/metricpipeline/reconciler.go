@@ -4,35 +4,51 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
 	"github.com/kyma-project/telemetry-manager/internal/k8sutils"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric/agent"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric/gateway"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpexporter"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/proxy"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 )
 
-const defaultReplicaCount int32 = 2
+const (
+	defaultReplicaCount int32 = 2
+	pipelineKind              = "MetricPipeline"
+)
 
 type Config struct {
 	AgentName          string
 	GatewayName        string
 	ModuleVersion      string
+	OTLPServiceName    string
 	TelemetryNamespace string
+	// EventRecorder records Events on condition transitions of the pipelines. No Events are recorded if it is nil.
+	EventRecorder record.EventRecorder
 }
 
 type AgentConfigBuilder interface {
@@ -60,6 +76,20 @@ type PipelineLock interface {
 
 type FlowHealthProber interface {
 	Probe(ctx context.Context, pipelineName string) (prober.OTelPipelineProbeResult, error)
+	ProbeStatistics(ctx context.Context, pipelineName string) (prober.StatisticsProbeResult, error)
+	ProbeTestData(ctx context.Context, pipelineName string, sentAt time.Time) (prober.TestDataProbeResult, error)
+}
+
+type OAuth2TokenProber interface {
+	Probe(ctx context.Context, pipelineName string, output *telemetryv1alpha1.OtlpOutput) error
+}
+
+type OutputReachabilityProber interface {
+	Probe(ctx context.Context, pipelineName string, target *prober.OutputTarget) error
+}
+
+type TestDataSender interface {
+	Send(ctx context.Context, pipelineName, request string) error
 }
 
 type OverridesHandler interface {
@@ -75,18 +105,21 @@ type Reconciler struct {
 
 	config Config
 
-	agentApplierDeleter   AgentApplierDeleter
-	agentConfigBuilder    AgentConfigBuilder
-	agentProber           commonstatus.DaemonSetProber
-	flowHealthProber      FlowHealthProber
-	gatewayApplierDeleter GatewayApplierDeleter
-	gatewayConfigBuilder  GatewayConfigBuilder
-	gatewayProber         commonstatus.DeploymentProber
-	istioStatusChecker    IstioStatusChecker
-	overridesHandler      OverridesHandler
-	pipelineLock          PipelineLock
-	pipelineValidator     *Validator
-	errToMsgConverter     commonstatus.ErrorToMessageConverter
+	agentApplierDeleter      AgentApplierDeleter
+	agentConfigBuilder       AgentConfigBuilder
+	agentProber              commonstatus.DaemonSetProber
+	flowHealthProber         FlowHealthProber
+	gatewayApplierDeleter    GatewayApplierDeleter
+	gatewayConfigBuilder     GatewayConfigBuilder
+	gatewayProber            commonstatus.DeploymentProber
+	istioStatusChecker       IstioStatusChecker
+	oauth2TokenProber        OAuth2TokenProber
+	outputReachabilityProber OutputReachabilityProber
+	overridesHandler         OverridesHandler
+	pipelineLock             PipelineLock
+	pipelineValidator        *Validator
+	testDataSender           TestDataSender
+	errToMsgConverter        commonstatus.ErrorToMessageConverter
 }
 
 func New(
@@ -106,24 +139,27 @@ func New(
 	errToMsgConverter commonstatus.ErrorToMessageConverter,
 ) *Reconciler {
 	return &Reconciler{
-		Client:                client,
-		config:                config,
-		agentApplierDeleter:   agentApplierDeleter,
-		agentConfigBuilder:    agentConfigBuilder,
-		agentProber:           agentProber,
-		flowHealthProber:      flowHealthProber,
-		gatewayApplierDeleter: gatewayApplierDeleter,
-		gatewayConfigBuilder:  gatewayConfigBuilder,
-		gatewayProber:         gatewayProber,
-		istioStatusChecker:    istioStatusChecker,
-		overridesHandler:      overridesHandler,
-		pipelineLock:          pipelineLock,
-		pipelineValidator:     pipelineValidator,
-		errToMsgConverter:     errToMsgConverter,
+		Client:                   client,
+		config:                   config,
+		agentApplierDeleter:      agentApplierDeleter,
+		agentConfigBuilder:       agentConfigBuilder,
+		agentProber:              agentProber,
+		flowHealthProber:         flowHealthProber,
+		gatewayApplierDeleter:    gatewayApplierDeleter,
+		gatewayConfigBuilder:     gatewayConfigBuilder,
+		gatewayProber:            gatewayProber,
+		istioStatusChecker:       istioStatusChecker,
+		oauth2TokenProber:        prober.NewOAuth2TokenProber(client),
+		outputReachabilityProber: prober.NewOutputReachabilityProber(),
+		overridesHandler:         overridesHandler,
+		pipelineLock:             pipelineLock,
+		pipelineValidator:        pipelineValidator,
+		testDataSender:           synthetic.NewMetricSender(types.NamespacedName{Name: config.OTLPServiceName, Namespace: config.TelemetryNamespace}),
+		errToMsgConverter:        errToMsgConverter,
 	}
 }
 
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	logf.FromContext(ctx).V(1).Info("Reconciling")
 
 	overrideConfig, err := r.overridesHandler.LoadOverrides(ctx)
@@ -136,13 +172,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	pipelineOverrides := overrideConfig.Pipeline(pipelineKind, req.Name)
+	if pipelineOverrides.Paused {
+		logf.FromContext(ctx).V(1).Info("Skipping reconciliation: pipeline paused using override config")
+		return ctrl.Result{}, nil
+	}
+
+	ctx = pipelineOverrides.ContextWithLogLevel(ctx)
+
+	ctx, span := selftracing.Start(ctx, selftracing.ReconcileSpanName(pipelineKind), attribute.String("name", req.Name))
+	defer func() { selftracing.End(span, err) }()
+
 	var metricPipeline telemetryv1alpha1.MetricPipeline
 	if err := r.Get(ctx, req.NamespacedName, &metricPipeline); err != nil {
+		if apierrors.IsNotFound(err) {
+			commonstatus.DeletePipelineMetrics(pipelineKind, req.Name)
+		}
+
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	err = r.doReconcile(ctx, &metricPipeline)
-	if statusErr := r.updateStatus(ctx, metricPipeline.Name); statusErr != nil {
+	err = r.doReconcile(ctx, &metricPipeline, overrideConfig)
+	if statusErr := r.updateStatus(ctx, metricPipeline.Name, overrideConfig); statusErr != nil {
 		if err != nil {
 			err = fmt.Errorf("failed while updating status: %w: %w", statusErr, err)
 		} else {
@@ -153,7 +204,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, err
 }
 
-func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) error {
+func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline, overrideConfig *overrides.Config) error {
 	if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
 		return err
 	}
@@ -163,7 +214,10 @@ func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha
 		return fmt.Errorf("failed to list metric pipelines: %w", err)
 	}
 
-	reconcilablePipelines, err := r.getReconcilablePipelines(ctx, allPipelinesList.Items)
+	resolveCtx, resolveSpan := selftracing.Start(ctx, selftracing.SpanResolveSecrets)
+	reconcilablePipelines, err := r.getReconcilablePipelines(resolveCtx, allPipelinesList.Items)
+	selftracing.End(resolveSpan, err)
+
 	if err != nil {
 		return fmt.Errorf("failed to fetch deployable metric pipelines: %w", err)
 	}
@@ -171,23 +225,19 @@ func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha
 	if len(reconcilablePipelines) == 0 {
 		logf.FromContext(ctx).V(1).Info("cleaning up metric pipeline resources: all metric pipelines are non-reconcilable")
 
-		if err = r.gatewayApplierDeleter.DeleteResources(ctx, r.Client, r.istioStatusChecker.IsIstioActive(ctx)); err != nil {
-			return fmt.Errorf("failed to delete gateway resources: %w", err)
-		}
+		deleteCtx, deleteSpan := selftracing.Start(ctx, selftracing.SpanDeleteResources)
+		err = r.deleteResources(deleteCtx)
+		selftracing.End(deleteSpan, err)
 
-		if err = r.agentApplierDeleter.DeleteResources(ctx, r.Client); err != nil {
-			return fmt.Errorf("failed to delete agent resources: %w", err)
-		}
-
-		return nil
+		return err
 	}
 
-	if err = r.reconcileMetricGateway(ctx, pipeline, reconcilablePipelines); err != nil {
+	if err = r.reconcileMetricGateway(ctx, pipeline, reconcilablePipelines, overrideConfig); err != nil {
 		return fmt.Errorf("failed to reconcile metric gateway: %w", err)
 	}
 
 	if isMetricAgentRequired(pipeline) {
-		if err = r.reconcileMetricAgents(ctx, pipeline, allPipelinesList.Items); err != nil {
+		if err = r.reconcileMetricAgents(ctx, pipeline, allPipelinesList.Items, overrideConfig); err != nil {
 			return fmt.Errorf("failed to reconcile metric agents: %w", err)
 		}
 	}
@@ -195,6 +245,20 @@ func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha
 	return nil
 }
 
+func (r *Reconciler) deleteResources(ctx context.Context) error {
+	if err := r.gatewayApplierDeleter.DeleteResources(ctx, r.Client, r.istioStatusChecker.IsIstioActive(ctx)); err != nil {
+		return fmt.Errorf("failed to delete gateway resources: %w", err)
+	}
+
+	commonstatus.DeleteGatewayReplicas(r.config.GatewayName)
+
+	if err := r.agentApplierDeleter.DeleteResources(ctx, r.Client); err != nil {
+		return fmt.Errorf("failed to delete agent resources: %w", err)
+	}
+
+	return nil
+}
+
 // getReconcilablePipelines returns the list of metric pipelines that are ready to be rendered into the otel collector configuration. A pipeline is deployable if it is not being deleted, all secret references exist, and is not above the pipeline limit.
 func (r *Reconciler) getReconcilablePipelines(ctx context.Context, allPipelines []telemetryv1alpha1.MetricPipeline) ([]telemetryv1alpha1.MetricPipeline, error) {
 	var reconcilablePipelines []telemetryv1alpha1.MetricPipeline
@@ -244,11 +308,15 @@ func isMetricAgentRequired(pipeline *telemetryv1alpha1.MetricPipeline) bool {
 	return isRuntimeInputEnabled || isPrometheusInputEnabled || isIstioInputEnabled
 }
 
-func (r *Reconciler) reconcileMetricGateway(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline, allPipelines []telemetryv1alpha1.MetricPipeline) error {
-	collectorConfig, collectorEnvVars, err := r.gatewayConfigBuilder.Build(ctx, allPipelines, gateway.BuildOptions{
+func (r *Reconciler) reconcileMetricGateway(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline, allPipelines []telemetryv1alpha1.MetricPipeline, overrideConfig *overrides.Config) error {
+	buildCtx, buildSpan := selftracing.Start(ctx, selftracing.SpanBuildConfig)
+	collectorConfig, collectorEnvVars, err := r.gatewayConfigBuilder.Build(buildCtx, allPipelines, gateway.BuildOptions{
 		GatewayNamespace:            r.config.TelemetryNamespace,
 		InstrumentationScopeVersion: r.config.ModuleVersion,
+		PendingTestData:             pendingTestData(allPipelines),
+		DebugExporterVerbosity:      overrideConfig.ExporterDebugLevels(pipelineKind),
 	})
+	selftracing.End(buildSpan, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to create collector config: %w", err)
@@ -259,6 +327,11 @@ func (r *Reconciler) reconcileMetricGateway(ctx context.Context, pipeline *telem
 		return fmt.Errorf("failed to marshal collector config: %w", err)
 	}
 
+	collectorConfigYAML, err = config.ApplyMergePatch(collectorConfigYAML, overrideConfig.CollectorPatches.MetricGateway)
+	if err != nil {
+		return fmt.Errorf("failed to patch collector config: %w", err)
+	}
+
 	isIstioActive := r.istioStatusChecker.IsIstioActive(ctx)
 
 	allowedPorts := getGatewayPorts()
@@ -272,54 +345,99 @@ func (r *Reconciler) reconcileMetricGateway(ctx context.Context, pipeline *telem
 		CollectorEnvVars:               collectorEnvVars,
 		IstioEnabled:                   isIstioActive,
 		IstioExcludePorts:              []int32{ports.Metrics},
+		ProxyEnvVars:                   proxy.GetEnvVars(ctx, r.Client, proxyOutputs(allPipelines), proxy.MakeEnvVars),
 		Replicas:                       r.getReplicaCountFromTelemetry(ctx),
 		ResourceRequirementsMultiplier: len(allPipelines),
 	}
 
-	if err := r.gatewayApplierDeleter.ApplyResources(
-		ctx,
+	applyCtx, applySpan := selftracing.Start(ctx, selftracing.SpanApplyResources)
+	err = r.gatewayApplierDeleter.ApplyResources(
+		applyCtx,
 		k8sutils.NewOwnerReferenceSetter(r.Client, pipeline),
 		opts,
-	); err != nil {
+	)
+	selftracing.End(applySpan, err)
+
+	if err != nil {
 		return fmt.Errorf("failed to apply gateway resources: %w", err)
 	}
 
+	commonstatus.RecordGatewayReplicas(r.config.GatewayName, opts.Replicas)
+
 	return nil
 }
 
-func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline, allPipelines []telemetryv1alpha1.MetricPipeline) error {
+func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline, allPipelines []telemetryv1alpha1.MetricPipeline, overrideConfig *overrides.Config) error {
 	isIstioActive := r.istioStatusChecker.IsIstioActive(ctx)
+
+	_, buildSpan := selftracing.Start(ctx, selftracing.SpanBuildConfig)
 	agentConfig := r.agentConfigBuilder.Build(allPipelines, agent.BuildOptions{
 		IstioEnabled:                isIstioActive,
 		IstioCertPath:               otelcollector.IstioCertPath,
 		InstrumentationScopeVersion: r.config.ModuleVersion,
 		AgentNamespace:              r.config.TelemetryNamespace,
 	})
+	buildSpan.End()
 
 	agentConfigYAML, err := yaml.Marshal(agentConfig)
 	if err != nil {
 		return fmt.Errorf("failed to marshal collector config: %w", err)
 	}
 
+	agentConfigYAML, err = config.ApplyMergePatch(agentConfigYAML, overrideConfig.CollectorPatches.MetricAgent)
+	if err != nil {
+		return fmt.Errorf("failed to patch collector config: %w", err)
+	}
+
 	allowedPorts := getAgentPorts()
 	if isIstioActive {
 		allowedPorts = append(allowedPorts, ports.IstioEnvoy)
 	}
 
-	if err := r.agentApplierDeleter.ApplyResources(
-		ctx,
+	applyCtx, applySpan := selftracing.Start(ctx, selftracing.SpanApplyResources)
+	err = r.agentApplierDeleter.ApplyResources(
+		applyCtx,
 		k8sutils.NewOwnerReferenceSetter(r.Client, pipeline),
 		otelcollector.AgentApplyOptions{
 			AllowedPorts:        allowedPorts,
 			CollectorConfigYAML: string(agentConfigYAML),
 		},
-	); err != nil {
+	)
+	selftracing.End(applySpan, err)
+
+	if err != nil {
 		return fmt.Errorf("failed to apply agent resources: %w", err)
 	}
 
 	return nil
 }
 
+// pendingTestData returns the names of the pipelines whose test data is pending, so that the gateway exports the test data only for those.
+func pendingTestData(pipelines []telemetryv1alpha1.MetricPipeline) map[string]bool {
+	pending := make(map[string]bool)
+
+	for i := range pipelines {
+		if conditions.IsTestDataPending(pipelines[i].Annotations, pipelines[i].Status.TestData, pipelines[i].Status.Conditions) {
+			pending[pipelines[i].Name] = true
+		}
+	}
+
+	return pending
+}
+
+// proxyOutputs returns the outputs of the pipelines, which are exported by the gateway.
+func proxyOutputs(pipelines []telemetryv1alpha1.MetricPipeline) []proxy.Output {
+	var outputs []proxy.Output
+
+	for i := range pipelines {
+		if output := pipelines[i].Spec.Output.Otlp; output != nil {
+			outputs = append(outputs, proxy.Output{PipelineName: pipelines[i].Name, Endpoint: output.Endpoint, Proxy: output.Proxy})
+		}
+	}
+
+	return outputs
+}
+
 func (r *Reconciler) getReplicaCountFromTelemetry(ctx context.Context) int32 {
 	var telemetries operatorv1alpha1.TelemetryList
 	if err := r.List(ctx, &telemetries); err != nil {
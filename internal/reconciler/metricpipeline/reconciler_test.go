@@ -19,6 +19,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
@@ -29,6 +30,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/metricpipeline/mocks"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/metricpipeline/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
+	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	"github.com/kyma-project/telemetry-manager/internal/testutils"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
@@ -70,6 +72,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -130,6 +133,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		errToMsg := &conditions.ErrorToMessageConverter{}
@@ -190,6 +194,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -257,6 +262,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(&workloadstatus.PodIsPendingError{Message: "Error"})
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -324,6 +330,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(workloadstatus.ErrDaemonSetNotFound)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -392,6 +399,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -461,6 +469,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -524,6 +533,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -589,6 +599,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -638,6 +649,81 @@ func TestReconcile(t *testing.T) {
 		gatewayConfigBuilderMock.AssertNotCalled(t, "Build", mock.Anything, mock.Anything)
 	})
 
+	t.Run("proxy settings from telemetry", func(t *testing.T) {
+		proxyScheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(proxyScheme))
+		require.NoError(t, telemetryv1alpha1.AddToScheme(proxyScheme))
+		require.NoError(t, operatorv1alpha1.AddToScheme(proxyScheme))
+
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				Proxy: &operatorv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"},
+			},
+		}
+		proxiedPipeline := testutils.NewMetricPipelineBuilder().WithName("proxied").WithOTLPOutput(testutils.OTLPEndpoint("https://proxied.example.com:4317")).Build()
+		directPipeline := testutils.NewMetricPipelineBuilder().WithName("direct").WithOTLPOutput(
+			testutils.OTLPEndpoint("https://direct.example.com:4317"),
+			testutils.OTLPProxy(&telemetryv1alpha1.OutputProxy{Disabled: true}),
+		).Build()
+		fakeClient := fake.NewClientBuilder().WithScheme(proxyScheme).WithObjects(telemetry, &proxiedPipeline, &directPipeline).WithStatusSubresource(&proxiedPipeline, &directPipeline).Build()
+
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+		var applyOpts otelcollector.GatewayApplyOptions
+
+		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			applyOpts = args.Get(2).(otelcollector.GatewayApplyOptions)
+		}).Return(nil)
+
+		pipelineLockStub := &mocks.PipelineLock{}
+		pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+		pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
+
+		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
+
+		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+		flowHealthProberStub.On("Probe", mock.Anything, mock.Anything).Return(prober.OTelPipelineProbeResult{}, nil)
+
+		pipelineValidatorWithStubs := &Validator{
+			EndpointValidator:  stubs.NewEndpointValidator(nil),
+			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
+		}
+
+		errToMsg := &conditions.ErrorToMessageConverter{}
+
+		sut := New(
+			fakeClient,
+			testConfig,
+			&mocks.AgentApplierDeleter{},
+			&mocks.AgentConfigBuilder{},
+			agentProberStub,
+			flowHealthProberStub,
+			gatewayApplierDeleterMock,
+			gatewayConfigBuilderMock,
+			gatewayProberStub,
+			istioStatusCheckerStub,
+			overridesHandlerStub,
+			pipelineLockStub,
+			pipelineValidatorWithStubs,
+			errToMsg,
+		)
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: proxiedPipeline.Name}})
+		require.NoError(t, err)
+
+		require.Equal(t, []corev1.EnvVar{
+			{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "localhost,127.0.0.1,.svc,.cluster.local,$(KUBERNETES_SERVICE_HOST),direct.example.com"},
+		}, applyOpts.ProxyEnvVars)
+	})
+
 	t.Run("flow healthy", func(t *testing.T) {
 		tests := []struct {
 			name            string
@@ -751,6 +837,7 @@ func TestReconcile(t *testing.T) {
 				agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, tt.probeErr)
 
 				pipelineValidatorWithStubs := &Validator{
@@ -795,6 +882,517 @@ func TestReconcile(t *testing.T) {
 		}
 	})
 
+	t.Run("flow healthy with agent inputs", func(t *testing.T) {
+		tests := []struct {
+			name            string
+			pipeline        telemetryv1alpha1.MetricPipeline
+			probe           prober.OTelPipelineProbeResult
+			expectedStatus  metav1.ConditionStatus
+			expectedReason  string
+			expectedMessage string
+		}{
+			{
+				name:     "agent export failing",
+				pipeline: testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build(),
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+					AgentExportFailing:  true,
+					AgentThrottling:     true,
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonAgentExportFailing,
+				expectedMessage: "Metric agent is unable to send metrics to the Metric gateway. Metrics of the runtime, prometheus, and istio inputs are dropped. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=agent-export-failing",
+			},
+			{
+				name:     "agent throttling",
+				pipeline: testutils.NewMetricPipelineBuilder().WithIstioInput(true).Build(),
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+					AgentThrottling:     true,
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonAgentThrottling,
+				expectedMessage: "Metric agent is unable to process metrics at current rate. Metrics of the runtime, prometheus, and istio inputs are refused. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=agent-throttling",
+			},
+			{
+				name:     "gateway problems shadow agent problems",
+				pipeline: testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build(),
+				probe: prober.OTelPipelineProbeResult{
+					Throttling:         true,
+					AgentExportFailing: true,
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonGatewayThrottling,
+				expectedMessage: "Metric gateway is unable to receive metrics at current rate. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=gateway-throttling",
+			},
+			{
+				name:     "scrape targets down",
+				pipeline: testutils.NewMetricPipelineBuilder().WithPrometheusInput(true, testutils.ExcludeNamespaces("kyma-system")).Build(),
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+					ScrapeTargetsDown:   map[string]int{"shop": 1, "default": 3, "kyma-system": 2},
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonScrapeTargetsDown,
+				expectedMessage: "Prometheus scrape targets are down: 3 in Namespace 'default', 1 in Namespace 'shop'. Metrics of these targets are missing. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=scrape-targets-down",
+			},
+			{
+				name:     "scrape targets down in Namespaces that are not collected",
+				pipeline: testutils.NewMetricPipelineBuilder().WithPrometheusInput(true, testutils.IncludeNamespaces("default")).Build(),
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+					ScrapeTargetsDown:   map[string]int{"shop": 1},
+				},
+				expectedStatus:  metav1.ConditionTrue,
+				expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+				expectedMessage: "No problems detected in the telemetry flow",
+			},
+			{
+				name:     "scrape targets down without prometheus input",
+				pipeline: testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build(),
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+					ScrapeTargetsDown:   map[string]int{"default": 1},
+				},
+				expectedStatus:  metav1.ConditionTrue,
+				expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+				expectedMessage: "No problems detected in the telemetry flow",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := tt.pipeline
+				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				agentConfigBuilderMock := &mocks.AgentConfigBuilder{}
+				agentConfigBuilderMock.On("Build", containsPipeline(pipeline), mock.Anything).Return(&agent.Config{}).Times(1)
+
+				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+				gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
+
+				agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+				agentApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				pipelineLockStub := &mocks.PipelineLock{}
+				pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+				pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
+
+				agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(nil),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsg := &conditions.ErrorToMessageConverter{}
+				sut := New(
+					fakeClient,
+					testConfig,
+					agentApplierDeleterMock,
+					agentConfigBuilderMock,
+					agentProberStub,
+					flowHealthProberStub,
+					gatewayApplierDeleterMock,
+					gatewayConfigBuilderMock,
+					gatewayProberStub,
+					istioStatusCheckerStub,
+					overridesHandlerStub,
+					pipelineLockStub,
+					pipelineValidatorWithStubs,
+					errToMsg,
+				)
+				_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.MetricPipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				requireHasStatusCondition(t, updatedPipeline,
+					conditions.TypeFlowHealthy,
+					tt.expectedStatus,
+					tt.expectedReason,
+					tt.expectedMessage,
+				)
+			})
+		}
+	})
+
+	t.Run("flow healthy with oauth2 authentication", func(t *testing.T) {
+		tests := []struct {
+			name            string
+			probe           prober.OTelPipelineProbeResult
+			tokenProbeErr   error
+			expectedStatus  metav1.ConditionStatus
+			expectedReason  string
+			expectedMessage string
+		}{
+			{
+				name: "all data dropped and token cannot be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+				},
+				tokenProbeErr:   prober.ErrOAuth2TokenFetchFailed,
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonOAuth2TokenFailed,
+				expectedMessage: "Metrics are dropped because no access token can be fetched from the OAuth2 token endpoint. Check the token URL and the client credentials of the output. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=oauth2-token-cannot-be-fetched",
+			},
+			{
+				name: "some data dropped and token cannot be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{SomeDataDropped: true},
+				},
+				tokenProbeErr:   prober.ErrOAuth2TokenFetchFailed,
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonOAuth2TokenFailed,
+				expectedMessage: "Metrics are dropped because no access token can be fetched from the OAuth2 token endpoint. Check the token URL and the client credentials of the output. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=oauth2-token-cannot-be-fetched",
+			},
+			{
+				name: "all data dropped and token can be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonAllDataDropped,
+				expectedMessage: "Backend is not reachable or rejecting metrics. All metrics are dropped. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/04-metrics?id=no-metrics-arrive-at-the-backend",
+			},
+			{
+				name: "healthy and token cannot be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+				},
+				tokenProbeErr:   prober.ErrOAuth2TokenFetchFailed,
+				expectedStatus:  metav1.ConditionTrue,
+				expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+				expectedMessage: "No problems detected in the telemetry flow",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := testutils.NewMetricPipelineBuilder().
+					WithOTLPOutput(testutils.OTLPOAuth2("https://auth.example.com/token", "client-id", "client-secret")).
+					Build()
+				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+				gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
+
+				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				pipelineLockStub := &mocks.PipelineLock{}
+				pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+				pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
+
+				agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(nil),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsg := &conditions.ErrorToMessageConverter{}
+
+				sut := New(
+					fakeClient,
+					testConfig,
+					&mocks.AgentApplierDeleter{},
+					&mocks.AgentConfigBuilder{},
+					agentProberStub,
+					flowHealthProberStub,
+					gatewayApplierDeleterMock,
+					gatewayConfigBuilderMock,
+					gatewayProberStub,
+					istioStatusCheckerStub,
+					overridesHandlerStub,
+					pipelineLockStub,
+					pipelineValidatorWithStubs,
+					errToMsg,
+				)
+				sut.oauth2TokenProber = commonStatusStubs.NewOAuth2TokenProber(tt.tokenProbeErr)
+
+				_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.MetricPipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				requireHasStatusCondition(t, updatedPipeline,
+					conditions.TypeFlowHealthy,
+					tt.expectedStatus,
+					tt.expectedReason,
+					tt.expectedMessage,
+				)
+			})
+		}
+	})
+
+	t.Run("output reachable", func(t *testing.T) {
+		probeScheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(probeScheme))
+		require.NoError(t, telemetryv1alpha1.AddToScheme(probeScheme))
+		require.NoError(t, operatorv1alpha1.AddToScheme(probeScheme))
+
+		tests := []struct {
+			name            string
+			secretRefErr    error
+			probeErr        error
+			expectedStatus  metav1.ConditionStatus
+			expectedReason  string
+			expectedMessage string
+		}{
+			{
+				name:            "endpoint reachable",
+				expectedStatus:  metav1.ConditionTrue,
+				expectedReason:  conditions.ReasonEndpointReachable,
+				expectedMessage: "Output endpoint is reachable",
+			},
+			{
+				name:            "host name cannot be resolved",
+				probeErr:        fmt.Errorf("%w: lookup backend.invalid: no such host", prober.ErrDNSResolutionFailed),
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonDNSResolutionFailed,
+				expectedMessage: "Host name of the output endpoint cannot be resolved: lookup backend.invalid: no such host",
+			},
+			{
+				name:            "certificate cannot be verified",
+				probeErr:        fmt.Errorf("%w: x509: certificate signed by unknown authority", prober.ErrTLSVerificationFailed),
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonTLSVerificationFailed,
+				expectedMessage: "Certificate of the output endpoint cannot be verified: x509: certificate signed by unknown authority",
+			},
+			{
+				name:            "configuration not generated",
+				secretRefErr:    fmt.Errorf("%w: Secret 'some-secret' of Namespace 'some-namespace'", secretref.ErrSecretRefNotFound),
+				expectedStatus:  metav1.ConditionUnknown,
+				expectedReason:  conditions.ReasonOutputNotProbed,
+				expectedMessage: "Output reachability is not probed because the pipeline configuration is not generated. Check the 'ConfigurationGenerated' condition for more details",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := testutils.NewMetricPipelineBuilder().Build()
+				fakeClient := fake.NewClientBuilder().WithScheme(probeScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+				gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				pipelineLockStub := &mocks.PipelineLock{}
+				pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+				pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+				agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+				agentApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything).Return(nil)
+
+				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
+
+				agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(tt.secretRefErr),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsg := &conditions.ErrorToMessageConverter{}
+
+				sut := New(
+					fakeClient,
+					testConfig,
+					agentApplierDeleterMock,
+					&mocks.AgentConfigBuilder{},
+					agentProberStub,
+					flowHealthProberStub,
+					gatewayApplierDeleterMock,
+					gatewayConfigBuilderMock,
+					gatewayProberStub,
+					istioStatusCheckerStub,
+					overridesHandlerStub,
+					pipelineLockStub,
+					pipelineValidatorWithStubs,
+					errToMsg,
+				)
+				sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(tt.probeErr)
+
+				_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.MetricPipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				requireHasStatusCondition(t, updatedPipeline,
+					conditions.TypeOutputReachable,
+					tt.expectedStatus,
+					tt.expectedReason,
+					tt.expectedMessage,
+				)
+			})
+		}
+	})
+
+	t.Run("test data delivered", func(t *testing.T) {
+		sentAt := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+
+		tests := []struct {
+			name               string
+			testData           *telemetryv1alpha1.TestDataStatus
+			currentReason      string
+			gatewayErr         error
+			sendErr            error
+			probeResult        prober.TestDataProbeResult
+			expectedStatus     metav1.ConditionStatus
+			expectedReason     string
+			expectTestDataSent bool
+		}{
+			{
+				name:           "new request waits for the rollout",
+				expectedStatus: metav1.ConditionUnknown,
+				expectedReason: conditions.ReasonTestDataRollingOut,
+			},
+			{
+				name:               "new request sent",
+				currentReason:      conditions.ReasonTestDataRollingOut,
+				expectedStatus:     metav1.ConditionUnknown,
+				expectedReason:     conditions.ReasonTestDataPending,
+				expectTestDataSent: true,
+			},
+			{
+				name:           "gateway not rolled out",
+				currentReason:  conditions.ReasonTestDataRollingOut,
+				gatewayErr:     &workloadstatus.RolloutInProgressError{},
+				expectedStatus: metav1.ConditionUnknown,
+				expectedReason: conditions.ReasonTestDataRollingOut,
+			},
+			{
+				name:           "sending failed",
+				currentReason:  conditions.ReasonTestDataRollingOut,
+				sendErr:        errors.New("connection refused"),
+				expectedStatus: metav1.ConditionFalse,
+				expectedReason: conditions.ReasonTestDataSendFailed,
+			},
+			{
+				name:               "pending request exported",
+				testData:           &telemetryv1alpha1.TestDataStatus{Request: "1", SentAt: sentAt},
+				probeResult:        prober.TestDataProbeResult{Exported: true},
+				expectedStatus:     metav1.ConditionTrue,
+				expectedReason:     conditions.ReasonTestDataExported,
+				expectTestDataSent: true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := testutils.NewMetricPipelineBuilder().
+					WithAnnotations(map[string]string{telemetryv1alpha1.AnnotationSendTestData: "1"}).
+					Build()
+				pipeline.Status.TestData = tt.testData
+
+				if tt.currentReason != "" {
+					pipeline.Status.Conditions = []metav1.Condition{{Type: conditions.TypeTestDataDelivered, Status: metav1.ConditionUnknown, Reason: tt.currentReason}}
+				}
+				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+				gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				pipelineLockStub := &mocks.PipelineLock{}
+				pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+				pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+				agentApplierDeleterMock := &mocks.AgentApplierDeleter{}
+				agentApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything).Return(nil)
+
+				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(tt.gatewayErr)
+
+				agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
+				flowHealthProberStub.On("ProbeTestData", mock.Anything, pipeline.Name, mock.Anything).Return(tt.probeResult, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(nil),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsg := &conditions.ErrorToMessageConverter{}
+
+				sut := New(
+					fakeClient,
+					testConfig,
+					agentApplierDeleterMock,
+					&mocks.AgentConfigBuilder{},
+					agentProberStub,
+					flowHealthProberStub,
+					gatewayApplierDeleterMock,
+					gatewayConfigBuilderMock,
+					gatewayProberStub,
+					istioStatusCheckerStub,
+					overridesHandlerStub,
+					pipelineLockStub,
+					pipelineValidatorWithStubs,
+					errToMsg,
+				)
+				sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(nil)
+				sut.testDataSender = commonStatusStubs.NewTestDataSender(tt.sendErr)
+
+				_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.MetricPipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				cond := meta.FindStatusCondition(updatedPipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+				require.NotNil(t, cond, "could not find condition of type %s", conditions.TypeTestDataDelivered)
+				require.Equal(t, tt.expectedStatus, cond.Status)
+				require.Equal(t, tt.expectedReason, cond.Reason)
+
+				if tt.expectTestDataSent {
+					require.NotNil(t, updatedPipeline.Status.TestData)
+					require.Equal(t, "1", updatedPipeline.Status.TestData.Request)
+				} else {
+					require.Nil(t, updatedPipeline.Status.TestData)
+				}
+			})
+		}
+	})
+
 	t.Run("tls conditions", func(t *testing.T) {
 		tests := []struct {
 			name                    string
@@ -894,6 +1492,7 @@ func TestReconcile(t *testing.T) {
 				agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 				pipelineValidatorWithStubs := &Validator{
@@ -978,6 +1577,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		serverErr := errors.New("failed to get secret: server error")
@@ -1049,6 +1649,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -1123,6 +1724,7 @@ func TestReconcile(t *testing.T) {
 		agentProberStub := commonStatusStubs.NewDaemonSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -1244,6 +1846,7 @@ func TestReconcile(t *testing.T) {
 				agentProberMock := commonStatusStubs.NewDaemonSetProber(tt.probeAgentErr)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 				pipelineLockStub := &mocks.PipelineLock{}
@@ -1314,3 +1917,48 @@ func containsPipeline(p telemetryv1alpha1.MetricPipeline) any {
 		return len(pipelines) == 1 && pipelines[0].Name == p.Name
 	})
 }
+
+func TestSetUnsupportedMode(t *testing.T) {
+	agentPatch := overrides.CollectorPatchesConfig{MetricAgent: map[string]any{"processors": nil}}
+	gatewayPatch := overrides.CollectorPatchesConfig{MetricGateway: map[string]any{"processors": nil}}
+
+	tests := []struct {
+		name     string
+		pipeline telemetryv1alpha1.MetricPipeline
+		patches  overrides.CollectorPatchesConfig
+		expected bool
+	}{
+		{
+			name:     "no patches",
+			pipeline: testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build(),
+			expected: false,
+		},
+		{
+			name:     "gateway patched",
+			pipeline: testutils.NewMetricPipelineBuilder().Build(),
+			patches:  gatewayPatch,
+			expected: true,
+		},
+		{
+			name:     "agent patched and used by pipeline",
+			pipeline: testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build(),
+			patches:  agentPatch,
+			expected: true,
+		},
+		{
+			name:     "agent patched but not used by pipeline",
+			pipeline: testutils.NewMetricPipelineBuilder().Build(),
+			patches:  agentPatch,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setUnsupportedMode(&tt.pipeline, &overrides.Config{CollectorPatches: tt.patches})
+
+			require.NotNil(t, tt.pipeline.Status.UnsupportedMode)
+			require.Equal(t, tt.expected, *tt.pipeline.Status.UnsupportedMode)
+		})
+	}
+}
@@ -4,24 +4,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/metric"
+	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
-func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) error {
+func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string, overrideConfig *overrides.Config) error {
 	var pipeline telemetryv1alpha1.MetricPipeline
 	if err := r.Get(ctx, types.NamespacedName{Name: pipelineName}, &pipeline); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -34,18 +42,32 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 
 	if pipeline.DeletionTimestamp != nil {
 		logf.FromContext(ctx).V(1).Info("Skipping status update for MetricPipeline - marked for deletion")
+		commonstatus.DeletePipelineMetrics(pipelineKind, pipeline.Name)
+
 		return nil
 	}
 
-	r.setAgentHealthyCondition(ctx, &pipeline)
-	r.setGatewayHealthyCondition(ctx, &pipeline)
-	r.setGatewayConfigGeneratedCondition(ctx, &pipeline)
-	r.setFlowHealthCondition(ctx, &pipeline)
+	oldConditions := slices.Clone(pipeline.Status.Conditions)
+
+	probeCtx, probeSpan := selftracing.Start(ctx, selftracing.SpanProbe)
+	r.setAgentHealthyCondition(probeCtx, &pipeline)
+	r.setGatewayHealthyCondition(probeCtx, &pipeline)
+	r.setGatewayConfigGeneratedCondition(probeCtx, &pipeline)
+	r.setFlowHealthCondition(probeCtx, &pipeline)
+	r.setOutputReachableCondition(probeCtx, &pipeline)
+	r.setTestDataCondition(probeCtx, &pipeline)
+	r.setStatistics(probeCtx, &pipeline)
+	probeSpan.End()
+
+	setUnsupportedMode(&pipeline, overrideConfig)
 
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		return fmt.Errorf("failed to update MetricPipeline status: %w", err)
 	}
 
+	commonstatus.RecordConditionEvents(r.config.EventRecorder, &pipeline, oldConditions, pipeline.Status.Conditions)
+	r.recordMetrics(ctx, &pipeline)
+
 	return nil
 }
 
@@ -120,39 +142,223 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 }
 
 func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) {
-	status, reason := r.evaluateFlowHealthCondition(ctx, pipeline)
+	status, reason, message := r.evaluateFlowHealthCondition(ctx, pipeline)
+	thresholds := commonstatus.GetSelfMonitorThresholds(ctx, r.Client)
 
 	condition := metav1.Condition{
 		Type:               conditions.TypeFlowHealthy,
 		Status:             status,
 		Reason:             reason,
-		Message:            conditions.MessageForMetricPipeline(reason),
+		Message:            commonstatus.FlowHealthMessage(message, reason, thresholds, commonstatus.SignalTypeMetrics),
 		ObservedGeneration: pipeline.Generation,
 	}
 
 	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
 }
 
-func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) (metav1.ConditionStatus, string) {
+func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) (metav1.ConditionStatus, string, string) {
 	configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
 	if configGeneratedStatus == metav1.ConditionFalse {
-		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated
+		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, conditions.MessageForMetricPipeline(conditions.ReasonSelfMonConfigNotGenerated)
 	}
 
 	probeResult, err := r.flowHealthProber.Probe(ctx, pipeline.Name)
 	if err != nil {
 		logf.FromContext(ctx).Error(err, "Failed to probe flow health")
-		return metav1.ConditionUnknown, conditions.ReasonSelfMonProbingFailed
+		return metav1.ConditionUnknown, conditions.ReasonSelfMonProbingFailed, conditions.MessageForMetricPipeline(conditions.ReasonSelfMonProbingFailed)
 	}
 
 	logf.FromContext(ctx).V(1).Info("Probed flow health", "result", probeResult)
 
 	reason := flowHealthReasonFor(probeResult)
-	if probeResult.Healthy {
-		return metav1.ConditionTrue, reason
+	if !probeResult.Healthy {
+		if (probeResult.AllDataDropped || probeResult.SomeDataDropped) && r.oauth2TokenFetchFailed(ctx, pipeline) {
+			reason = conditions.ReasonSelfMonOAuth2TokenFailed
+		}
+
+		return metav1.ConditionFalse, reason, conditions.MessageForMetricPipeline(reason)
+	}
+
+	// Problems of the agent are only reported if the gateway is healthy, because the agent exports to the gateway
+	if agentReason, message := agentFlowHealthReasonFor(probeResult, pipeline); agentReason != "" {
+		return metav1.ConditionFalse, agentReason, message
+	}
+
+	return metav1.ConditionTrue, reason, conditions.MessageForMetricPipeline(reason)
+}
+
+// agentFlowHealthReasonFor returns the reason and message for the problems of the metric agent that affect the given pipeline,
+// or an empty reason if the pipeline has no agent inputs or the agent has no problems.
+func agentFlowHealthReasonFor(probeResult prober.OTelPipelineProbeResult, pipeline *telemetryv1alpha1.MetricPipeline) (string, string) {
+	if !isMetricAgentRequired(pipeline) {
+		return "", ""
+	}
+
+	if probeResult.AgentExportFailing {
+		return conditions.ReasonSelfMonAgentExportFailing, conditions.MessageForMetricPipeline(conditions.ReasonSelfMonAgentExportFailing)
+	}
+
+	if probeResult.AgentThrottling {
+		return conditions.ReasonSelfMonAgentThrottling, conditions.MessageForMetricPipeline(conditions.ReasonSelfMonAgentThrottling)
+	}
+
+	if !metric.IsPrometheusInputEnabled(pipeline.Spec.Input) {
+		return "", ""
+	}
+
+	if targetsDown := formatScrapeTargetsDown(probeResult.ScrapeTargetsDown, pipeline.Spec.Input.Prometheus.Namespaces); targetsDown != "" {
+		return conditions.ReasonSelfMonScrapeTargetsDown, fmt.Sprintf(conditions.MessageForMetricPipeline(conditions.ReasonSelfMonScrapeTargetsDown), targetsDown)
+	}
+
+	return "", ""
+}
+
+// formatScrapeTargetsDown lists the number of scrape targets that are down in the Namespaces that the pipeline collects metrics from, sorted by Namespace.
+func formatScrapeTargetsDown(targetsDown map[string]int, namespaces *telemetryv1alpha1.MetricPipelineInputNamespaceSelector) string {
+	var parts []string
+
+	for _, namespace := range slices.Sorted(maps.Keys(targetsDown)) {
+		if !selectsNamespace(namespaces, namespace) {
+			continue
+		}
+
+		parts = append(parts, fmt.Sprintf("%d in Namespace '%s'", targetsDown[namespace], namespace))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func selectsNamespace(namespaces *telemetryv1alpha1.MetricPipelineInputNamespaceSelector, namespace string) bool {
+	if namespaces == nil {
+		return true
+	}
+
+	if len(namespaces.Include) > 0 {
+		return slices.Contains(namespaces.Include, namespace)
+	}
+
+	return !slices.Contains(namespaces.Exclude, namespace)
+}
+
+// oauth2TokenFetchFailed checks whether the data is dropped because the token endpoint of an output with OAuth2 authentication fails,
+// which the metrics of the gateway do not tell apart from failures of the backend.
+func (r *Reconciler) oauth2TokenFetchFailed(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) bool {
+	output := pipeline.Spec.Output.Otlp
+	if output == nil || output.Authentication == nil || !output.Authentication.OAuth2.IsDefined() {
+		return false
+	}
+
+	if err := r.oauth2TokenProber.Probe(ctx, pipeline.Name, output); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to fetch OAuth2 access token", "error", err.Error())
+		return errors.Is(err, prober.ErrOAuth2TokenFetchFailed)
 	}
 
-	return metav1.ConditionFalse, reason
+	return false
+}
+
+func (r *Reconciler) setOutputReachableCondition(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) {
+	status, reason, message := r.evaluateOutputReachableCondition(ctx, pipeline)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeOutputReachable,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: pipeline.Generation,
+	}
+
+	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+}
+
+func (r *Reconciler) evaluateOutputReachableCondition(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) (status metav1.ConditionStatus, reason string, message string) {
+	// The ConfigurationGenerated condition is already set, so the pipeline is not validated again
+	if meta.IsStatusConditionFalse(pipeline.Status.Conditions, conditions.TypeConfigurationGenerated) {
+		return metav1.ConditionUnknown, conditions.ReasonOutputNotProbed, conditions.MessageForMetricPipeline(conditions.ReasonOutputNotProbed)
+	}
+
+	var (
+		target *prober.OutputTarget
+		err    error
+	)
+
+	if pipeline.Spec.Output.Kafka != nil {
+		target, err = prober.KafkaOutputTarget(ctx, r.Client, pipeline.Spec.Output.Kafka)
+	} else {
+		target, err = prober.OTLPOutputTarget(ctx, r.Client, pipeline.Spec.Output.Otlp)
+	}
+
+	if err == nil {
+		err = r.outputReachabilityProber.Probe(ctx, pipeline.Name, target)
+	}
+
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("Output is not reachable", "error", err.Error())
+	}
+
+	return conditions.EvaluateOutputReachableCondition(err)
+}
+
+func (r *Reconciler) setStatistics(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) {
+	pipeline.Status.Statistics = commonstatus.GetStatistics(ctx, r.flowHealthProber, pipeline.Name, pipeline.Status.Statistics, time.Now())
+}
+
+func (r *Reconciler) setTestDataCondition(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) {
+	request := pipeline.Annotations[telemetryv1alpha1.AnnotationSendTestData]
+	if request == "" {
+		meta.RemoveStatusCondition(&pipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+		return
+	}
+
+	status, reason, message := r.evaluateTestDataCondition(ctx, pipeline, request)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeTestDataDelivered,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: pipeline.Generation,
+	}
+
+	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+}
+
+// evaluateTestDataCondition sends the test data for a new request, and probes the export result of a pending one.
+// The test data is sent again if sending fails, because the request is not recorded in the status then.
+func (r *Reconciler) evaluateTestDataCondition(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline, request string) (status metav1.ConditionStatus, reason string, message string) {
+	testData := pipeline.Status.TestData
+	current := meta.FindStatusCondition(pipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+
+	if testData == nil || testData.Request != request {
+		configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
+		if configGeneratedStatus == metav1.ConditionFalse {
+			return metav1.ConditionUnknown, conditions.ReasonTestDataNotSent, conditions.MessageForMetricPipeline(conditions.ReasonTestDataNotSent)
+		}
+
+		// The test data output is only rendered while the test data is pending, so the test data is sent in a later reconciliation, once the gateway with that output is rolled out
+		if !conditions.IsTestDataRolloutAwaited(current) || r.gatewayProber.IsReady(ctx, types.NamespacedName{Name: r.config.GatewayName, Namespace: r.config.TelemetryNamespace}) != nil {
+			return metav1.ConditionUnknown, conditions.ReasonTestDataRollingOut, conditions.MessageForMetricPipeline(conditions.ReasonTestDataRollingOut)
+		}
+
+		if err := r.testDataSender.Send(ctx, pipeline.Name, request); err != nil {
+			logf.FromContext(ctx).V(1).Info("Failed to send test data", "error", err.Error())
+			return metav1.ConditionFalse, conditions.ReasonTestDataSendFailed, fmt.Sprintf(conditions.MessageForMetricPipeline(conditions.ReasonTestDataSendFailed), err)
+		}
+
+		pipeline.Status.TestData = &telemetryv1alpha1.TestDataStatus{Request: request, SentAt: metav1.Now()}
+
+		return metav1.ConditionUnknown, conditions.ReasonTestDataPending, conditions.MessageForMetricPipeline(conditions.ReasonTestDataPending)
+	}
+
+	if current != nil && current.Reason != conditions.ReasonTestDataPending {
+		return current.Status, current.Reason, current.Message
+	}
+
+	result, err := r.flowHealthProber.ProbeTestData(ctx, pipeline.Name, testData.SentAt.Time)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to probe test data export")
+	}
+
+	return conditions.EvaluateTestDataCondition(result, err, testData.SentAt.Time, time.Now())
 }
 
 func flowHealthReasonFor(probeResult prober.OTelPipelineProbeResult) string {
@@ -174,3 +380,29 @@ func flowHealthReasonFor(probeResult prober.OTelPipelineProbeResult) string {
 
 	return conditions.ReasonSelfMonFlowHealthy
 }
+
+func (r *Reconciler) recordMetrics(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) {
+	commonstatus.RecordPipelineMetrics(commonstatus.PipelineMetrics{
+		Kind:            pipelineKind,
+		Name:            pipeline.Name,
+		Conditions:      pipeline.Status.Conditions,
+		OutputType:      outputType(pipeline),
+		UnsupportedMode: ptr.Deref(pipeline.Status.UnsupportedMode, false),
+		TLSCertExpiry:   r.pipelineValidator.tlsCertExpiry(ctx, pipeline),
+	})
+}
+
+// setUnsupportedMode marks the pipeline if the configuration of the metric gateway is patched, or if the configuration of the metric agent is patched and the pipeline uses the agent
+func setUnsupportedMode(pipeline *telemetryv1alpha1.MetricPipeline, overrideConfig *overrides.Config) {
+	patches := overrideConfig.CollectorPatches
+	unsupportedMode := len(patches.MetricGateway) > 0 || (len(patches.MetricAgent) > 0 && isMetricAgentRequired(pipeline))
+	pipeline.Status.UnsupportedMode = &unsupportedMode
+}
+
+func outputType(pipeline *telemetryv1alpha1.MetricPipeline) string {
+	if pipeline.Spec.Output.Kafka != nil {
+		return "kafka"
+	}
+
+	return "otlp"
+}
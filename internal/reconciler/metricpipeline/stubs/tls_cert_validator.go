@@ -2,6 +2,7 @@ package stubs
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 )
@@ -19,3 +20,7 @@ func NewTLSCertValidator(err error) *TLSCertValidator {
 func (t *TLSCertValidator) Validate(ctx context.Context, config tlscert.TLSBundle) error {
 	return t.err
 }
+
+func (t *TLSCertValidator) Expiry(ctx context.Context, config tlscert.TLSBundle) time.Time {
+	return time.Time{}
+}
@@ -2,8 +2,10 @@ package metricpipeline
 
 import (
 	"context"
+	"time"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 )
@@ -14,6 +16,7 @@ type EndpointValidator interface {
 
 type TLSCertValidator interface {
 	Validate(ctx context.Context, config tlscert.TLSBundle) error
+	Expiry(ctx context.Context, config tlscert.TLSBundle) time.Time
 }
 
 type SecretRefValidator interface {
@@ -38,14 +41,16 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1alpha1.Me
 		}
 	}
 
-	if tlsValidationRequired(pipeline) {
-		tlsConfig := tlscert.TLSBundle{
-			Cert: pipeline.Spec.Output.Otlp.TLS.Cert,
-			Key:  pipeline.Spec.Output.Otlp.TLS.Key,
-			CA:   pipeline.Spec.Output.Otlp.TLS.CA,
+	if pipeline.Spec.Output.Kafka != nil {
+		for _, broker := range pipeline.Spec.Output.Kafka.Brokers {
+			if err := v.EndpointValidator.Validate(ctx, &telemetryv1alpha1.ValueType{Value: broker}, endpoint.KafkaProtocolBroker); err != nil {
+				return err
+			}
 		}
+	}
 
-		if err := v.TLSCertValidator.Validate(ctx, tlsConfig); err != nil {
+	if tlsValidationRequired(pipeline) {
+		if err := v.TLSCertValidator.Validate(ctx, tlsBundle(pipeline)); err != nil {
 			return err
 		}
 	}
@@ -57,15 +62,45 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1alpha1.Me
 	return nil
 }
 
+// tlsCertExpiry returns the earliest expiry of the certificates configured for the output, or the zero time if there is none.
+func (v *Validator) tlsCertExpiry(ctx context.Context, pipeline *telemetryv1alpha1.MetricPipeline) time.Time {
+	if !tlsValidationRequired(pipeline) {
+		return time.Time{}
+	}
+
+	return v.TLSCertValidator.Expiry(ctx, tlsBundle(pipeline))
+}
+
+func tlsBundle(pipeline *telemetryv1alpha1.MetricPipeline) tlscert.TLSBundle {
+	tls := outputTLS(pipeline)
+
+	return tlscert.TLSBundle{
+		Cert:         tls.GetCert(),
+		Key:          tls.GetKey(),
+		CA:           tls.GetCA(),
+		MinVersion:   tls.MinVersion,
+		MaxVersion:   tls.MaxVersion,
+		CipherSuites: tls.CipherSuites,
+	}
+}
+
 func tlsValidationRequired(pipeline *telemetryv1alpha1.MetricPipeline) bool {
-	otlp := pipeline.Spec.Output.Otlp
-	if otlp == nil {
+	tls := outputTLS(pipeline)
+	if tls == nil {
 		return false
 	}
 
-	if otlp.TLS == nil {
-		return false
+	return tls.GetCert() != nil || tls.GetKey() != nil || tls.GetCA() != nil || tls.MinVersion != "" || tls.MaxVersion != "" || len(tls.CipherSuites) > 0
+}
+
+func outputTLS(pipeline *telemetryv1alpha1.MetricPipeline) *telemetryv1alpha1.OtlpTLS {
+	if pipeline.Spec.Output.Kafka != nil {
+		return pipeline.Spec.Output.Kafka.TLS
 	}
 
-	return otlp.TLS.Cert != nil || otlp.TLS.Key != nil || otlp.TLS.CA != nil
+	if pipeline.Spec.Output.Otlp != nil {
+		return pipeline.Spec.Output.Otlp.TLS
+	}
+
+	return nil
 }
@@ -0,0 +1,90 @@
+package namespacedpipeline
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelinelimits"
+)
+
+// NewLogReconciler creates a Reconciler that runs each NamespacedLogPipeline as a LogPipeline.
+func NewLogReconciler(client client.Client) *Reconciler {
+	return &Reconciler{
+		Client: client,
+		kind:   logKind{},
+	}
+}
+
+type logKind struct{}
+
+func (logKind) newNamespacedPipeline() client.Object {
+	return &telemetryv1alpha1.NamespacedLogPipeline{}
+}
+
+func (logKind) listNamespacedPipelines(ctx context.Context, c client.Reader, namespace string) ([]client.Object, error) {
+	var list telemetryv1alpha1.NamespacedLogPipelineList
+	if err := c.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	pipelines := make([]client.Object, 0, len(list.Items))
+	for i := range list.Items {
+		pipelines = append(pipelines, &list.Items[i])
+	}
+
+	return pipelines, nil
+}
+
+func (logKind) newPipeline() client.Object {
+	return &telemetryv1alpha1.LogPipeline{}
+}
+
+// buildPipeline restricts the LogPipeline to the application logs of the namespace of the NamespacedLogPipeline.
+// The defaults of the LogPipeline are set explicitly, so that the spec does not change when the API server applies them.
+func (logKind) buildPipeline(namespaced, pipeline client.Object) {
+	spec := namespaced.(*telemetryv1alpha1.NamespacedLogPipeline).Spec
+	logPipeline := pipeline.(*telemetryv1alpha1.LogPipeline)
+
+	logPipeline.Spec = telemetryv1alpha1.LogPipelineSpec{
+		Input: telemetryv1alpha1.Input{
+			Application: telemetryv1alpha1.ApplicationInput{
+				Enabled: ptr.To(true),
+				Namespaces: telemetryv1alpha1.InputNamespaces{
+					Include: []string{namespaced.GetNamespace()},
+				},
+				Containers:       *spec.Input.Containers.DeepCopy(),
+				KeepAnnotations:  spec.Input.KeepAnnotations,
+				DropLabels:       spec.Input.DropLabels,
+				KeepOriginalBody: ptr.To(true),
+			},
+		},
+		Output: telemetryv1alpha1.Output{
+			HTTP:          spec.Output.HTTP.DeepCopy(),
+			Loki:          spec.Output.Loki.DeepCopy(),
+			Elasticsearch: spec.Output.Elasticsearch.DeepCopy(),
+			Syslog:        spec.Output.Syslog.DeepCopy(),
+			GELF:          spec.Output.GELF.DeepCopy(),
+			Kafka:         spec.Output.Kafka.DeepCopy(),
+		},
+	}
+}
+
+func (logKind) secretRefs(pipeline client.Object) []telemetryv1alpha1.SecretKeyRef {
+	return pipeline.(*telemetryv1alpha1.LogPipeline).GetSecretRefs()
+}
+
+func (logKind) pipelineConditions(pipeline client.Object) []metav1.Condition {
+	return pipeline.(*telemetryv1alpha1.LogPipeline).Status.Conditions
+}
+
+func (logKind) status(namespaced client.Object) *telemetryv1alpha1.NamespacedPipelineStatus {
+	return &namespaced.(*telemetryv1alpha1.NamespacedLogPipeline).Status
+}
+
+func (logKind) maxPipelinesPerNamespace(ctx context.Context, c client.Reader) int {
+	return pipelinelimits.MaxLogPipelinesPerNamespace(ctx, c)
+}
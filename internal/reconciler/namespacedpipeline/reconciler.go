@@ -0,0 +1,292 @@
+package namespacedpipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+)
+
+// generatedPipelinePriority is the priority of the pipelines that run namespaced pipelines when the maximum number of pipelines in the cluster is reached.
+// It is lower than any priority that a cluster-scoped pipeline can have, so that namespaced pipelines never take the place of cluster-scoped pipelines.
+const generatedPipelinePriority int32 = -1
+
+// finalizer makes sure that the pipeline that runs a namespaced pipeline is deleted together with it.
+// Owner references cannot be used, because cluster-scoped resources cannot be owned by namespaced resources.
+const finalizer = "telemetry.kyma-project.io/namespaced-pipeline"
+
+var errNameConflict = errors.New("pipeline is not managed by the namespaced pipeline")
+
+// pipelineKind translates one kind of namespaced pipeline into the cluster-scoped pipeline that runs it.
+type pipelineKind interface {
+	newNamespacedPipeline() client.Object
+	listNamespacedPipelines(ctx context.Context, c client.Reader, namespace string) ([]client.Object, error)
+	newPipeline() client.Object
+	// buildPipeline replaces the spec of the pipeline with the spec that is derived from the namespaced pipeline.
+	buildPipeline(namespaced, pipeline client.Object)
+	secretRefs(pipeline client.Object) []telemetryv1alpha1.SecretKeyRef
+	pipelineConditions(pipeline client.Object) []metav1.Condition
+	status(namespaced client.Object) *telemetryv1alpha1.NamespacedPipelineStatus
+	maxPipelinesPerNamespace(ctx context.Context, c client.Reader) int
+}
+
+// Reconciler runs each namespaced pipeline as a cluster-scoped pipeline that only selects the data of its namespace.
+// The cluster-scoped pipelines are reconciled by the pipeline reconcilers like any other pipeline, so that they share the gateways and agents.
+type Reconciler struct {
+	client.Client
+
+	kind pipelineKind
+}
+
+// PipelineName returns the name of the cluster-scoped pipeline that runs a namespaced pipeline.
+// The name ends with the length of the Namespace, because namespace and name alone are ambiguous: "a-b"/"c" and "a"/"b-c" would both result in "a-b-c".
+// As the suffix contains no "-", it can always be told apart from the name, and the length then separates the Namespace from the name.
+func PipelineName(namespace, name string) string {
+	return fmt.Sprintf("%s-%s-%d", namespace, name, len(namespace))
+}
+
+// NamespacedPipeline returns the namespaced pipeline that a cluster-scoped pipeline runs, if any.
+func NamespacedPipeline(pipeline client.Object) (types.NamespacedName, bool) {
+	value, found := pipeline.GetAnnotations()[telemetryv1alpha1.AnnotationNamespacedPipeline]
+	if !found {
+		return types.NamespacedName{}, false
+	}
+
+	namespace, name, found := strings.Cut(value, "/")
+	if !found {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: name}, true
+}
+
+// PipelinePriority returns the priority of a cluster-scoped pipeline for the maximum number of pipelines in the cluster.
+// Pipelines that run namespaced pipelines have a lower priority than all other pipelines, whatever priority their spec defines.
+func PipelinePriority(pipeline client.Object, specPriority int32) int32 {
+	if _, found := NamespacedPipeline(pipeline); found {
+		return generatedPipelinePriority
+	}
+
+	return specPriority
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logf.FromContext(ctx).V(1).Info("Reconciling")
+
+	namespaced := r.kind.newNamespacedPipeline()
+	if err := r.Get(ctx, req.NamespacedName, namespaced); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !namespaced.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, r.cleanup(ctx, namespaced)
+	}
+
+	if !controllerutil.ContainsFinalizer(namespaced, finalizer) {
+		controllerutil.AddFinalizer(namespaced, finalizer)
+
+		if err := r.Update(ctx, namespaced); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	status, err := r.syncPipeline(ctx, namespaced)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, r.updateStatus(ctx, namespaced, status)
+}
+
+func (r *Reconciler) cleanup(ctx context.Context, namespaced client.Object) error {
+	if !controllerutil.ContainsFinalizer(namespaced, finalizer) {
+		return nil
+	}
+
+	if err := r.deletePipeline(ctx, namespaced); err != nil {
+		return err
+	}
+
+	controllerutil.RemoveFinalizer(namespaced, finalizer)
+
+	if err := r.Update(ctx, namespaced); err != nil {
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return nil
+}
+
+// syncPipeline creates or updates the pipeline that runs the namespaced pipeline and returns the resulting status of the namespaced pipeline.
+// If the namespaced pipeline must not run, its pipeline is deleted and the status explains why.
+func (r *Reconciler) syncPipeline(ctx context.Context, namespaced client.Object) (telemetryv1alpha1.NamespacedPipelineStatus, error) {
+	admitted, maxPipelines, err := r.isAdmitted(ctx, namespaced)
+	if err != nil {
+		return telemetryv1alpha1.NamespacedPipelineStatus{}, err
+	}
+
+	if !admitted {
+		return r.stopPipeline(ctx, namespaced, conditions.ReasonMaxPipelinesPerNamespaceExceeded,
+			fmt.Sprintf(conditions.MessageForNamespacedPipeline(conditions.ReasonMaxPipelinesPerNamespaceExceeded), maxPipelines))
+	}
+
+	desired := r.kind.newPipeline()
+	r.kind.buildPipeline(namespaced, desired)
+
+	for _, ref := range r.kind.secretRefs(desired) {
+		if ref.Namespace != namespaced.GetNamespace() {
+			return r.stopPipeline(ctx, namespaced, conditions.ReasonSecretNamespaceNotAllowed,
+				fmt.Sprintf(conditions.MessageForNamespacedPipeline(conditions.ReasonSecretNamespaceNotAllowed), ref.NamespacedName()))
+		}
+	}
+
+	pipeline := r.kind.newPipeline()
+	pipeline.SetName(PipelineName(namespaced.GetNamespace(), namespaced.GetName()))
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, pipeline, func() error {
+		if pipeline.GetResourceVersion() != "" && !isManagedBy(pipeline, namespaced) {
+			return errNameConflict
+		}
+
+		annotations := pipeline.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+
+		annotations[telemetryv1alpha1.AnnotationNamespacedPipeline] = client.ObjectKeyFromObject(namespaced).String()
+		pipeline.SetAnnotations(annotations)
+		r.kind.buildPipeline(namespaced, pipeline)
+
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, errNameConflict):
+		return notRunningStatus(namespaced, r.kind.status(namespaced), conditions.ReasonPipelineNameConflict,
+			fmt.Sprintf(conditions.MessageForNamespacedPipeline(conditions.ReasonPipelineNameConflict), pipeline.GetName())), nil
+	case apierrors.IsForbidden(err) || apierrors.IsInvalid(err):
+		// The pipeline is rejected by the API server, for example by the LogPipeline webhook if its configuration is invalid
+		return notRunningStatus(namespaced, r.kind.status(namespaced), conditions.ReasonPipelineNotCreated,
+			fmt.Sprintf(conditions.MessageForNamespacedPipeline(conditions.ReasonPipelineNotCreated), err)), nil
+	case err != nil:
+		return telemetryv1alpha1.NamespacedPipelineStatus{}, fmt.Errorf("failed to create or update pipeline: %w", err)
+	}
+
+	pipelineConditions := slices.Clone(r.kind.pipelineConditions(pipeline))
+	for i := range pipelineConditions {
+		pipelineConditions[i].ObservedGeneration = namespaced.GetGeneration()
+	}
+
+	return telemetryv1alpha1.NamespacedPipelineStatus{
+		PipelineName: pipeline.GetName(),
+		Conditions:   pipelineConditions,
+	}, nil
+}
+
+// isAdmitted checks whether the namespaced pipeline is within the maximum number of pipelines per namespace. The oldest pipelines of a namespace are admitted first.
+func (r *Reconciler) isAdmitted(ctx context.Context, namespaced client.Object) (bool, int, error) {
+	pipelines, err := r.kind.listNamespacedPipelines(ctx, r.Client, namespaced.GetNamespace())
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to list namespaced pipelines: %w", err)
+	}
+
+	pipelines = slices.DeleteFunc(pipelines, func(p client.Object) bool {
+		return !p.GetDeletionTimestamp().IsZero()
+	})
+
+	slices.SortFunc(pipelines, func(a, b client.Object) int {
+		if c := a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	maxPipelines := r.kind.maxPipelinesPerNamespace(ctx, r.Client)
+	index := slices.IndexFunc(pipelines, func(p client.Object) bool {
+		return p.GetName() == namespaced.GetName()
+	})
+
+	return index >= 0 && index < maxPipelines, maxPipelines, nil
+}
+
+func (r *Reconciler) stopPipeline(ctx context.Context, namespaced client.Object, reason, message string) (telemetryv1alpha1.NamespacedPipelineStatus, error) {
+	if err := r.deletePipeline(ctx, namespaced); err != nil {
+		return telemetryv1alpha1.NamespacedPipelineStatus{}, err
+	}
+
+	return notRunningStatus(namespaced, r.kind.status(namespaced), reason, message), nil
+}
+
+// deletePipeline deletes the pipeline of the namespaced pipeline. Pipelines with the same name that are not managed by the namespaced pipeline are kept.
+func (r *Reconciler) deletePipeline(ctx context.Context, namespaced client.Object) error {
+	pipeline := r.kind.newPipeline()
+	if err := r.Get(ctx, types.NamespacedName{Name: PipelineName(namespaced.GetNamespace(), namespaced.GetName())}, pipeline); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get pipeline: %w", err)
+	}
+
+	if !isManagedBy(pipeline, namespaced) {
+		return nil
+	}
+
+	if err := r.Delete(ctx, pipeline); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete pipeline: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, namespaced client.Object, status telemetryv1alpha1.NamespacedPipelineStatus) error {
+	current := r.kind.status(namespaced)
+	if apiequality.Semantic.DeepEqual(*current, status) {
+		return nil
+	}
+
+	*current = status
+
+	if err := r.Status().Update(ctx, namespaced); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// notRunningStatus describes why a namespaced pipeline does not run. The transition time of the condition is kept as long as the reason does not change.
+func notRunningStatus(namespaced client.Object, current *telemetryv1alpha1.NamespacedPipelineStatus, reason, message string) telemetryv1alpha1.NamespacedPipelineStatus {
+	var statusConditions []metav1.Condition
+	if previous := meta.FindStatusCondition(current.Conditions, conditions.TypeConfigurationGenerated); previous != nil {
+		statusConditions = append(statusConditions, *previous)
+	}
+
+	meta.SetStatusCondition(&statusConditions, metav1.Condition{
+		Type:               conditions.TypeConfigurationGenerated,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: namespaced.GetGeneration(),
+	})
+
+	return telemetryv1alpha1.NamespacedPipelineStatus{Conditions: statusConditions}
+}
+
+func isManagedBy(pipeline, namespaced client.Object) bool {
+	source, found := NamespacedPipeline(pipeline)
+	return found && source == client.ObjectKeyFromObject(namespaced)
+}
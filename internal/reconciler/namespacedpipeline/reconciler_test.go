@@ -0,0 +1,276 @@
+package namespacedpipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+)
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = telemetryv1alpha1.AddToScheme(scheme)
+	_ = operatorv1alpha1.AddToScheme(scheme)
+
+	return scheme
+}
+
+func newNamespacedTracePipeline(namespace, name string, created time.Time, secretNamespace string) *telemetryv1alpha1.NamespacedTracePipeline {
+	return &telemetryv1alpha1.NamespacedTracePipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(created),
+			Generation:        1,
+		},
+		Spec: telemetryv1alpha1.NamespacedTracePipelineSpec{
+			Output: telemetryv1alpha1.TracePipelineOutput{
+				Otlp: &telemetryv1alpha1.OtlpOutput{
+					Endpoint: telemetryv1alpha1.ValueType{
+						ValueFrom: &telemetryv1alpha1.ValueFromSource{
+							SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{Name: "backend", Namespace: secretNamespace, Key: "endpoint"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func reconcile(t *testing.T, sut *Reconciler, namespaced client.Object) {
+	t.Helper()
+
+	_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(namespaced)})
+	require.NoError(t, err)
+}
+
+func TestReconcileTrace(t *testing.T) {
+	created := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates restricted pipeline", func(t *testing.T) {
+		namespaced := newNamespacedTracePipeline("team-a", "backend", created, "team-a")
+		fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(namespaced).WithStatusSubresource(namespaced).Build()
+		sut := NewTraceReconciler(fakeClient)
+
+		reconcile(t, sut, namespaced)
+
+		var pipeline telemetryv1alpha1.TracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-a-backend-6"}, &pipeline))
+		require.Equal(t, "team-a/backend", pipeline.Annotations[telemetryv1alpha1.AnnotationNamespacedPipeline])
+		require.Equal(t, []string{"team-a"}, pipeline.Spec.Input.Otlp.Namespaces.Include)
+		require.Equal(t, namespaced.Spec.Output, pipeline.Spec.Output)
+
+		var updated telemetryv1alpha1.NamespacedTracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(namespaced), &updated))
+		require.Contains(t, updated.Finalizers, finalizer)
+		require.Equal(t, "team-a-backend-6", updated.Status.PipelineName)
+
+		namespacedName, found := NamespacedPipeline(&pipeline)
+		require.True(t, found)
+		require.Equal(t, client.ObjectKeyFromObject(namespaced), namespacedName)
+	})
+
+	t.Run("max pipelines per namespace exceeded", func(t *testing.T) {
+		oldest := newNamespacedTracePipeline("team-a", "oldest", created, "team-a")
+		newest := newNamespacedTracePipeline("team-a", "newest", created.Add(time.Minute), "team-a")
+		otherNamespace := newNamespacedTracePipeline("team-b", "newest", created.Add(time.Minute), "team-b")
+		fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).
+			WithObjects(oldest, newest, otherNamespace).
+			WithStatusSubresource(oldest, newest, otherNamespace).
+			Build()
+		sut := NewTraceReconciler(fakeClient)
+
+		reconcile(t, sut, newest)
+		reconcile(t, sut, otherNamespace)
+
+		var updated telemetryv1alpha1.NamespacedTracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(newest), &updated))
+		require.Empty(t, updated.Status.PipelineName)
+
+		condition := meta.FindStatusCondition(updated.Status.Conditions, conditions.TypeConfigurationGenerated)
+		require.NotNil(t, condition)
+		require.Equal(t, metav1.ConditionFalse, condition.Status)
+		require.Equal(t, conditions.ReasonMaxPipelinesPerNamespaceExceeded, condition.Reason)
+		require.Equal(t, "Maximum number of pipelines in the Namespace exceeded: 1", condition.Message)
+
+		var pipeline telemetryv1alpha1.TracePipeline
+		require.True(t, apierrors.IsNotFound(fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-a-newest-6"}, &pipeline)))
+		require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-b-newest-6"}, &pipeline))
+	})
+
+	t.Run("max pipelines per namespace configured", func(t *testing.T) {
+		oldest := newNamespacedTracePipeline("team-a", "oldest", created, "team-a")
+		newest := newNamespacedTracePipeline("team-a", "newest", created.Add(time.Minute), "team-a")
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				Trace: &operatorv1alpha1.TraceSpec{MaxPipelinesPerNamespace: ptr.To(int32(2))},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).
+			WithObjects(oldest, newest, telemetry).
+			WithStatusSubresource(oldest, newest).
+			Build()
+		sut := NewTraceReconciler(fakeClient)
+
+		reconcile(t, sut, newest)
+
+		var pipeline telemetryv1alpha1.TracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-a-newest-6"}, &pipeline))
+	})
+
+	t.Run("secret from other namespace", func(t *testing.T) {
+		namespaced := newNamespacedTracePipeline("team-a", "backend", created, "kyma-system")
+		fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(namespaced).WithStatusSubresource(namespaced).Build()
+		sut := NewTraceReconciler(fakeClient)
+
+		reconcile(t, sut, namespaced)
+
+		var updated telemetryv1alpha1.NamespacedTracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(namespaced), &updated))
+
+		condition := meta.FindStatusCondition(updated.Status.Conditions, conditions.TypeConfigurationGenerated)
+		require.NotNil(t, condition)
+		require.Equal(t, conditions.ReasonSecretNamespaceNotAllowed, condition.Reason)
+		require.Equal(t, "Secret kyma-system/backend must be in the Namespace of the pipeline", condition.Message)
+
+		var pipeline telemetryv1alpha1.TracePipeline
+		require.True(t, apierrors.IsNotFound(fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-a-backend-6"}, &pipeline)))
+	})
+
+	t.Run("pipeline name conflict", func(t *testing.T) {
+		namespaced := newNamespacedTracePipeline("team-a", "backend", created, "team-a")
+		existing := &telemetryv1alpha1.TracePipeline{ObjectMeta: metav1.ObjectMeta{Name: "team-a-backend-6"}}
+		fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(namespaced, existing).WithStatusSubresource(namespaced).Build()
+		sut := NewTraceReconciler(fakeClient)
+
+		reconcile(t, sut, namespaced)
+
+		var updated telemetryv1alpha1.NamespacedTracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(namespaced), &updated))
+
+		condition := meta.FindStatusCondition(updated.Status.Conditions, conditions.TypeConfigurationGenerated)
+		require.NotNil(t, condition)
+		require.Equal(t, conditions.ReasonPipelineNameConflict, condition.Reason)
+
+		var pipeline telemetryv1alpha1.TracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-a-backend-6"}, &pipeline))
+		require.Nil(t, pipeline.Spec.Output.Otlp)
+		require.Empty(t, pipeline.Annotations)
+	})
+
+	t.Run("ambiguous namespace and name", func(t *testing.T) {
+		first := newNamespacedTracePipeline("a-b", "c", created, "a-b")
+		second := newNamespacedTracePipeline("a", "b-c", created, "a")
+		fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(first, second).WithStatusSubresource(first, second).Build()
+		sut := NewTraceReconciler(fakeClient)
+
+		reconcile(t, sut, first)
+		reconcile(t, sut, second)
+
+		for _, namespaced := range []*telemetryv1alpha1.NamespacedTracePipeline{first, second} {
+			var updated telemetryv1alpha1.NamespacedTracePipeline
+			require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(namespaced), &updated))
+			require.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, conditions.TypeConfigurationGenerated))
+
+			var pipeline telemetryv1alpha1.TracePipeline
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: updated.Status.PipelineName}, &pipeline))
+			require.Equal(t, []string{namespaced.Namespace}, pipeline.Spec.Input.Otlp.Namespaces.Include)
+		}
+
+		require.NotEqual(t, PipelineName("a-b", "c"), PipelineName("a", "b-c"))
+	})
+
+	t.Run("deletes pipeline", func(t *testing.T) {
+		namespaced := newNamespacedTracePipeline("team-a", "backend", created, "team-a")
+		fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(namespaced).WithStatusSubresource(namespaced).Build()
+		sut := NewTraceReconciler(fakeClient)
+
+		reconcile(t, sut, namespaced)
+
+		var updated telemetryv1alpha1.NamespacedTracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(namespaced), &updated))
+		require.NoError(t, fakeClient.Delete(context.Background(), &updated))
+
+		reconcile(t, sut, namespaced)
+
+		var pipeline telemetryv1alpha1.TracePipeline
+		require.True(t, apierrors.IsNotFound(fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-a-backend-6"}, &pipeline)))
+		require.True(t, apierrors.IsNotFound(fakeClient.Get(context.Background(), client.ObjectKeyFromObject(namespaced), &updated)))
+	})
+}
+
+func TestReconcileLog(t *testing.T) {
+	namespaced := &telemetryv1alpha1.NamespacedLogPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "team-a"},
+		Spec: telemetryv1alpha1.NamespacedLogPipelineSpec{
+			Input: telemetryv1alpha1.NamespacedLogPipelineInput{
+				Containers: telemetryv1alpha1.InputContainers{Exclude: []string{"istio-proxy"}},
+				DropLabels: true,
+			},
+			Output: telemetryv1alpha1.NamespacedLogPipelineOutput{
+				HTTP: &telemetryv1alpha1.HTTPOutput{
+					Host: telemetryv1alpha1.ValueType{Value: "logs.example.com"},
+				},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(namespaced).WithStatusSubresource(namespaced).Build()
+	sut := NewLogReconciler(fakeClient)
+
+	reconcile(t, sut, namespaced)
+
+	var pipeline telemetryv1alpha1.LogPipeline
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "team-a-backend-6"}, &pipeline))
+	require.Equal(t, "team-a/backend", pipeline.Annotations[telemetryv1alpha1.AnnotationNamespacedPipeline])
+	require.Equal(t, []string{"team-a"}, pipeline.Spec.Input.Application.Namespaces.Include)
+	require.Equal(t, []string{"istio-proxy"}, pipeline.Spec.Input.Application.Containers.Exclude)
+	require.True(t, pipeline.Spec.Input.Application.DropLabels)
+	require.Equal(t, "logs.example.com", pipeline.Spec.Output.HTTP.Host.Value)
+	require.Empty(t, pipeline.Spec.Output.Custom)
+}
+
+func TestPipelinePriority(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    int32
+	}{
+		{
+			name:     "cluster-scoped pipeline",
+			expected: 5,
+		},
+		{
+			name:        "pipeline of a namespaced pipeline",
+			annotations: map[string]string{telemetryv1alpha1.AnnotationNamespacedPipeline: "team-a/backend"},
+			expected:    -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := &telemetryv1alpha1.TracePipeline{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend", Annotations: tt.annotations},
+				Spec:       telemetryv1alpha1.TracePipelineSpec{Priority: 5},
+			}
+
+			require.Equal(t, tt.expected, PipelinePriority(pipeline, pipeline.Spec.Priority))
+		})
+	}
+}
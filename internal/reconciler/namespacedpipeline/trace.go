@@ -0,0 +1,76 @@
+package namespacedpipeline
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelinelimits"
+)
+
+// NewTraceReconciler creates a Reconciler that runs each NamespacedTracePipeline as a TracePipeline.
+func NewTraceReconciler(client client.Client) *Reconciler {
+	return &Reconciler{
+		Client: client,
+		kind:   traceKind{},
+	}
+}
+
+type traceKind struct{}
+
+func (traceKind) newNamespacedPipeline() client.Object {
+	return &telemetryv1alpha1.NamespacedTracePipeline{}
+}
+
+func (traceKind) listNamespacedPipelines(ctx context.Context, c client.Reader, namespace string) ([]client.Object, error) {
+	var list telemetryv1alpha1.NamespacedTracePipelineList
+	if err := c.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	pipelines := make([]client.Object, 0, len(list.Items))
+	for i := range list.Items {
+		pipelines = append(pipelines, &list.Items[i])
+	}
+
+	return pipelines, nil
+}
+
+func (traceKind) newPipeline() client.Object {
+	return &telemetryv1alpha1.TracePipeline{}
+}
+
+// buildPipeline restricts the TracePipeline to the spans of the namespace of the NamespacedTracePipeline.
+func (traceKind) buildPipeline(namespaced, pipeline client.Object) {
+	namespacedPipeline := namespaced.(*telemetryv1alpha1.NamespacedTracePipeline)
+	tracePipeline := pipeline.(*telemetryv1alpha1.TracePipeline)
+
+	tracePipeline.Spec = telemetryv1alpha1.TracePipelineSpec{
+		Input: telemetryv1alpha1.TracePipelineInput{
+			Otlp: &telemetryv1alpha1.TracePipelineOtlpInput{
+				Namespaces: &telemetryv1alpha1.TracePipelineInputNamespaceSelector{
+					Include: []string{namespaced.GetNamespace()},
+				},
+			},
+		},
+		Output: *namespacedPipeline.Spec.Output.DeepCopy(),
+	}
+}
+
+func (traceKind) secretRefs(pipeline client.Object) []telemetryv1alpha1.SecretKeyRef {
+	return pipeline.(*telemetryv1alpha1.TracePipeline).GetSecretRefs()
+}
+
+func (traceKind) pipelineConditions(pipeline client.Object) []metav1.Condition {
+	return pipeline.(*telemetryv1alpha1.TracePipeline).Status.Conditions
+}
+
+func (traceKind) status(namespaced client.Object) *telemetryv1alpha1.NamespacedPipelineStatus {
+	return &namespaced.(*telemetryv1alpha1.NamespacedTracePipeline).Status
+}
+
+func (traceKind) maxPipelinesPerNamespace(ctx context.Context, c client.Reader) int {
+	return pipelinelimits.MaxTracePipelinesPerNamespace(ctx, c)
+}
@@ -0,0 +1,139 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+// alertTargetsResolver resolves the additional alert targets of the self monitor. The credentials are collected as Secret data,
+// and the targets refer to them as files in the directory that the Secret is mounted to.
+type alertTargetsResolver struct {
+	client     client.Reader
+	secretPath string
+	secretData map[string][]byte
+}
+
+// resolveAlertTargets returns the additional alert targets of the given Telemetry and the Secret data with their credentials.
+// A target whose credentials cannot be resolved is skipped, so that the Telemetry Manager keeps receiving the alerts.
+func resolveAlertTargets(ctx context.Context, c client.Reader, telemetry *operatorv1alpha1.Telemetry, secretPath string) ([]config.AlertTarget, map[string][]byte) {
+	if telemetry.Spec.SelfMonitor == nil || len(telemetry.Spec.SelfMonitor.AlertTargets) == 0 {
+		return nil, nil
+	}
+
+	var alertTargets []config.AlertTarget
+
+	resolver := alertTargetsResolver{
+		client:     c,
+		secretPath: secretPath,
+		secretData: make(map[string][]byte),
+	}
+
+	for i, target := range telemetry.Spec.SelfMonitor.AlertTargets {
+		alertTarget, err := resolver.resolve(ctx, target, fmt.Sprintf("%d-", i))
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Skipping alert target", "url", target.URL)
+			continue
+		}
+
+		alertTargets = append(alertTargets, alertTarget)
+	}
+
+	return alertTargets, resolver.secretData
+}
+
+func (r *alertTargetsResolver) resolve(ctx context.Context, target operatorv1alpha1.AlertTarget, keyPrefix string) (config.AlertTarget, error) {
+	u, err := url.Parse(target.URL)
+	if err != nil {
+		return config.AlertTarget{}, fmt.Errorf("failed to parse alert target URL: %w", err)
+	}
+
+	alertTarget := config.AlertTarget{
+		Scheme:     u.Scheme,
+		Host:       u.Host,
+		PathPrefix: strings.TrimSuffix(u.Path, "/"),
+	}
+
+	// Values are only added to the Secret data once the whole target is resolved
+	files := make(map[string][]byte)
+
+	if target.TLS != nil {
+		alertTarget.InsecureSkipVerify = target.TLS.InsecureSkipVerify
+
+		for _, field := range []struct {
+			value *telemetryv1alpha1.ValueType
+			key   string
+			dest  *string
+		}{
+			{target.TLS.CA, "ca.crt", &alertTarget.CAFile},
+			{target.TLS.Cert, "tls.crt", &alertTarget.CertFile},
+			{target.TLS.Key, "tls.key", &alertTarget.KeyFile},
+		} {
+			if !field.value.IsDefined() {
+				continue
+			}
+
+			if *field.dest, err = r.resolveFile(ctx, *field.value, keyPrefix+field.key, files); err != nil {
+				return config.AlertTarget{}, err
+			}
+		}
+	}
+
+	if target.BasicAuth.IsDefined() {
+		user, err := resolveValue(ctx, r.client, target.BasicAuth.User)
+		if err != nil {
+			return config.AlertTarget{}, err
+		}
+
+		alertTarget.Username = string(user)
+
+		if alertTarget.PasswordFile, err = r.resolveFile(ctx, target.BasicAuth.Password, keyPrefix+"password", files); err != nil {
+			return config.AlertTarget{}, err
+		}
+	}
+
+	if target.BearerToken.IsDefined() {
+		if alertTarget.BearerTokenFile, err = r.resolveFile(ctx, *target.BearerToken, keyPrefix+"token", files); err != nil {
+			return config.AlertTarget{}, err
+		}
+	}
+
+	for key, value := range files {
+		r.secretData[key] = value
+	}
+
+	return alertTarget, nil
+}
+
+func (r *alertTargetsResolver) resolveFile(ctx context.Context, value telemetryv1alpha1.ValueType, key string, files map[string][]byte) (string, error) {
+	resolved, err := resolveValue(ctx, r.client, value)
+	if err != nil {
+		return "", err
+	}
+
+	files[key] = resolved
+
+	return r.secretPath + key, nil
+}
+
+func resolveValue(ctx context.Context, c client.Reader, value telemetryv1alpha1.ValueType) ([]byte, error) {
+	if value.Value != "" {
+		return []byte(value.Value), nil
+	}
+
+	if value.ValueFrom == nil || !value.ValueFrom.IsSecretKeyRef() {
+		return nil, errors.New("value is not defined")
+	}
+
+	return secretref.GetValue(ctx, c, *value.ValueFrom.SecretKeyRef)
+}
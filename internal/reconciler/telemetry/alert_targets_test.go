@@ -0,0 +1,87 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
+)
+
+func TestResolveAlertTargets(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "alertmanager", Namespace: "default"},
+		Data: map[string][]byte{
+			"password": []byte("secret"),
+			"ca.crt":   []byte("ca"),
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	secretValue := func(key string) *telemetryv1alpha1.ValueType {
+		return &telemetryv1alpha1.ValueType{
+			ValueFrom: &telemetryv1alpha1.ValueFromSource{
+				SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{Name: "alertmanager", Namespace: "default", Key: key},
+			},
+		}
+	}
+
+	telemetry := &operatorv1alpha1.Telemetry{
+		Spec: operatorv1alpha1.TelemetrySpec{
+			SelfMonitor: &operatorv1alpha1.SelfMonitorSpec{
+				AlertTargets: []operatorv1alpha1.AlertTarget{
+					{
+						URL: "https://alertmanager.example.com:9093",
+						TLS: &operatorv1alpha1.AlertTargetTLS{CA: secretValue("ca.crt")},
+						BasicAuth: &telemetryv1alpha1.BasicAuthOptions{
+							User:     telemetryv1alpha1.ValueType{Value: "user"},
+							Password: *secretValue("password"),
+						},
+					},
+					{
+						URL:         "http://webhook.example.com/alerts/",
+						BearerToken: secretValue("missing"),
+					},
+					{
+						URL:         "http://other-webhook.example.com:8080",
+						BearerToken: &telemetryv1alpha1.ValueType{Value: "token"},
+					},
+				},
+			},
+		},
+	}
+
+	alertTargets, secretData := resolveAlertTargets(context.Background(), fakeClient, telemetry, "/alert-targets/")
+
+	require.Equal(t, []config.AlertTarget{
+		{
+			Scheme:       "https",
+			Host:         "alertmanager.example.com:9093",
+			CAFile:       "/alert-targets/0-ca.crt",
+			Username:     "user",
+			PasswordFile: "/alert-targets/0-password",
+		},
+		{
+			Scheme:          "http",
+			Host:            "other-webhook.example.com:8080",
+			BearerTokenFile: "/alert-targets/2-token",
+		},
+	}, alertTargets, "the target with the missing Secret key is skipped")
+	require.Equal(t, map[string][]byte{
+		"0-ca.crt":   []byte("ca"),
+		"0-password": []byte("secret"),
+		"2-token":    []byte("token"),
+	}, secretData)
+}
+
+func TestResolveAlertTargetsWithoutSelfMonitorSpec(t *testing.T) {
+	alertTargets, secretData := resolveAlertTargets(context.Background(), fake.NewClientBuilder().Build(), &operatorv1alpha1.Telemetry{}, "/alert-targets/")
+	require.Nil(t, alertTargets)
+	require.Nil(t, secretData)
+}
@@ -84,6 +84,31 @@ func TestLogComponentsCheck(t *testing.T) {
 				Message: "One or more referenced Secrets are missing",
 			},
 		},
+		{
+			name: "should not be healthy if max pipelines exceeded",
+			pipelines: []telemetryv1alpha1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithStatusCondition(healthyAgentCond).
+					WithStatusCondition(metav1.Condition{
+						Type:    conditions.TypeConfigurationGenerated,
+						Status:  metav1.ConditionFalse,
+						Reason:  conditions.ReasonMaxPipelinesExceeded,
+						Message: "Maximum pipeline count limit exceeded",
+					}).
+					Build(),
+				testutils.NewLogPipelineBuilder().
+					WithStatusCondition(healthyAgentCond).
+					WithStatusCondition(configGeneratedCond).
+					Build(),
+			},
+			telemetryInDeletion: false,
+			expectedCondition: &metav1.Condition{
+				Type:    conditions.TypeLogComponentsHealthy,
+				Status:  "False",
+				Reason:  "MaxPipelinesExceeded",
+				Message: "Maximum pipeline count limit exceeded",
+			},
+		},
 		{
 			name: "should not be healthy if one pipeline waiting for fluent bit",
 			pipelines: []telemetryv1alpha1.LogPipeline{
@@ -3,13 +3,16 @@ package telemetry
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v3"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -21,6 +24,8 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/resources/selfmonitor"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/webhook"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 	"github.com/kyma-project/telemetry-manager/internal/webhookcert"
 )
 
@@ -29,6 +34,9 @@ const (
 	selfMonitorConfigPath        = "/etc/prometheus/"
 	selfMonitorConfigFileName    = "prometheus.yml"
 	selfMonitorAlertRuleFileName = "alerting_rules.yml"
+	selfMonitorAlertTargetsPath  = "/etc/alert-targets/"
+	selfMonitorWebhookTokenPath  = "/etc/webhook-token/"
+	kind                         = "Telemetry"
 )
 
 type Config struct {
@@ -57,6 +65,8 @@ type SelfMonitorConfig struct {
 
 	WebhookURL    string
 	WebhookScheme string
+	// WebhookTokenSecretName is the Secret holding the token that authenticates the self-monitor to the webhook. It is created and rotated by the reconciler.
+	WebhookTokenSecretName types.NamespacedName
 }
 
 type healthCheckers struct {
@@ -104,19 +114,28 @@ func New(
 	}
 }
 
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	logf.FromContext(ctx).V(1).Info("Reconciling")
 
 	overrideConfig, err := r.overridesHandler.LoadOverrides(ctx)
 	if err != nil {
+		// The reconciliation stops, but the status still shows why the overrides are not applied
+		if statusErr := r.updateOverridesStatus(ctx, req.NamespacedName, nil, err); statusErr != nil {
+			return ctrl.Result{}, fmt.Errorf("failed while updating status: %w: %w", statusErr, err)
+		}
+
 		return ctrl.Result{}, err
 	}
 
 	if overrideConfig.Telemetry.Paused {
 		logf.FromContext(ctx).V(1).Info("Skipping reconciliation: paused using override config")
-		return ctrl.Result{}, nil
+		// A paused reconciliation must remain visible in the status, so that it is not forgotten
+		return ctrl.Result{}, r.updateOverridesStatus(ctx, req.NamespacedName, overrideConfig, nil)
 	}
 
+	ctx, span := selftracing.Start(ctx, selftracing.ReconcileSpanName(kind), attribute.String("name", req.Name))
+	defer func() { selftracing.End(span, err) }()
+
 	var telemetry operatorv1alpha1.Telemetry
 	if err := r.Client.Get(ctx, req.NamespacedName, &telemetry); err != nil {
 		logf.FromContext(ctx).Info(req.NamespacedName.String() + " got deleted!")
@@ -124,6 +143,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	err = r.doReconcile(ctx, &telemetry)
+	setOverridesCondition(&telemetry, overrideConfig, nil)
+
 	if statusErr := r.updateStatus(ctx, &telemetry); statusErr != nil {
 		if err != nil {
 			err = fmt.Errorf("failed while updating status: %w: %w", statusErr, err)
@@ -160,50 +181,101 @@ func (r *Reconciler) reconcileSelfMonitor(ctx context.Context, telemetry *operat
 	}
 
 	if !pipelinesPresent {
-		if err := r.selfMonitorApplierDeleter.DeleteResources(ctx, r.Client); err != nil {
+		deleteCtx, deleteSpan := selftracing.Start(ctx, selftracing.SpanDeleteResources)
+		err = r.selfMonitorApplierDeleter.DeleteResources(deleteCtx, r.Client)
+		selftracing.End(deleteSpan, err)
+
+		if err != nil {
 			return fmt.Errorf("failed to delete self-monitor resources: %w", err)
 		}
 
 		return nil
 	}
 
-	prometheusConfig := config.MakeConfig(config.BuilderConfig{
-		ScrapeNamespace:   r.config.SelfMonitor.Config.Namespace,
-		WebhookURL:        r.config.SelfMonitor.WebhookURL,
-		WebhookScheme:     r.config.SelfMonitor.WebhookScheme,
-		ConfigPath:        selfMonitorConfigPath,
-		AlertRuleFileName: selfMonitorAlertRuleFileName,
-	})
+	ownerRefSetter := k8sutils.NewOwnerReferenceSetter(r.Client, telemetry)
+
+	resolveCtx, resolveSpan := selftracing.Start(ctx, selftracing.SpanResolveSecrets)
+	alertTargets, alertTargetSecretData := resolveAlertTargets(resolveCtx, r.Client, telemetry, selfMonitorAlertTargetsPath)
+	webhookTokenFile, err := r.ensureWebhookToken(resolveCtx, ownerRefSetter)
+	selftracing.End(resolveSpan, err)
 
-	prometheusConfigYAML, err := yaml.Marshal(prometheusConfig)
 	if err != nil {
-		return fmt.Errorf("failed to marshal selfmonitor config: %w", err)
+		return err
 	}
 
-	alertRules := config.MakeRules()
+	_, buildSpan := selftracing.Start(ctx, selftracing.SpanBuildConfig)
+	prometheusConfigYAML, alertRulesYAML, err := r.buildSelfMonitorConfig(telemetry, webhookTokenFile, alertTargets)
+	selftracing.End(buildSpan, err)
 
-	alertRulesYAML, err := yaml.Marshal(alertRules)
 	if err != nil {
-		return fmt.Errorf("failed to marshal rules: %w", err)
+		return err
 	}
 
-	if err := r.selfMonitorApplierDeleter.ApplyResources(
-		ctx,
-		k8sutils.NewOwnerReferenceSetter(r.Client, telemetry),
+	applyCtx, applySpan := selftracing.Start(ctx, selftracing.SpanApplyResources)
+	err = r.selfMonitorApplierDeleter.ApplyResources(
+		applyCtx,
+		ownerRefSetter,
 		selfmonitor.ApplyOptions{
 			AlertRulesFileName:       selfMonitorAlertRuleFileName,
-			AlertRulesYAML:           string(alertRulesYAML),
+			AlertRulesYAML:           alertRulesYAML,
 			PrometheusConfigFileName: selfMonitorConfigFileName,
 			PrometheusConfigPath:     selfMonitorConfigPath,
-			PrometheusConfigYAML:     string(prometheusConfigYAML),
+			PrometheusConfigYAML:     prometheusConfigYAML,
+			AlertTargetSecretData:    alertTargetSecretData,
+			AlertTargetSecretPath:    selfMonitorAlertTargetsPath,
+			WebhookTokenSecretName:   r.config.SelfMonitor.WebhookTokenSecretName.Name,
+			WebhookTokenPath:         selfMonitorWebhookTokenPath,
 		},
-	); err != nil {
+	)
+	selftracing.End(applySpan, err)
+
+	if err != nil {
 		return fmt.Errorf("failed to apply self-monitor resources: %w", err)
 	}
 
 	return nil
 }
 
+// ensureWebhookToken creates or rotates the token that the self-monitor uses to call the webhook, and returns the path of the mounted token file.
+// If no token Secret is configured, the webhook is called without authentication and an empty path is returned.
+func (r *Reconciler) ensureWebhookToken(ctx context.Context, c client.Client) (string, error) {
+	if r.config.SelfMonitor.WebhookTokenSecretName.Name == "" {
+		return "", nil
+	}
+
+	if _, err := webhook.EnsureToken(ctx, c, r.config.SelfMonitor.WebhookTokenSecretName, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to ensure self-monitor webhook token: %w", err)
+	}
+
+	return selfMonitorWebhookTokenPath + webhook.TokenKey, nil
+}
+
+func (r *Reconciler) buildSelfMonitorConfig(telemetry *operatorv1alpha1.Telemetry, webhookTokenFile string, alertTargets []config.AlertTarget) (prometheusConfigYAML string, alertRulesYAML string, err error) {
+	prometheusConfig := config.MakeConfig(config.BuilderConfig{
+		ScrapeNamespace:   r.config.SelfMonitor.Config.Namespace,
+		WebhookURL:        r.config.SelfMonitor.WebhookURL,
+		WebhookScheme:     r.config.SelfMonitor.WebhookScheme,
+		WebhookTokenFile:  webhookTokenFile,
+		ConfigPath:        selfMonitorConfigPath,
+		AlertRuleFileName: selfMonitorAlertRuleFileName,
+		AlertTargets:      alertTargets,
+	})
+
+	prometheusConfigBytes, err := yaml.Marshal(prometheusConfig)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal selfmonitor config: %w", err)
+	}
+
+	alertRules := config.MakeRules(config.ThresholdsFor(telemetry.Spec.SelfMonitor))
+
+	alertRulesBytes, err := yaml.Marshal(alertRules)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal rules: %w", err)
+	}
+
+	return string(prometheusConfigBytes), string(alertRulesBytes), nil
+}
+
 func (r *Reconciler) checkPipelineExist(ctx context.Context) (bool, error) {
 	var allLogPipelines telemetryv1alpha1.LogPipelineList
 	if err := r.List(ctx, &allLogPipelines); err != nil {
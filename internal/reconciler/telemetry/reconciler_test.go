@@ -2,9 +2,12 @@ package telemetry
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -14,6 +17,7 @@ import (
 
 	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/telemetry/mocks"
 )
@@ -54,3 +58,135 @@ func TestReconcile(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestReconcileOverridesCondition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = operatorv1alpha1.AddToScheme(scheme)
+
+	tests := []struct {
+		name              string
+		overrides         *overrides.Config
+		loadErr           error
+		expectError       bool
+		expectedStatus    metav1.ConditionStatus
+		expectedReason    string
+		expectedMessage   string
+		expectedStateWarn bool
+	}{
+		{
+			name:            "reconciliation paused",
+			overrides:       &overrides.Config{Telemetry: overrides.TelemetryConfig{Paused: true}},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonOverridesActive,
+			expectedMessage: "Overrides are active: telemetry.paused",
+		},
+		{
+			name:              "reconciliation paused with unknown key",
+			overrides:         &overrides.Config{Telemetry: overrides.TelemetryConfig{Paused: true}, Warnings: []string{`unknown key "pause" in line 2`}},
+			expectedStatus:    metav1.ConditionFalse,
+			expectedReason:    conditions.ReasonOverridesPartiallyApplied,
+			expectedMessage:   `Some overrides are ignored: unknown key "pause" in line 2. Active overrides: telemetry.paused`,
+			expectedStateWarn: true,
+		},
+		{
+			name:              "overrides cannot be loaded",
+			loadErr:           errors.New("failed to load overrides config: yaml: line 2: mapping values are not allowed in this context"),
+			expectError:       true,
+			expectedStatus:    metav1.ConditionFalse,
+			expectedReason:    conditions.ReasonOverridesInvalid,
+			expectedMessage:   "Overrides are not applied: failed to load overrides config: yaml: line 2: mapping values are not allowed in this context",
+			expectedStateWarn: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telemetry := operatorv1alpha1.Telemetry{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&telemetry).WithStatusSubresource(&telemetry).Build()
+
+			overridesHandlerStub := &mocks.OverridesHandler{}
+			overridesHandlerStub.On("LoadOverrides", mock.Anything).Return(tt.overrides, tt.loadErr)
+
+			sut := Reconciler{
+				Client:           fakeClient,
+				overridesHandler: overridesHandlerStub,
+			}
+
+			_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "default", Namespace: "default"}})
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var updated operatorv1alpha1.Telemetry
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "default", Namespace: "default"}, &updated))
+
+			cond := meta.FindStatusCondition(updated.Status.Conditions, conditions.TypeOverridesApplied)
+			require.NotNil(t, cond)
+			require.Equal(t, tt.expectedStatus, cond.Status)
+			require.Equal(t, tt.expectedReason, cond.Reason)
+			require.Equal(t, tt.expectedMessage, cond.Message)
+
+			if tt.expectedStateWarn {
+				require.Equal(t, operatorv1alpha1.StateWarning, updated.Status.State)
+			} else {
+				require.Equal(t, operatorv1alpha1.StateReady, updated.Status.State)
+			}
+		})
+	}
+}
+
+func TestSetOverridesCondition(t *testing.T) {
+	tests := []struct {
+		name            string
+		overrides       *overrides.Config
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "no overrides",
+			overrides:       &overrides.Config{},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonNoOverrides,
+			expectedMessage: "No overrides are configured",
+		},
+		{
+			name: "active overrides",
+			overrides: &overrides.Config{
+				Global:    overrides.GlobalConfig{LogLevel: "debug"},
+				Pipelines: []overrides.PipelineConfig{{Kind: overrides.KindTracePipeline, Name: "backend", Paused: true}},
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  conditions.ReasonOverridesActive,
+			expectedMessage: "Overrides are active: global.logLevel=debug, TracePipeline backend (paused)",
+		},
+		{
+			name: "ignored overrides only",
+			overrides: &overrides.Config{
+				Warnings: []string{`unknown key "pause" in line 2`, "invalid override for MetricPipeline: name is missing"},
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonOverridesPartiallyApplied,
+			expectedMessage: `Some overrides are ignored: unknown key "pause" in line 2; invalid override for MetricPipeline: name is missing. Active overrides: none`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telemetry := operatorv1alpha1.Telemetry{ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 2}}
+
+			require.True(t, setOverridesCondition(&telemetry, tt.overrides, nil))
+			require.False(t, setOverridesCondition(&telemetry, tt.overrides, nil), "Should not change the condition if the overrides did not change")
+
+			cond := meta.FindStatusCondition(telemetry.Status.Conditions, conditions.TypeOverridesApplied)
+			require.NotNil(t, cond)
+			require.Equal(t, tt.expectedStatus, cond.Status)
+			require.Equal(t, tt.expectedReason, cond.Reason)
+			require.Equal(t, tt.expectedMessage, cond.Message)
+			require.Equal(t, int64(2), cond.ObservedGeneration)
+		})
+	}
+}
@@ -5,15 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 )
 
 type ComponentHealthChecker interface {
@@ -23,10 +27,12 @@ type ComponentHealthChecker interface {
 func (r *Reconciler) updateStatus(ctx context.Context, telemetry *operatorv1alpha1.Telemetry) error {
 	telemetryInDeletion := !telemetry.GetDeletionTimestamp().IsZero()
 
-	for _, checker := range r.enabledHealthCheckers() {
-		if err := r.updateComponentCondition(ctx, checker, telemetry, telemetryInDeletion); err != nil {
-			return fmt.Errorf("failed to update component condition: %w", err)
-		}
+	probeCtx, probeSpan := selftracing.Start(ctx, selftracing.SpanProbe)
+	err := r.updateComponentConditions(probeCtx, telemetry, telemetryInDeletion)
+	selftracing.End(probeSpan, err)
+
+	if err != nil {
+		return err
 	}
 
 	r.updateOverallState(ctx, telemetry, telemetryInDeletion)
@@ -42,10 +48,80 @@ func (r *Reconciler) updateStatus(ctx context.Context, telemetry *operatorv1alph
 	return nil
 }
 
+// updateOverridesStatus updates only the OverridesApplied condition and the overall state. It is used if the reconciliation stops before the whole status is updated.
+func (r *Reconciler) updateOverridesStatus(ctx context.Context, name types.NamespacedName, overrideConfig *overrides.Config, loadErr error) error {
+	var telemetry operatorv1alpha1.Telemetry
+	if err := r.Get(ctx, name, &telemetry); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if !setOverridesCondition(&telemetry, overrideConfig, loadErr) {
+		return nil
+	}
+
+	r.updateOverallState(ctx, &telemetry, !telemetry.GetDeletionTimestamp().IsZero())
+
+	if err := r.Status().Update(ctx, &telemetry); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// setOverridesCondition sets the OverridesApplied condition, which lists the active overrides and the ignored parts of the overrides config.
+// If the overrides cannot be loaded, loadErr is set instead of overrideConfig. It returns true if the condition changed.
+func setOverridesCondition(telemetry *operatorv1alpha1.Telemetry, overrideConfig *overrides.Config, loadErr error) bool {
+	condition := metav1.Condition{
+		Type:               conditions.TypeOverridesApplied,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: telemetry.GetGeneration(),
+	}
+
+	if loadErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = conditions.ReasonOverridesInvalid
+		condition.Message = fmt.Sprintf(conditions.MessageForOverrides(condition.Reason), loadErr.Error())
+
+		return meta.SetStatusCondition(&telemetry.Status.Conditions, condition)
+	}
+
+	activeOverrides := overrideConfig.ActiveOverrides()
+
+	switch {
+	case len(overrideConfig.Warnings) > 0:
+		active := "none"
+		if len(activeOverrides) > 0 {
+			active = strings.Join(activeOverrides, ", ")
+		}
+
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = conditions.ReasonOverridesPartiallyApplied
+		condition.Message = fmt.Sprintf(conditions.MessageForOverrides(condition.Reason), strings.Join(overrideConfig.Warnings, "; "), active)
+	case len(activeOverrides) > 0:
+		condition.Reason = conditions.ReasonOverridesActive
+		condition.Message = fmt.Sprintf(conditions.MessageForOverrides(condition.Reason), strings.Join(activeOverrides, ", "))
+	default:
+		condition.Reason = conditions.ReasonNoOverrides
+		condition.Message = conditions.MessageForOverrides(condition.Reason)
+	}
+
+	return meta.SetStatusCondition(&telemetry.Status.Conditions, condition)
+}
+
 func (r *Reconciler) enabledHealthCheckers() []ComponentHealthChecker {
 	return []ComponentHealthChecker{r.healthCheckers.logs, r.healthCheckers.metrics, r.healthCheckers.traces}
 }
 
+func (r *Reconciler) updateComponentConditions(ctx context.Context, telemetry *operatorv1alpha1.Telemetry, telemetryInDeletion bool) error {
+	for _, checker := range r.enabledHealthCheckers() {
+		if err := r.updateComponentCondition(ctx, checker, telemetry, telemetryInDeletion); err != nil {
+			return fmt.Errorf("failed to update component condition: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *Reconciler) updateComponentCondition(ctx context.Context, checker ComponentHealthChecker, telemetry *operatorv1alpha1.Telemetry, telemetryInDeletion bool) error {
 	newCondition, err := checker.Check(ctx, telemetryInDeletion)
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 
 	prober "github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // FlowHealthProber is an autogenerated mock type for the FlowHealthProber type
@@ -42,6 +44,62 @@ func (_m *FlowHealthProber) Probe(ctx context.Context, pipelineName string) (pro
 	return r0, r1
 }
 
+// ProbeStatistics provides a mock function with given fields: ctx, pipelineName
+func (_m *FlowHealthProber) ProbeStatistics(ctx context.Context, pipelineName string) (prober.StatisticsProbeResult, error) {
+	ret := _m.Called(ctx, pipelineName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProbeStatistics")
+	}
+
+	var r0 prober.StatisticsProbeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (prober.StatisticsProbeResult, error)); ok {
+		return rf(ctx, pipelineName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) prober.StatisticsProbeResult); ok {
+		r0 = rf(ctx, pipelineName)
+	} else {
+		r0 = ret.Get(0).(prober.StatisticsProbeResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, pipelineName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProbeTestData provides a mock function with given fields: ctx, pipelineName, sentAt
+func (_m *FlowHealthProber) ProbeTestData(ctx context.Context, pipelineName string, sentAt time.Time) (prober.TestDataProbeResult, error) {
+	ret := _m.Called(ctx, pipelineName, sentAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProbeTestData")
+	}
+
+	var r0 prober.TestDataProbeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (prober.TestDataProbeResult, error)); ok {
+		return rf(ctx, pipelineName, sentAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) prober.TestDataProbeResult); ok {
+		r0 = rf(ctx, pipelineName, sentAt)
+	} else {
+		r0 = ret.Get(0).(prober.TestDataProbeResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, pipelineName, sentAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewFlowHealthProber creates a new instance of FlowHealthProber. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFlowHealthProber(t interface {
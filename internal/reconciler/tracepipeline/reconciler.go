@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v3"
 	istiosecurityclientv1 "istio.io/client-go/pkg/apis/security/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -29,33 +31,46 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
 	"github.com/kyma-project/telemetry-manager/internal/k8sutils"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/otlpexporter"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/trace/gateway"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	"github.com/kyma-project/telemetry-manager/internal/overrides"
+	"github.com/kyma-project/telemetry-manager/internal/proxy"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 )
 
-const defaultReplicaCount int32 = 2
+const (
+	defaultReplicaCount int32 = 2
+	pipelineKind              = "TracePipeline"
+)
 
 type Config struct {
 	TraceGatewayName   string
+	OTLPServiceName    string
 	TelemetryNamespace string
+	// EventRecorder records Events on condition transitions of the pipelines. No Events are recorded if it is nil.
+	EventRecorder record.EventRecorder
 }
 
 type GatewayConfigBuilder interface {
-	Build(ctx context.Context, pipelines []telemetryv1alpha1.TracePipeline) (*gateway.Config, otlpexporter.EnvVars, error)
+	Build(ctx context.Context, pipelines []telemetryv1alpha1.TracePipeline, opts gateway.BuildOptions) (*gateway.Config, otlpexporter.EnvVars, error)
 }
 
 type GatewayApplierDeleter interface {
@@ -70,6 +85,20 @@ type PipelineLock interface {
 
 type FlowHealthProber interface {
 	Probe(ctx context.Context, pipelineName string) (prober.OTelPipelineProbeResult, error)
+	ProbeStatistics(ctx context.Context, pipelineName string) (prober.StatisticsProbeResult, error)
+	ProbeTestData(ctx context.Context, pipelineName string, sentAt time.Time) (prober.TestDataProbeResult, error)
+}
+
+type OAuth2TokenProber interface {
+	Probe(ctx context.Context, pipelineName string, output *telemetryv1alpha1.OtlpOutput) error
+}
+
+type OutputReachabilityProber interface {
+	Probe(ctx context.Context, pipelineName string, target *prober.OutputTarget) error
+}
+
+type TestDataSender interface {
+	Send(ctx context.Context, pipelineName, request string) error
 }
 
 type OverridesHandler interface {
@@ -86,15 +115,18 @@ type Reconciler struct {
 	config Config
 
 	// Dependencies
-	flowHealthProber      FlowHealthProber
-	gatewayApplierDeleter GatewayApplierDeleter
-	gatewayConfigBuilder  GatewayConfigBuilder
-	gatewayProber         commonstatus.DeploymentProber
-	istioStatusChecker    IstioStatusChecker
-	overridesHandler      OverridesHandler
-	pipelineLock          PipelineLock
-	pipelineValidator     *Validator
-	errToMsgConverter     commonstatus.ErrorToMessageConverter
+	flowHealthProber         FlowHealthProber
+	gatewayApplierDeleter    GatewayApplierDeleter
+	gatewayConfigBuilder     GatewayConfigBuilder
+	gatewayProber            commonstatus.DeploymentProber
+	istioStatusChecker       IstioStatusChecker
+	oauth2TokenProber        OAuth2TokenProber
+	outputReachabilityProber OutputReachabilityProber
+	overridesHandler         OverridesHandler
+	pipelineLock             PipelineLock
+	pipelineValidator        *Validator
+	testDataSender           TestDataSender
+	errToMsgConverter        commonstatus.ErrorToMessageConverter
 }
 
 func New(
@@ -111,21 +143,24 @@ func New(
 	errToMsgConverter commonstatus.ErrorToMessageConverter,
 ) *Reconciler {
 	return &Reconciler{
-		Client:                client,
-		config:                config,
-		flowHealthProber:      flowHealthProber,
-		gatewayApplierDeleter: gatewayApplierDeleter,
-		gatewayConfigBuilder:  gatewayConfigBuilder,
-		gatewayProber:         gatewayProber,
-		istioStatusChecker:    istioStatusChecker,
-		overridesHandler:      overridesHandler,
-		pipelineLock:          pipelineLock,
-		pipelineValidator:     pipelineValidator,
-		errToMsgConverter:     errToMsgConverter,
+		Client:                   client,
+		config:                   config,
+		flowHealthProber:         flowHealthProber,
+		gatewayApplierDeleter:    gatewayApplierDeleter,
+		gatewayConfigBuilder:     gatewayConfigBuilder,
+		gatewayProber:            gatewayProber,
+		istioStatusChecker:       istioStatusChecker,
+		oauth2TokenProber:        prober.NewOAuth2TokenProber(client),
+		outputReachabilityProber: prober.NewOutputReachabilityProber(),
+		overridesHandler:         overridesHandler,
+		pipelineLock:             pipelineLock,
+		pipelineValidator:        pipelineValidator,
+		testDataSender:           synthetic.NewTraceSender(types.NamespacedName{Name: config.OTLPServiceName, Namespace: config.TelemetryNamespace}),
+		errToMsgConverter:        errToMsgConverter,
 	}
 }
 
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	logf.FromContext(ctx).V(1).Info("Reconciling")
 
 	overrideConfig, err := r.overridesHandler.LoadOverrides(ctx)
@@ -138,6 +173,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	pipelineOverrides := overrideConfig.Pipeline(pipelineKind, req.Name)
+	if pipelineOverrides.Paused {
+		logf.FromContext(ctx).V(1).Info("Skipping reconciliation: pipeline paused using override config")
+		return ctrl.Result{}, nil
+	}
+
+	ctx = pipelineOverrides.ContextWithLogLevel(ctx)
+
+	ctx, span := selftracing.Start(ctx, selftracing.ReconcileSpanName(pipelineKind), attribute.String("name", req.Name))
+	defer func() { selftracing.End(span, err) }()
+
 	// TODO: Remove after next release on regular (1/2) (+ increase coverage threshold back to 74%)
 	if err := r.cleanUpOldTraceCollectorResources(ctx); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to clean up old trace collector resources: %w", err)
@@ -145,11 +191,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	var tracePipeline telemetryv1alpha1.TracePipeline
 	if err := r.Get(ctx, req.NamespacedName, &tracePipeline); err != nil {
+		if apierrors.IsNotFound(err) {
+			commonstatus.DeletePipelineMetrics(pipelineKind, req.Name)
+		}
+
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	err = r.doReconcile(ctx, &tracePipeline)
-	if statusErr := r.updateStatus(ctx, tracePipeline.Name); statusErr != nil {
+	err = r.doReconcile(ctx, &tracePipeline, overrideConfig)
+	if statusErr := r.updateStatus(ctx, tracePipeline.Name, overrideConfig); statusErr != nil {
 		if err != nil {
 			err = fmt.Errorf("failed while updating status: %w: %w", statusErr, err)
 		} else {
@@ -160,7 +210,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, err
 }
 
-func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) error {
+func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline, overrideConfig *overrides.Config) error {
 	if err := r.pipelineLock.TryAcquireLock(ctx, pipeline); err != nil {
 		return err
 	}
@@ -170,7 +220,10 @@ func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha
 		return fmt.Errorf("failed to list trace pipelines: %w", err)
 	}
 
-	reconcilablePipelines, err := r.getReconcilablePipelines(ctx, allPipelinesList.Items)
+	resolveCtx, resolveSpan := selftracing.Start(ctx, selftracing.SpanResolveSecrets)
+	reconcilablePipelines, err := r.getReconcilablePipelines(resolveCtx, allPipelinesList.Items)
+	selftracing.End(resolveSpan, err)
+
 	if err != nil {
 		return fmt.Errorf("failed to fetch deployable trace pipelines: %w", err)
 	}
@@ -178,14 +231,20 @@ func (r *Reconciler) doReconcile(ctx context.Context, pipeline *telemetryv1alpha
 	if len(reconcilablePipelines) == 0 {
 		logf.FromContext(ctx).V(1).Info("cleaning up trace pipeline resources: all trace pipelines are non-reconcilable")
 
-		if err = r.gatewayApplierDeleter.DeleteResources(ctx, r.Client, r.istioStatusChecker.IsIstioActive(ctx)); err != nil {
+		deleteCtx, deleteSpan := selftracing.Start(ctx, selftracing.SpanDeleteResources)
+		err = r.gatewayApplierDeleter.DeleteResources(deleteCtx, r.Client, r.istioStatusChecker.IsIstioActive(ctx))
+		selftracing.End(deleteSpan, err)
+
+		if err != nil {
 			return fmt.Errorf("failed to delete gateway resources: %w", err)
 		}
 
+		commonstatus.DeleteGatewayReplicas(r.config.TraceGatewayName)
+
 		return nil
 	}
 
-	if err = r.reconcileTraceGateway(ctx, pipeline, reconcilablePipelines); err != nil {
+	if err = r.reconcileTraceGateway(ctx, pipeline, reconcilablePipelines, overrideConfig); err != nil {
 		return fmt.Errorf("failed to reconcile trace gateway: %w", err)
 	}
 
@@ -232,8 +291,14 @@ func (r *Reconciler) isReconcilable(ctx context.Context, pipeline *telemetryv1al
 	return false, nil
 }
 
-func (r *Reconciler) reconcileTraceGateway(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline, allPipelines []telemetryv1alpha1.TracePipeline) error {
-	collectorConfig, collectorEnvVars, err := r.gatewayConfigBuilder.Build(ctx, allPipelines)
+func (r *Reconciler) reconcileTraceGateway(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline, allPipelines []telemetryv1alpha1.TracePipeline, overrideConfig *overrides.Config) error {
+	buildCtx, buildSpan := selftracing.Start(ctx, selftracing.SpanBuildConfig)
+	collectorConfig, collectorEnvVars, err := r.gatewayConfigBuilder.Build(buildCtx, allPipelines, gateway.BuildOptions{
+		PendingTestData:        pendingTestData(allPipelines),
+		DebugExporterVerbosity: overrideConfig.ExporterDebugLevels(pipelineKind),
+	})
+	selftracing.End(buildSpan, err)
+
 	if err != nil {
 		return fmt.Errorf("failed to create collector config: %w", err)
 	}
@@ -243,6 +308,11 @@ func (r *Reconciler) reconcileTraceGateway(ctx context.Context, pipeline *teleme
 		return fmt.Errorf("failed to marshal collector config: %w", err)
 	}
 
+	collectorConfigYAML, err = config.ApplyMergePatch(collectorConfigYAML, overrideConfig.CollectorPatches.TraceGateway)
+	if err != nil {
+		return fmt.Errorf("failed to patch collector config: %w", err)
+	}
+
 	isIstioActive := r.istioStatusChecker.IsIstioActive(ctx)
 
 	allowedPorts := []int32{
@@ -262,21 +332,54 @@ func (r *Reconciler) reconcileTraceGateway(ctx context.Context, pipeline *teleme
 		CollectorEnvVars:               collectorEnvVars,
 		IstioEnabled:                   isIstioActive,
 		IstioExcludePorts:              []int32{ports.Metrics},
+		ProxyEnvVars:                   proxy.GetEnvVars(ctx, r.Client, proxyOutputs(allPipelines), proxy.MakeEnvVars),
 		Replicas:                       r.getReplicaCountFromTelemetry(ctx),
 		ResourceRequirementsMultiplier: len(allPipelines),
 	}
 
-	if err := r.gatewayApplierDeleter.ApplyResources(
-		ctx,
+	applyCtx, applySpan := selftracing.Start(ctx, selftracing.SpanApplyResources)
+	err = r.gatewayApplierDeleter.ApplyResources(
+		applyCtx,
 		k8sutils.NewOwnerReferenceSetter(r.Client, pipeline),
 		opts,
-	); err != nil {
+	)
+	selftracing.End(applySpan, err)
+
+	if err != nil {
 		return fmt.Errorf("failed to apply gateway resources: %w", err)
 	}
 
+	commonstatus.RecordGatewayReplicas(r.config.TraceGatewayName, opts.Replicas)
+
 	return nil
 }
 
+// pendingTestData returns the names of the pipelines whose test data is pending, so that the gateway exports the test data only for those.
+func pendingTestData(pipelines []telemetryv1alpha1.TracePipeline) map[string]bool {
+	pending := make(map[string]bool)
+
+	for i := range pipelines {
+		if conditions.IsTestDataPending(pipelines[i].Annotations, pipelines[i].Status.TestData, pipelines[i].Status.Conditions) {
+			pending[pipelines[i].Name] = true
+		}
+	}
+
+	return pending
+}
+
+// proxyOutputs returns the outputs of the pipelines, which are exported by the gateway.
+func proxyOutputs(pipelines []telemetryv1alpha1.TracePipeline) []proxy.Output {
+	var outputs []proxy.Output
+
+	for i := range pipelines {
+		if output := pipelines[i].Spec.Output.Otlp; output != nil {
+			outputs = append(outputs, proxy.Output{PipelineName: pipelines[i].Name, Endpoint: output.Endpoint, Proxy: output.Proxy})
+		}
+	}
+
+	return outputs
+}
+
 func (r *Reconciler) getReplicaCountFromTelemetry(ctx context.Context) int32 {
 	var telemetries operatorv1alpha1.TelemetryList
 	if err := r.List(ctx, &telemetries); err != nil {
@@ -16,9 +16,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
@@ -28,6 +32,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/tracepipeline/mocks"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/tracepipeline/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
+	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	"github.com/kyma-project/telemetry-manager/internal/testutils"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
@@ -55,7 +60,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline)).Return(&gateway.Config{}, nil, nil).Times(1)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -67,6 +72,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(workloadstatus.ErrDeploymentFetching)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -107,12 +113,67 @@ func TestReconcile(t *testing.T) {
 		gatewayConfigBuilderMock.AssertExpectations(t)
 	})
 
+	t.Run("records events on condition transitions", func(t *testing.T) {
+		pipeline := testutils.NewTracePipelineBuilder().WithName("pipeline").Build()
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		pipelineLockStub := &mocks.PipelineLock{}
+		pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+		pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(workloadstatus.ErrDeploymentFetching)
+
+		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
+
+		pipelineValidatorWithStubs := &Validator{
+			EndpointValidator:  stubs.NewEndpointValidator(nil),
+			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
+		}
+
+		recorder := record.NewFakeRecorder(100)
+		configWithRecorder := testConfig
+		configWithRecorder.EventRecorder = recorder
+
+		sut := New(
+			fakeClient,
+			configWithRecorder,
+			flowHealthProberStub,
+			gatewayApplierDeleterMock,
+			gatewayConfigBuilderMock,
+			gatewayProberStub,
+			istioStatusCheckerStub,
+			overridesHandlerStub,
+			pipelineLockStub,
+			pipelineValidatorWithStubs,
+			&conditions.ErrorToMessageConverter{})
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+		require.NoError(t, err)
+
+		require.Contains(t, readEvents(recorder), "Warning GatewayNotReady GatewayHealthy: Failed to get Deployment")
+
+		// The conditions did not change, so no further events are recorded
+		_, err = sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+		require.NoError(t, err)
+
+		require.Empty(t, readEvents(recorder))
+	})
+
 	t.Run("trace gateway deployment is not ready", func(t *testing.T) {
 		pipeline := testutils.NewTracePipelineBuilder().WithName("pipeline").Build()
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline)).Return(&gateway.Config{}, nil, nil).Times(1)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -124,6 +185,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(&workloadstatus.PodIsPendingError{ContainerName: "foo", Message: "Error"})
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -169,7 +231,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline)).Return(&gateway.Config{}, nil, nil).Times(1)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -181,6 +243,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -225,7 +288,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -237,6 +300,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -299,7 +363,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline, secret).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline)).Return(&gateway.Config{}, nil, nil).Times(1)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -311,6 +375,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -355,7 +420,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
 
 		pipelineLockStub := &mocks.PipelineLock{}
 		pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(resourcelock.ErrMaxPipelinesExceeded)
@@ -364,6 +429,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -410,6 +476,75 @@ func TestReconcile(t *testing.T) {
 		gatewayConfigBuilderMock.AssertNotCalled(t, "Build", mock.Anything, mock.Anything)
 	})
 
+	t.Run("proxy settings from telemetry", func(t *testing.T) {
+		proxyScheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(proxyScheme))
+		require.NoError(t, telemetryv1alpha1.AddToScheme(proxyScheme))
+		require.NoError(t, operatorv1alpha1.AddToScheme(proxyScheme))
+
+		telemetry := &operatorv1alpha1.Telemetry{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"},
+			Spec: operatorv1alpha1.TelemetrySpec{
+				Proxy: &operatorv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"},
+			},
+		}
+		proxiedPipeline := testutils.NewTracePipelineBuilder().WithName("proxied").WithOTLPOutput(testutils.OTLPEndpoint("https://proxied.example.com:4317")).Build()
+		directPipeline := testutils.NewTracePipelineBuilder().WithName("direct").WithOTLPOutput(
+			testutils.OTLPEndpoint("https://direct.example.com:4317"),
+			testutils.OTLPProxy(&telemetryv1alpha1.OutputProxy{Disabled: true}),
+		).Build()
+		fakeClient := fake.NewClientBuilder().WithScheme(proxyScheme).WithObjects(telemetry, &proxiedPipeline, &directPipeline).WithStatusSubresource(&proxiedPipeline, &directPipeline).Build()
+
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+		var applyOpts otelcollector.GatewayApplyOptions
+
+		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			applyOpts = args.Get(2).(otelcollector.GatewayApplyOptions)
+		}).Return(nil)
+
+		pipelineLockStub := &mocks.PipelineLock{}
+		pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+		pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
+
+		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+		flowHealthProberStub.On("Probe", mock.Anything, mock.Anything).Return(prober.OTelPipelineProbeResult{}, nil)
+
+		pipelineValidatorWithStubs := &Validator{
+			EndpointValidator:  stubs.NewEndpointValidator(nil),
+			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
+		}
+
+		errToMsg := &conditions.ErrorToMessageConverter{}
+
+		sut := New(
+			fakeClient,
+			testConfig,
+			flowHealthProberStub,
+			gatewayApplierDeleterMock,
+			gatewayConfigBuilderMock,
+			gatewayProberStub,
+			istioStatusCheckerStub,
+			overridesHandlerStub,
+			pipelineLockStub,
+			pipelineValidatorWithStubs,
+			errToMsg)
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: proxiedPipeline.Name}})
+		require.NoError(t, err)
+
+		require.Equal(t, []corev1.EnvVar{
+			{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "localhost,127.0.0.1,.svc,.cluster.local,$(KUBERNETES_SERVICE_HOST),direct.example.com"},
+		}, applyOpts.ProxyEnvVars)
+	})
+
 	t.Run("flow healthy", func(t *testing.T) {
 		tests := []struct {
 			name            string
@@ -509,7 +644,7 @@ func TestReconcile(t *testing.T) {
 				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-				gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline)).Return(&gateway.Config{}, nil, nil).Times(1)
+				gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
 
 				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -521,6 +656,7 @@ func TestReconcile(t *testing.T) {
 				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, tt.probeErr)
 
 				pipelineValidatorWithStubs := &Validator{
@@ -562,6 +698,444 @@ func TestReconcile(t *testing.T) {
 		}
 	})
 
+	t.Run("flow healthy with oauth2 authentication", func(t *testing.T) {
+		tests := []struct {
+			name            string
+			probe           prober.OTelPipelineProbeResult
+			tokenProbeErr   error
+			expectedStatus  metav1.ConditionStatus
+			expectedReason  string
+			expectedMessage string
+		}{
+			{
+				name: "all data dropped and token cannot be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+				},
+				tokenProbeErr:   prober.ErrOAuth2TokenFetchFailed,
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonOAuth2TokenFailed,
+				expectedMessage: "Spans are dropped because no access token can be fetched from the OAuth2 token endpoint. Check the token URL and the client credentials of the output. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=oauth2-token-cannot-be-fetched",
+			},
+			{
+				name: "some data dropped and token cannot be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{SomeDataDropped: true},
+				},
+				tokenProbeErr:   prober.ErrOAuth2TokenFetchFailed,
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonOAuth2TokenFailed,
+				expectedMessage: "Spans are dropped because no access token can be fetched from the OAuth2 token endpoint. Check the token URL and the client credentials of the output. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=oauth2-token-cannot-be-fetched",
+			},
+			{
+				name: "all data dropped and token can be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+				},
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonSelfMonAllDataDropped,
+				expectedMessage: "Backend is not reachable or rejecting spans. All spans are dropped. See troubleshooting: https://kyma-project.io/#/telemetry-manager/user/03-traces?id=no-spans-arrive-at-the-backend",
+			},
+			{
+				name: "healthy and token cannot be fetched",
+				probe: prober.OTelPipelineProbeResult{
+					PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+				},
+				tokenProbeErr:   prober.ErrOAuth2TokenFetchFailed,
+				expectedStatus:  metav1.ConditionTrue,
+				expectedReason:  conditions.ReasonSelfMonFlowHealthy,
+				expectedMessage: "No problems detected in the telemetry flow",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := testutils.NewTracePipelineBuilder().
+					WithOTLPOutput(testutils.OTLPOAuth2("https://auth.example.com/token", "client-id", "client-secret")).
+					Build()
+				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+				gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
+
+				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				pipelineLockStub := &mocks.PipelineLock{}
+				pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+				pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(nil),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsg := &conditions.ErrorToMessageConverter{}
+
+				sut := New(
+					fakeClient,
+					testConfig,
+					flowHealthProberStub,
+					gatewayApplierDeleterMock,
+					gatewayConfigBuilderMock,
+					gatewayProberStub,
+					istioStatusCheckerStub,
+					overridesHandlerStub,
+					pipelineLockStub,
+					pipelineValidatorWithStubs,
+					errToMsg)
+				sut.oauth2TokenProber = commonStatusStubs.NewOAuth2TokenProber(tt.tokenProbeErr)
+
+				_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.TracePipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				requireHasStatusCondition(t, updatedPipeline,
+					conditions.TypeFlowHealthy,
+					tt.expectedStatus,
+					tt.expectedReason,
+					tt.expectedMessage,
+				)
+			})
+		}
+	})
+
+	t.Run("output reachable", func(t *testing.T) {
+		probeScheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(probeScheme))
+		require.NoError(t, telemetryv1alpha1.AddToScheme(probeScheme))
+		require.NoError(t, operatorv1alpha1.AddToScheme(probeScheme))
+
+		tests := []struct {
+			name            string
+			secretRefErr    error
+			probeErr        error
+			expectedStatus  metav1.ConditionStatus
+			expectedReason  string
+			expectedMessage string
+		}{
+			{
+				name:            "endpoint reachable",
+				expectedStatus:  metav1.ConditionTrue,
+				expectedReason:  conditions.ReasonEndpointReachable,
+				expectedMessage: "Output endpoint is reachable",
+			},
+			{
+				name:            "host name cannot be resolved",
+				probeErr:        fmt.Errorf("%w: lookup backend.invalid: no such host", prober.ErrDNSResolutionFailed),
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonDNSResolutionFailed,
+				expectedMessage: "Host name of the output endpoint cannot be resolved: lookup backend.invalid: no such host",
+			},
+			{
+				name:            "certificate cannot be verified",
+				probeErr:        fmt.Errorf("%w: x509: certificate signed by unknown authority", prober.ErrTLSVerificationFailed),
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonTLSVerificationFailed,
+				expectedMessage: "Certificate of the output endpoint cannot be verified: x509: certificate signed by unknown authority",
+			},
+			{
+				name:            "configuration not generated",
+				secretRefErr:    fmt.Errorf("%w: Secret 'some-secret' of Namespace 'some-namespace'", secretref.ErrSecretRefNotFound),
+				expectedStatus:  metav1.ConditionUnknown,
+				expectedReason:  conditions.ReasonOutputNotProbed,
+				expectedMessage: "Output reachability is not probed because the pipeline configuration is not generated. Check the 'ConfigurationGenerated' condition for more details",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := testutils.NewTracePipelineBuilder().Build()
+				fakeClient := fake.NewClientBuilder().WithScheme(probeScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+				gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				pipelineLockStub := &mocks.PipelineLock{}
+				pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+				pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(tt.secretRefErr),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsg := &conditions.ErrorToMessageConverter{}
+
+				sut := New(
+					fakeClient,
+					testConfig,
+					flowHealthProberStub,
+					gatewayApplierDeleterMock,
+					gatewayConfigBuilderMock,
+					gatewayProberStub,
+					istioStatusCheckerStub,
+					overridesHandlerStub,
+					pipelineLockStub,
+					pipelineValidatorWithStubs,
+					errToMsg)
+				sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(tt.probeErr)
+
+				_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.TracePipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				requireHasStatusCondition(t, updatedPipeline,
+					conditions.TypeOutputReachable,
+					tt.expectedStatus,
+					tt.expectedReason,
+					tt.expectedMessage,
+				)
+			})
+		}
+	})
+
+	t.Run("test data delivered", func(t *testing.T) {
+		sentAt := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+
+		tests := []struct {
+			name               string
+			testData           *telemetryv1alpha1.TestDataStatus
+			currentReason      string
+			secretRefErr       error
+			gatewayErr         error
+			sendErr            error
+			probeResult        prober.TestDataProbeResult
+			expectedStatus     metav1.ConditionStatus
+			expectedReason     string
+			expectedMessage    string
+			expectTestDataSent bool
+		}{
+			{
+				name:            "new request waits for the rollout",
+				expectedStatus:  metav1.ConditionUnknown,
+				expectedReason:  conditions.ReasonTestDataRollingOut,
+				expectedMessage: "Test data is sent once the pipeline configuration with the test data output is rolled out",
+			},
+			{
+				name:               "new request sent",
+				currentReason:      conditions.ReasonTestDataRollingOut,
+				expectedStatus:     metav1.ConditionUnknown,
+				expectedReason:     conditions.ReasonTestDataPending,
+				expectedMessage:    "Test data was sent, waiting for the export result",
+				expectTestDataSent: true,
+			},
+			{
+				name:           "gateway not rolled out",
+				currentReason:  conditions.ReasonTestDataRollingOut,
+				gatewayErr:     &workloadstatus.RolloutInProgressError{},
+				expectedStatus: metav1.ConditionUnknown,
+				expectedReason: conditions.ReasonTestDataRollingOut,
+			},
+			{
+				name:            "sending failed",
+				currentReason:   conditions.ReasonTestDataRollingOut,
+				sendErr:         errors.New("connection refused"),
+				expectedStatus:  metav1.ConditionFalse,
+				expectedReason:  conditions.ReasonTestDataSendFailed,
+				expectedMessage: "Test data cannot be sent: connection refused",
+			},
+			{
+				name:               "failed sending retried",
+				currentReason:      conditions.ReasonTestDataSendFailed,
+				expectedStatus:     metav1.ConditionUnknown,
+				expectedReason:     conditions.ReasonTestDataPending,
+				expectTestDataSent: true,
+			},
+			{
+				name:            "configuration not generated",
+				secretRefErr:    fmt.Errorf("%w: Secret 'some-secret' of Namespace 'some-namespace'", secretref.ErrSecretRefNotFound),
+				expectedStatus:  metav1.ConditionUnknown,
+				expectedReason:  conditions.ReasonTestDataNotSent,
+				expectedMessage: "Test data is not sent because the pipeline configuration is not generated. Check the 'ConfigurationGenerated' condition for more details",
+			},
+			{
+				name:               "pending request exported",
+				testData:           &telemetryv1alpha1.TestDataStatus{Request: "1", SentAt: sentAt},
+				probeResult:        prober.TestDataProbeResult{Exported: true},
+				expectedStatus:     metav1.ConditionTrue,
+				expectedReason:     conditions.ReasonTestDataExported,
+				expectTestDataSent: true,
+			},
+			{
+				name:               "pending request failed",
+				testData:           &telemetryv1alpha1.TestDataStatus{Request: "1", SentAt: sentAt},
+				probeResult:        prober.TestDataProbeResult{ExportFailed: true},
+				expectedStatus:     metav1.ConditionFalse,
+				expectedReason:     conditions.ReasonTestDataExportFailed,
+				expectTestDataSent: true,
+			},
+			{
+				name:           "previous request replaced",
+				testData:       &telemetryv1alpha1.TestDataStatus{Request: "0", SentAt: sentAt},
+				currentReason:  conditions.ReasonTestDataExported,
+				expectedStatus: metav1.ConditionUnknown,
+				expectedReason: conditions.ReasonTestDataRollingOut,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pipeline := testutils.NewTracePipelineBuilder().
+					WithAnnotations(map[string]string{telemetryv1alpha1.AnnotationSendTestData: "1"}).
+					Build()
+				pipeline.Status.TestData = tt.testData
+
+				if tt.currentReason != "" {
+					pipeline.Status.Conditions = []metav1.Condition{{Type: conditions.TypeTestDataDelivered, Status: metav1.ConditionUnknown, Reason: tt.currentReason}}
+				}
+				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+				gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+				pipelineLockStub := &mocks.PipelineLock{}
+				pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+				pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(tt.gatewayErr)
+
+				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
+				flowHealthProberStub.On("ProbeTestData", mock.Anything, pipeline.Name, mock.Anything).Return(tt.probeResult, nil)
+
+				pipelineValidatorWithStubs := &Validator{
+					EndpointValidator:  stubs.NewEndpointValidator(nil),
+					TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+					SecretRefValidator: stubs.NewSecretRefValidator(tt.secretRefErr),
+					PipelineLock:       pipelineLockStub,
+				}
+
+				errToMsg := &conditions.ErrorToMessageConverter{}
+
+				sut := New(
+					fakeClient,
+					testConfig,
+					flowHealthProberStub,
+					gatewayApplierDeleterMock,
+					gatewayConfigBuilderMock,
+					gatewayProberStub,
+					istioStatusCheckerStub,
+					overridesHandlerStub,
+					pipelineLockStub,
+					pipelineValidatorWithStubs,
+					errToMsg)
+				sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(nil)
+				sut.testDataSender = commonStatusStubs.NewTestDataSender(tt.sendErr)
+
+				_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+				require.NoError(t, err)
+
+				var updatedPipeline telemetryv1alpha1.TracePipeline
+				_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+				cond := meta.FindStatusCondition(updatedPipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+				require.NotNil(t, cond, "could not find condition of type %s", conditions.TypeTestDataDelivered)
+				require.Equal(t, tt.expectedStatus, cond.Status)
+				require.Equal(t, tt.expectedReason, cond.Reason)
+
+				if tt.expectedMessage != "" {
+					require.Equal(t, tt.expectedMessage, cond.Message)
+				}
+
+				if tt.expectTestDataSent {
+					require.NotNil(t, updatedPipeline.Status.TestData)
+					require.Equal(t, "1", updatedPipeline.Status.TestData.Request)
+				} else {
+					require.Equal(t, tt.testData, updatedPipeline.Status.TestData)
+				}
+			})
+		}
+	})
+
+	t.Run("statistics", func(t *testing.T) {
+		pipeline := testutils.NewTracePipelineBuilder().Build()
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+
+		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		pipelineLockStub := &mocks.PipelineLock{}
+		pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+		pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, pipeline.Name).Return(prober.StatisticsProbeResult{
+			SentPerSecond:    ptr.To(42.0),
+			DroppedPerSecond: ptr.To(0.25),
+			RefusedPerSecond: ptr.To(0.0),
+			Queued:           ptr.To(2.0),
+		}, nil)
+
+		pipelineValidatorWithStubs := &Validator{
+			EndpointValidator:  stubs.NewEndpointValidator(nil),
+			TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+			SecretRefValidator: stubs.NewSecretRefValidator(nil),
+			PipelineLock:       pipelineLockStub,
+		}
+
+		sut := New(
+			fakeClient,
+			testConfig,
+			flowHealthProberStub,
+			gatewayApplierDeleterMock,
+			gatewayConfigBuilderMock,
+			commonStatusStubs.NewDeploymentSetProber(nil),
+			istioStatusCheckerStub,
+			overridesHandlerStub,
+			pipelineLockStub,
+			pipelineValidatorWithStubs,
+			&conditions.ErrorToMessageConverter{})
+		sut.outputReachabilityProber = commonStatusStubs.NewOutputReachabilityProber(nil)
+
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+		require.NoError(t, err)
+
+		var updatedPipeline telemetryv1alpha1.TracePipeline
+		_ = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+
+		statistics := updatedPipeline.Status.Statistics
+		require.NotNil(t, statistics)
+		require.Equal(t, "42", statistics.SentPerSecond)
+		require.Equal(t, "0.25", statistics.DroppedPerSecond)
+		require.Equal(t, "0", statistics.RefusedPerSecond)
+		require.Equal(t, ptr.To[int64](2), statistics.Queued)
+		require.NotNil(t, statistics.LastExportTime)
+	})
+
 	t.Run("tls conditions", func(t *testing.T) {
 		tests := []struct {
 			name                    string
@@ -643,7 +1217,7 @@ func TestReconcile(t *testing.T) {
 				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-				gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+				gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
 
 				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 				gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -656,6 +1230,7 @@ func TestReconcile(t *testing.T) {
 				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 				pipelineValidatorWithStubs := &Validator{
@@ -704,7 +1279,7 @@ func TestReconcile(t *testing.T) {
 				if !tt.expectGatewayConfigured {
 					gatewayConfigBuilderMock.AssertNotCalled(t, "Build", mock.Anything, mock.Anything)
 				} else {
-					gatewayConfigBuilderMock.AssertCalled(t, "Build", mock.Anything, containsPipeline(pipeline))
+					gatewayConfigBuilderMock.AssertCalled(t, "Build", mock.Anything, containsPipeline(pipeline), mock.Anything)
 				}
 			})
 		}
@@ -726,7 +1301,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline, secret).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -738,6 +1313,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		serverErr := errors.New("failed to get lock: server error")
@@ -790,7 +1366,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -804,6 +1380,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -855,7 +1432,7 @@ func TestReconcile(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
+		gatewayConfigBuilderMock.On("Build", mock.Anything, mock.Anything, mock.Anything).Return(&gateway.Config{}, nil, nil)
 
 		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 		gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(1)
@@ -867,6 +1444,7 @@ func TestReconcile(t *testing.T) {
 		gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(nil)
 
 		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 		flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 		pipelineValidatorWithStubs := &Validator{
@@ -946,7 +1524,7 @@ func TestReconcile(t *testing.T) {
 				fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
 
 				gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
-				gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline)).Return(&gateway.Config{}, nil, nil).Times(1)
+				gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
 
 				gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
 				gatewayApplierDeleterMock.On("DeleteResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -965,6 +1543,7 @@ func TestReconcile(t *testing.T) {
 				gatewayProberStub := commonStatusStubs.NewDeploymentSetProber(tt.probeGatewayErr)
 
 				flowHealthProberStub := &mocks.FlowHealthProber{}
+				flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
 				flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelPipelineProbeResult{}, nil)
 
 				errToMsg := &conditions.ErrorToMessageConverter{}
@@ -997,6 +1576,126 @@ func TestReconcile(t *testing.T) {
 	})
 }
 
+func TestReconcilePipelineOverrides(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = telemetryv1alpha1.AddToScheme(scheme)
+
+	pipeline := testutils.NewTracePipelineBuilder().WithName("pipeline").Build()
+
+	newReconciler := func(fakeClient client.Client, overrideConfig *overrides.Config, gatewayConfigBuilder GatewayConfigBuilder) *Reconciler {
+		overridesHandlerStub := &mocks.OverridesHandler{}
+		overridesHandlerStub.On("LoadOverrides", mock.Anything).Return(overrideConfig, nil)
+
+		gatewayApplierDeleterMock := &mocks.GatewayApplierDeleter{}
+		gatewayApplierDeleterMock.On("ApplyResources", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		pipelineLockStub := &mocks.PipelineLock{}
+		pipelineLockStub.On("TryAcquireLock", mock.Anything, mock.Anything).Return(nil)
+		pipelineLockStub.On("IsLockHolder", mock.Anything, mock.Anything).Return(nil)
+
+		flowHealthProberStub := &mocks.FlowHealthProber{}
+		flowHealthProberStub.On("ProbeStatistics", mock.Anything, mock.Anything).Return(prober.StatisticsProbeResult{}, nil)
+		flowHealthProberStub.On("Probe", mock.Anything, mock.Anything).Return(prober.OTelPipelineProbeResult{}, nil)
+
+		return New(
+			fakeClient,
+			Config{TraceGatewayName: "gateway", TelemetryNamespace: "default"},
+			flowHealthProberStub,
+			gatewayApplierDeleterMock,
+			gatewayConfigBuilder,
+			commonStatusStubs.NewDeploymentSetProber(nil),
+			&stubs.IstioStatusChecker{IsActive: false},
+			overridesHandlerStub,
+			pipelineLockStub,
+			&Validator{
+				EndpointValidator:  stubs.NewEndpointValidator(nil),
+				TLSCertValidator:   stubs.NewTLSCertValidator(nil),
+				SecretRefValidator: stubs.NewSecretRefValidator(nil),
+				PipelineLock:       pipelineLockStub,
+			},
+			&conditions.ErrorToMessageConverter{})
+	}
+
+	t.Run("pipeline paused", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+
+		sut := newReconciler(fakeClient, &overrides.Config{
+			Pipelines: []overrides.PipelineConfig{{Kind: "TracePipeline", Name: pipeline.Name, Paused: true}},
+		}, gatewayConfigBuilderMock)
+
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+		require.NoError(t, err)
+
+		gatewayConfigBuilderMock.AssertNotCalled(t, "Build", mock.Anything, mock.Anything, mock.Anything)
+
+		var updatedPipeline telemetryv1alpha1.TracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline))
+		require.Empty(t, updatedPipeline.Status.Conditions)
+	})
+
+	t.Run("other pipeline paused", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
+
+		sut := newReconciler(fakeClient, &overrides.Config{
+			Pipelines: []overrides.PipelineConfig{
+				{Kind: "TracePipeline", Name: "other", Paused: true},
+				{Kind: "MetricPipeline", Name: pipeline.Name, Paused: true},
+			},
+		}, gatewayConfigBuilderMock)
+
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+		require.NoError(t, err)
+
+		gatewayConfigBuilderMock.AssertExpectations(t)
+	})
+
+	t.Run("exporter debug level", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), gateway.BuildOptions{
+			PendingTestData:        map[string]bool{},
+			DebugExporterVerbosity: map[string]string{pipeline.Name: "detailed"},
+		}).Return(&gateway.Config{}, nil, nil).Times(1)
+
+		sut := newReconciler(fakeClient, &overrides.Config{
+			Pipelines: []overrides.PipelineConfig{{Kind: "TracePipeline", Name: pipeline.Name, ExporterDebugLevel: "detailed"}},
+		}, gatewayConfigBuilderMock)
+
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+		require.NoError(t, err)
+
+		gatewayConfigBuilderMock.AssertExpectations(t)
+	})
+
+	t.Run("collector patch", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+		gatewayConfigBuilderMock := &mocks.GatewayConfigBuilder{}
+		gatewayConfigBuilderMock.On("Build", mock.Anything, containsPipeline(pipeline), mock.Anything).Return(&gateway.Config{}, nil, nil).Times(1)
+
+		sut := newReconciler(fakeClient, &overrides.Config{
+			CollectorPatches: overrides.CollectorPatchesConfig{
+				TraceGateway: map[string]any{"processors": map[string]any{"batch": map[string]any{"send_batch_size": 1024}}},
+			},
+		}, gatewayConfigBuilderMock)
+
+		_, err := sut.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: pipeline.Name}})
+		require.NoError(t, err)
+
+		gatewayApplierDeleterMock := sut.gatewayApplierDeleter.(*mocks.GatewayApplierDeleter)
+		require.Len(t, gatewayApplierDeleterMock.Calls, 1)
+		opts := gatewayApplierDeleterMock.Calls[0].Arguments.Get(2).(otelcollector.GatewayApplyOptions)
+		require.Contains(t, opts.CollectorConfigYAML, "send_batch_size: 1024")
+
+		var updatedPipeline telemetryv1alpha1.TracePipeline
+		require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline))
+		require.True(t, *updatedPipeline.Status.UnsupportedMode)
+	})
+}
+
 func requireHasStatusCondition(t *testing.T, pipeline telemetryv1alpha1.TracePipeline, condType string, status metav1.ConditionStatus, reason, message string) {
 	cond := meta.FindStatusCondition(pipeline.Status.Conditions, condType)
 	require.NotNil(t, cond, "could not find condition of type %s", condType)
@@ -1012,3 +1711,16 @@ func containsPipeline(p telemetryv1alpha1.TracePipeline) any {
 		return len(pipelines) == 1 && pipelines[0].Name == p.Name
 	})
 }
+
+func readEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
@@ -4,24 +4,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	"github.com/kyma-project/telemetry-manager/internal/overrides"
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/commonstatus"
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
-func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) error {
+func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string, overrideConfig *overrides.Config) error {
 	var pipeline telemetryv1alpha1.TracePipeline
 	if err := r.Get(ctx, types.NamespacedName{Name: pipelineName}, &pipeline); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -34,17 +39,31 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 
 	if pipeline.DeletionTimestamp != nil {
 		logf.FromContext(ctx).V(1).Info("Skipping status update for TracePipeline - marked for deletion")
+		commonstatus.DeletePipelineMetrics(pipelineKind, pipeline.Name)
+
 		return nil
 	}
 
-	r.setGatewayHealthyCondition(ctx, &pipeline)
-	r.setGatewayConfigGeneratedCondition(ctx, &pipeline)
-	r.setFlowHealthCondition(ctx, &pipeline)
+	oldConditions := slices.Clone(pipeline.Status.Conditions)
+
+	probeCtx, probeSpan := selftracing.Start(ctx, selftracing.SpanProbe)
+	r.setGatewayHealthyCondition(probeCtx, &pipeline)
+	r.setGatewayConfigGeneratedCondition(probeCtx, &pipeline)
+	r.setFlowHealthCondition(probeCtx, &pipeline)
+	r.setOutputReachableCondition(probeCtx, &pipeline)
+	r.setTestDataCondition(probeCtx, &pipeline)
+	r.setStatistics(probeCtx, &pipeline)
+	probeSpan.End()
+
+	setUnsupportedMode(&pipeline, overrideConfig)
 
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		return fmt.Errorf("failed to update TracePipeline status: %w", err)
 	}
 
+	commonstatus.RecordConditionEvents(r.config.EventRecorder, &pipeline, oldConditions, pipeline.Status.Conditions)
+	r.recordMetrics(ctx, &pipeline)
+
 	return nil
 }
 
@@ -101,12 +120,13 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 
 func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) {
 	status, reason := r.evaluateFlowHealthCondition(ctx, pipeline)
+	thresholds := commonstatus.GetSelfMonitorThresholds(ctx, r.Client)
 
 	condition := metav1.Condition{
 		Type:               conditions.TypeFlowHealthy,
 		Status:             status,
 		Reason:             reason,
-		Message:            conditions.MessageForTracePipeline(reason),
+		Message:            commonstatus.FlowHealthMessage(conditions.MessageForTracePipeline(reason), reason, thresholds, commonstatus.SignalTypeTraces),
 		ObservedGeneration: pipeline.Generation,
 	}
 
@@ -132,9 +152,134 @@ func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *
 		return metav1.ConditionTrue, reason
 	}
 
+	if (probeResult.AllDataDropped || probeResult.SomeDataDropped) && r.oauth2TokenFetchFailed(ctx, pipeline) {
+		return metav1.ConditionFalse, conditions.ReasonSelfMonOAuth2TokenFailed
+	}
+
 	return metav1.ConditionFalse, reason
 }
 
+// oauth2TokenFetchFailed checks whether the data is dropped because the token endpoint of an output with OAuth2 authentication fails,
+// which the metrics of the gateway do not tell apart from failures of the backend.
+func (r *Reconciler) oauth2TokenFetchFailed(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) bool {
+	output := pipeline.Spec.Output.Otlp
+	if output == nil || output.Authentication == nil || !output.Authentication.OAuth2.IsDefined() {
+		return false
+	}
+
+	if err := r.oauth2TokenProber.Probe(ctx, pipeline.Name, output); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to fetch OAuth2 access token", "error", err.Error())
+		return errors.Is(err, prober.ErrOAuth2TokenFetchFailed)
+	}
+
+	return false
+}
+
+func (r *Reconciler) setOutputReachableCondition(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) {
+	status, reason, message := r.evaluateOutputReachableCondition(ctx, pipeline)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeOutputReachable,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: pipeline.Generation,
+	}
+
+	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+}
+
+func (r *Reconciler) evaluateOutputReachableCondition(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) (status metav1.ConditionStatus, reason string, message string) {
+	// The ConfigurationGenerated condition is already set, so the pipeline is not validated again
+	if meta.IsStatusConditionFalse(pipeline.Status.Conditions, conditions.TypeConfigurationGenerated) {
+		return metav1.ConditionUnknown, conditions.ReasonOutputNotProbed, conditions.MessageForTracePipeline(conditions.ReasonOutputNotProbed)
+	}
+
+	var (
+		target *prober.OutputTarget
+		err    error
+	)
+
+	if pipeline.Spec.Output.Kafka != nil {
+		target, err = prober.KafkaOutputTarget(ctx, r.Client, pipeline.Spec.Output.Kafka)
+	} else {
+		target, err = prober.OTLPOutputTarget(ctx, r.Client, pipeline.Spec.Output.Otlp)
+	}
+
+	if err == nil {
+		err = r.outputReachabilityProber.Probe(ctx, pipeline.Name, target)
+	}
+
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("Output is not reachable", "error", err.Error())
+	}
+
+	return conditions.EvaluateOutputReachableCondition(err)
+}
+
+func (r *Reconciler) setStatistics(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) {
+	pipeline.Status.Statistics = commonstatus.GetStatistics(ctx, r.flowHealthProber, pipeline.Name, pipeline.Status.Statistics, time.Now())
+}
+
+func (r *Reconciler) setTestDataCondition(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) {
+	request := pipeline.Annotations[telemetryv1alpha1.AnnotationSendTestData]
+	if request == "" {
+		meta.RemoveStatusCondition(&pipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+		return
+	}
+
+	status, reason, message := r.evaluateTestDataCondition(ctx, pipeline, request)
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeTestDataDelivered,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: pipeline.Generation,
+	}
+
+	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+}
+
+// evaluateTestDataCondition sends the test data for a new request, and probes the export result of a pending one.
+// The test data is sent again if sending fails, because the request is not recorded in the status then.
+func (r *Reconciler) evaluateTestDataCondition(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline, request string) (status metav1.ConditionStatus, reason string, message string) {
+	testData := pipeline.Status.TestData
+	current := meta.FindStatusCondition(pipeline.Status.Conditions, conditions.TypeTestDataDelivered)
+
+	if testData == nil || testData.Request != request {
+		configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
+		if configGeneratedStatus == metav1.ConditionFalse {
+			return metav1.ConditionUnknown, conditions.ReasonTestDataNotSent, conditions.MessageForTracePipeline(conditions.ReasonTestDataNotSent)
+		}
+
+		// The test data output is only rendered while the test data is pending, so the test data is sent in a later reconciliation, once the gateway with that output is rolled out
+		if !conditions.IsTestDataRolloutAwaited(current) || r.gatewayProber.IsReady(ctx, types.NamespacedName{Name: r.config.TraceGatewayName, Namespace: r.config.TelemetryNamespace}) != nil {
+			return metav1.ConditionUnknown, conditions.ReasonTestDataRollingOut, conditions.MessageForTracePipeline(conditions.ReasonTestDataRollingOut)
+		}
+
+		if err := r.testDataSender.Send(ctx, pipeline.Name, request); err != nil {
+			logf.FromContext(ctx).V(1).Info("Failed to send test data", "error", err.Error())
+			return metav1.ConditionFalse, conditions.ReasonTestDataSendFailed, fmt.Sprintf(conditions.MessageForTracePipeline(conditions.ReasonTestDataSendFailed), err)
+		}
+
+		pipeline.Status.TestData = &telemetryv1alpha1.TestDataStatus{Request: request, SentAt: metav1.Now()}
+
+		return metav1.ConditionUnknown, conditions.ReasonTestDataPending, conditions.MessageForTracePipeline(conditions.ReasonTestDataPending)
+	}
+
+	if current != nil && current.Reason != conditions.ReasonTestDataPending {
+		return current.Status, current.Reason, current.Message
+	}
+
+	result, err := r.flowHealthProber.ProbeTestData(ctx, pipeline.Name, testData.SentAt.Time)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to probe test data export")
+	}
+
+	return conditions.EvaluateTestDataCondition(result, err, testData.SentAt.Time, time.Now())
+}
+
 func flowHealthReasonFor(probeResult prober.OTelPipelineProbeResult) string {
 	if probeResult.AllDataDropped {
 		return conditions.ReasonSelfMonAllDataDropped
@@ -154,3 +299,27 @@ func flowHealthReasonFor(probeResult prober.OTelPipelineProbeResult) string {
 
 	return conditions.ReasonSelfMonFlowHealthy
 }
+
+func (r *Reconciler) recordMetrics(ctx context.Context, pipeline *telemetryv1alpha1.TracePipeline) {
+	commonstatus.RecordPipelineMetrics(commonstatus.PipelineMetrics{
+		Kind:            pipelineKind,
+		Name:            pipeline.Name,
+		Conditions:      pipeline.Status.Conditions,
+		OutputType:      outputType(pipeline),
+		UnsupportedMode: ptr.Deref(pipeline.Status.UnsupportedMode, false),
+		TLSCertExpiry:   r.pipelineValidator.tlsCertExpiry(ctx, pipeline),
+	})
+}
+
+// setUnsupportedMode marks the pipeline if the configuration of the trace gateway is patched, because all pipelines share the gateway
+func setUnsupportedMode(pipeline *telemetryv1alpha1.TracePipeline, overrideConfig *overrides.Config) {
+	pipeline.Status.UnsupportedMode = ptr.To(len(overrideConfig.CollectorPatches.TraceGateway) > 0)
+}
+
+func outputType(pipeline *telemetryv1alpha1.TracePipeline) string {
+	if pipeline.Spec.Output.Kafka != nil {
+		return "kafka"
+	}
+
+	return "otlp"
+}
@@ -1,9 +1,13 @@
 package resourcelock
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,20 +21,48 @@ import (
 
 var ErrMaxPipelinesExceeded = errors.New("maximum pipeline count limit exceeded")
 
+// Checker grants a limited number of owners a slot in a lock ConfigMap, recorded as owner references.
+// If there are more candidates than slots, the owners with the highest priority keep a slot. Owners with the same priority keep their slots in the order in which they acquired them.
 type Checker struct {
 	client    client.Client
 	lockName  types.NamespacedName
-	maxOwners int
+	maxOwners func(ctx context.Context) int
+	priority  func(owner metav1.Object) int32
 }
 
-func New(client client.Client, lockName types.NamespacedName, maxOwners int) *Checker {
-	return &Checker{
+type Option = func(*Checker)
+
+// WithMaxOwnersFunc looks up the maximum number of owners whenever the lock is acquired, so that the limit can be changed at runtime.
+func WithMaxOwnersFunc(maxOwners func(ctx context.Context) int) Option {
+	return func(l *Checker) {
+		l.maxOwners = maxOwners
+	}
+}
+
+// WithPriorityFunc sets the priority of the owners. By default, all owners have the priority 0.
+func WithPriorityFunc(priority func(owner metav1.Object) int32) Option {
+	return func(l *Checker) {
+		l.priority = priority
+	}
+}
+
+func New(client client.Client, lockName types.NamespacedName, maxOwners int, opts ...Option) *Checker {
+	l := &Checker{
 		client:    client,
 		lockName:  lockName,
-		maxOwners: maxOwners,
+		maxOwners: func(context.Context) int { return maxOwners },
+		priority:  func(metav1.Object) int32 { return 0 },
+	}
+
+	for _, opt := range opts {
+		opt(l)
 	}
+
+	return l
 }
 
+// TryAcquireLock grants the owner a slot. If all slots are taken, the owner takes the slot of the holder with the lowest priority, if that priority is lower than its own.
+// The priorities of the holders are stored in the lock, so that the lock can be evaluated without reading the owners.
 func (l *Checker) TryAcquireLock(ctx context.Context, owner metav1.Object) error {
 	var lock corev1.ConfigMap
 	if err := l.client.Get(ctx, l.lockName, &lock); err != nil {
@@ -41,25 +73,88 @@ func (l *Checker) TryAcquireLock(ctx context.Context, owner metav1.Object) error
 		return fmt.Errorf("failed to get lock: %w", err)
 	}
 
-	for _, ref := range lock.GetOwnerReferences() {
-		if ref.Name == owner.GetName() && ref.UID == owner.GetUID() {
-			return nil
-		}
-	}
+	oldOwnerRefs := slices.Clone(lock.GetOwnerReferences())
+	oldPriorities := maps.Clone(lock.Data)
 
-	if l.maxOwners == 0 || len(lock.GetOwnerReferences()) < l.maxOwners {
+	if !slices.ContainsFunc(lock.GetOwnerReferences(), isOwnerRef(owner)) {
 		if err := controllerutil.SetOwnerReference(owner, &lock, l.client.Scheme()); err != nil {
 			return fmt.Errorf("failed to set owner reference: %w", err)
 		}
+	}
+
+	if lock.Data == nil {
+		lock.Data = make(map[string]string)
+	}
 
+	lock.Data[owner.GetName()] = strconv.Itoa(int(l.priority(owner)))
+
+	l.evictLowestPriorityOwners(ctx, &lock)
+
+	if !slices.Equal(oldOwnerRefs, lock.GetOwnerReferences()) || !maps.Equal(oldPriorities, lock.Data) {
 		if err := l.client.Update(ctx, &lock); err != nil {
 			return fmt.Errorf("failed to update lock: %w", err)
 		}
+	}
 
-		return nil
+	if !slices.ContainsFunc(lock.GetOwnerReferences(), isOwnerRef(owner)) {
+		return ErrMaxPipelinesExceeded
 	}
 
-	return ErrMaxPipelinesExceeded
+	return nil
+}
+
+// evictLowestPriorityOwners removes the owners that exceed the maximum number of owners, starting with the lowest priority and, within the same priority, with the most recent owner.
+// It also removes the priorities of owners that no longer hold the lock.
+func (l *Checker) evictLowestPriorityOwners(ctx context.Context, lock *corev1.ConfigMap) {
+	ownerRefs := lock.GetOwnerReferences()
+
+	if maxOwners := l.maxOwners(ctx); maxOwners > 0 && len(ownerRefs) > maxOwners {
+		byPriority := make([]int, len(ownerRefs))
+		for i := range byPriority {
+			byPriority[i] = i
+		}
+
+		slices.SortStableFunc(byPriority, func(a, b int) int {
+			return cmp.Or(
+				cmp.Compare(storedPriority(lock, ownerRefs[a].Name), storedPriority(lock, ownerRefs[b].Name)),
+				cmp.Compare(b, a),
+			)
+		})
+
+		evicted := byPriority[:len(ownerRefs)-maxOwners]
+
+		var kept []metav1.OwnerReference
+
+		for i, ref := range ownerRefs {
+			if !slices.Contains(evicted, i) {
+				kept = append(kept, ref)
+			}
+		}
+
+		lock.SetOwnerReferences(kept)
+	}
+
+	for name := range lock.Data {
+		if !slices.ContainsFunc(lock.GetOwnerReferences(), func(ref metav1.OwnerReference) bool { return ref.Name == name }) {
+			delete(lock.Data, name)
+		}
+	}
+}
+
+func storedPriority(lock *corev1.ConfigMap, name string) int {
+	priority, err := strconv.Atoi(lock.Data[name])
+	if err != nil {
+		// Owners that acquired the lock before priorities were stored have the default priority
+		return 0
+	}
+
+	return priority
+}
+
+func isOwnerRef(owner metav1.Object) func(ref metav1.OwnerReference) bool {
+	return func(ref metav1.OwnerReference) bool {
+		return ref.Name == owner.GetName() && ref.UID == owner.GetUID()
+	}
 }
 
 func (l *Checker) IsLockHolder(ctx context.Context, obj metav1.Object) error {
@@ -70,10 +165,8 @@ func (l *Checker) IsLockHolder(ctx context.Context, obj metav1.Object) error {
 		}
 	}
 
-	for _, ref := range lock.GetOwnerReferences() {
-		if ref.Name == obj.GetName() && ref.UID == obj.GetUID() {
-			return nil
-		}
+	if slices.ContainsFunc(lock.GetOwnerReferences(), isOwnerRef(obj)) {
+		return nil
 	}
 
 	return ErrMaxPipelinesExceeded
@@ -85,6 +178,9 @@ func (l *Checker) createLock(ctx context.Context, owner metav1.Object) error {
 			Name:      l.lockName.Name,
 			Namespace: l.lockName.Namespace,
 		},
+		Data: map[string]string{
+			owner.GetName(): strconv.Itoa(int(l.priority(owner))),
+		},
 	}
 
 	if err := controllerutil.SetOwnerReference(owner, &lock, l.client.Scheme()); err != nil {
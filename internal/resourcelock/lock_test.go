@@ -2,6 +2,7 @@ package resourcelock
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -91,3 +92,76 @@ func TestIsLockHolder(t *testing.T) {
 	err = l.IsLockHolder(ctx, owner3)
 	require.Equal(t, ErrMaxPipelinesExceeded, err)
 }
+
+func TestTryAcquireLockWithPriority(t *testing.T) {
+	newOwner := func(name string, priority string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				UID:         types.UID(name),
+				Annotations: map[string]string{"priority": priority},
+			},
+		}
+	}
+
+	priorityFromAnnotation := func(owner metav1.Object) int32 {
+		priority, _ := strconv.Atoi(owner.GetAnnotations()["priority"])
+		return int32(priority) //nolint:gosec // test values are small
+	}
+
+	low := newOwner("low", "0")
+	medium := newOwner("medium", "5")
+	otherMedium := newOwner("other-medium", "5")
+	high := newOwner("high", "10")
+
+	ctx := context.Background()
+	fakeClient := fake.NewClientBuilder().Build()
+	l := New(fakeClient, lockName, 2, WithPriorityFunc(priorityFromAnnotation))
+
+	require.NoError(t, l.TryAcquireLock(ctx, low))
+	require.NoError(t, l.TryAcquireLock(ctx, medium))
+
+	// A pipeline with a higher priority takes the slot of the pipeline with the lowest priority
+	require.NoError(t, l.TryAcquireLock(ctx, high))
+	require.Equal(t, ErrMaxPipelinesExceeded, l.IsLockHolder(ctx, low))
+	require.NoError(t, l.IsLockHolder(ctx, medium))
+	require.NoError(t, l.IsLockHolder(ctx, high))
+
+	// The preempted pipeline cannot take the slot back
+	require.Equal(t, ErrMaxPipelinesExceeded, l.TryAcquireLock(ctx, low))
+
+	// A pipeline with the same priority does not take a slot from an earlier one
+	require.Equal(t, ErrMaxPipelinesExceeded, l.TryAcquireLock(ctx, otherMedium))
+	require.NoError(t, l.IsLockHolder(ctx, medium))
+
+	var lock corev1.ConfigMap
+	require.NoError(t, fakeClient.Get(ctx, lockName, &lock))
+	require.Equal(t, map[string]string{"medium": "5", "high": "10"}, lock.Data)
+}
+
+func TestTryAcquireLockWithChangedMaxOwners(t *testing.T) {
+	owners := make([]*corev1.ConfigMap, 3)
+	for i := range owners {
+		name := "owner" + strconv.Itoa(i+1)
+		owners[i] = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name)}}
+	}
+
+	maxOwners := 3
+
+	ctx := context.Background()
+	fakeClient := fake.NewClientBuilder().Build()
+	l := New(fakeClient, lockName, 1, WithMaxOwnersFunc(func(context.Context) int { return maxOwners }))
+
+	for _, owner := range owners {
+		require.NoError(t, l.TryAcquireLock(ctx, owner))
+	}
+
+	// Lowering the limit evicts the most recent owners when the lock is acquired the next time
+	maxOwners = 1
+
+	require.NoError(t, l.TryAcquireLock(ctx, owners[0]))
+	require.NoError(t, l.IsLockHolder(ctx, owners[0]))
+	require.Equal(t, ErrMaxPipelinesExceeded, l.IsLockHolder(ctx, owners[1]))
+	require.Equal(t, ErrMaxPipelinesExceeded, l.IsLockHolder(ctx, owners[2]))
+}
@@ -86,6 +86,14 @@ func MakeNetworkPolicy(name types.NamespacedName, allowedPorts []int32, labels m
 	}
 }
 
+// ManagerLabels select the Telemetry Manager Pod, which runs in the same Namespace as the workloads that it deploys.
+func ManagerLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name": "manager",
+		"control-plane":          "telemetry-manager",
+	}
+}
+
 type PodSpecOption = func(pod *corev1.PodSpec)
 
 func WithPriorityClass(priorityClassName string) PodSpecOption {
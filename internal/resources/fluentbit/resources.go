@@ -7,6 +7,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/kyma-project/telemetry-manager/internal/fluentbit/ports"
+	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 )
 
 const checksumAnnotationKey = "checksum/logpipeline-config"
@@ -31,7 +33,8 @@ type DaemonSetConfig struct {
 	MemoryRequest               resource.Quantity
 }
 
-func MakeDaemonSet(name types.NamespacedName, checksum string, dsConfig DaemonSetConfig) *appsv1.DaemonSet {
+// MakeDaemonSet returns the Fluent Bit DaemonSet. The proxyEnvVars configure the egress proxy of the fluent-bit container.
+func MakeDaemonSet(name types.NamespacedName, checksum string, dsConfig DaemonSetConfig, proxyEnvVars []corev1.EnvVar) *appsv1.DaemonSet {
 	resourcesFluentBit := corev1.ResourceRequirements{
 		Requests: map[corev1.ResourceName]resource.Quantity{
 			corev1.ResourceCPU:    dsConfig.CPURequest,
@@ -105,12 +108,18 @@ func MakeDaemonSet(name types.NamespacedName, checksum string, dsConfig DaemonSe
 									},
 								},
 							},
+							Env: proxyEnvVars,
 							Ports: []corev1.ContainerPort{
 								{
 									Name:          "http",
 									ContainerPort: ports.HTTP,
 									Protocol:      "TCP",
 								},
+								{
+									Name:          "http-test-data",
+									ContainerPort: ports.TestData,
+									Protocol:      "TCP",
+								},
 							},
 							LivenessProbe: &corev1.Probe{
 								ProbeHandler: corev1.ProbeHandler{
@@ -297,6 +306,52 @@ func MakeMetricsService(name types.NamespacedName) *corev1.Service {
 	}
 }
 
+// MakeTestDataService returns the Service that exposes the HTTP input of Fluent Bit, to which test data is sent on request of a LogPipeline.
+func MakeTestDataService(name types.NamespacedName) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-test-data", name.Name),
+			Namespace: name.Namespace,
+			Labels:    Labels(),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http-test-data",
+					Protocol:   "TCP",
+					Port:       int32(ports.TestData),
+					TargetPort: intstr.FromString("http-test-data"),
+				},
+			},
+			Selector: Labels(),
+			Type:     corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
+// MakeTestDataIngressRule returns the NetworkPolicy ingress rule that admits only Telemetry Manager to the HTTP input of Fluent Bit,
+// so that no other workload can inject records into the outputs of the LogPipelines.
+func MakeTestDataIngressRule() networkingv1.NetworkPolicyIngressRule {
+	protocolTCP := corev1.ProtocolTCP
+	testDataPort := intstr.FromInt32(int32(ports.TestData))
+
+	return networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{
+			{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: commonresources.ManagerLabels(),
+				},
+			},
+		},
+		Ports: []networkingv1.NetworkPolicyPort{
+			{
+				Protocol: &protocolTCP,
+				Port:     &testDataPort,
+			},
+		},
+	}
+}
+
 func MakeExporterMetricsService(name types.NamespacedName) *corev1.Service {
 	serviceLabels := Labels()
 	serviceLabels["telemetry.kyma-project.io/self-monitor"] = "enabled"
@@ -350,9 +405,17 @@ func MakeConfigMap(name types.NamespacedName) *corev1.ConfigMap {
     storage.path /data/flb-storage/
     storage.metrics on
 
+[INPUT]
+    Name http
+    Alias test-data
+    Listen 0.0.0.0
+    Port {{ TEST_DATA_PORT }}
+    Mem_Buf_Limit 1MB
+
 @INCLUDE dynamic/*.conf
 `
 	fluentBitConfig = strings.Replace(fluentBitConfig, "{{ HTTP_PORT }}", strconv.Itoa(ports.HTTP), 1)
+	fluentBitConfig = strings.Replace(fluentBitConfig, "{{ TEST_DATA_PORT }}", strconv.Itoa(ports.TestData), 1)
 
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
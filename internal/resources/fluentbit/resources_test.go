@@ -29,7 +29,7 @@ func TestMakeDaemonSet(t *testing.T) {
 		"checksum/logpipeline-config":                  checksum,
 		"traffic.sidecar.istio.io/excludeInboundPorts": "2020,2021",
 	}
-	daemonSet := MakeDaemonSet(name, checksum, ds)
+	daemonSet := MakeDaemonSet(name, checksum, ds, nil)
 
 	require.NotNil(t, daemonSet)
 	require.Equal(t, daemonSet.Name, name.Name)
@@ -67,6 +67,19 @@ func TestMakeDaemonSet(t *testing.T) {
 	require.Equal(t, 10, len(volMounts), "volume mounts do not match")
 }
 
+func TestMakeDaemonSetWithProxy(t *testing.T) {
+	name := types.NamespacedName{Name: "telemetry-fluent-bit", Namespace: "telemetry-system"}
+	proxyEnvVars := []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+		{Name: "NO_PROXY", Value: "localhost,.svc"},
+	}
+
+	daemonSet := MakeDaemonSet(name, "foo", DaemonSetConfig{}, proxyEnvVars)
+
+	require.Equal(t, proxyEnvVars, daemonSet.Spec.Template.Spec.Containers[0].Env)
+	require.Empty(t, daemonSet.Spec.Template.Spec.Containers[1].Env, "exporter must not use the proxy")
+}
+
 func TestMakeClusterRole(t *testing.T) {
 	name := types.NamespacedName{Name: "telemetry-fluent-bit", Namespace: "telemetry-system"}
 	clusterRole := MakeClusterRole(name)
@@ -126,6 +139,34 @@ func TestMakeExporterMetricsService(t *testing.T) {
 	require.Equal(t, int32(port), service.Spec.Ports[0].Port) //nolint:gosec // parseInt returns int64.  This is a testfile so not part of binary
 }
 
+func TestMakeTestDataService(t *testing.T) {
+	name := types.NamespacedName{Name: "telemetry-fluent-bit", Namespace: "telemetry-system"}
+	service := MakeTestDataService(name)
+
+	require.NotNil(t, service)
+	require.Equal(t, service.Name, "telemetry-fluent-bit-test-data")
+	require.Equal(t, service.Namespace, name.Namespace)
+	require.Equal(t, service.Spec.Type, corev1.ServiceTypeClusterIP)
+	require.Len(t, service.Spec.Ports, 1)
+	require.Equal(t, int32(2022), service.Spec.Ports[0].Port)
+	require.NotContains(t, service.Labels, "telemetry.kyma-project.io/self-monitor")
+}
+
+func TestMakeTestDataIngressRule(t *testing.T) {
+	rule := MakeTestDataIngressRule()
+
+	require.Len(t, rule.From, 1)
+	require.Nil(t, rule.From[0].IPBlock)
+	require.Nil(t, rule.From[0].NamespaceSelector)
+	require.Equal(t, map[string]string{
+		"app.kubernetes.io/name": "manager",
+		"control-plane":          "telemetry-manager",
+	}, rule.From[0].PodSelector.MatchLabels)
+	require.Len(t, rule.Ports, 1)
+	require.Equal(t, corev1.ProtocolTCP, *rule.Ports[0].Protocol)
+	require.Equal(t, int32(2022), rule.Ports[0].Port.IntVal)
+}
+
 func TestMakeConfigMap(t *testing.T) {
 	name := types.NamespacedName{Name: "telemetry-fluent-bit", Namespace: "telemetry-system"}
 	cm := MakeConfigMap(name)
@@ -135,6 +176,8 @@ func TestMakeConfigMap(t *testing.T) {
 	require.Equal(t, cm.Namespace, name.Namespace)
 	require.NotEmpty(t, cm.Data["custom_parsers.conf"])
 	require.NotEmpty(t, cm.Data["fluent-bit.conf"])
+	require.Contains(t, cm.Data["fluent-bit.conf"], "Name http")
+	require.Contains(t, cm.Data["fluent-bit.conf"], "Port 2022")
 }
 
 func TestMakeLuaConfigMap(t *testing.T) {
@@ -41,6 +41,12 @@ func withEnvVarFromSource(envVarName, fieldPath string) podSpecOption {
 	}
 }
 
+func withEnvVars(envVars []corev1.EnvVar) podSpecOption {
+	return func(pod *corev1.PodSpec) {
+		pod.Containers[0].Env = append(pod.Containers[0].Env, envVars...)
+	}
+}
+
 func withVolumeMount(volumeMount corev1.VolumeMount) podSpecOption {
 	return func(pod *corev1.PodSpec) {
 		for i := range pod.Containers {
@@ -37,6 +37,8 @@ type GatewayApplyOptions struct {
 	CollectorEnvVars    map[string][]byte
 	IstioEnabled        bool
 	IstioExcludePorts   []int32
+	// ProxyEnvVars specifies the environment variables that configure the egress proxy of the gateway.
+	ProxyEnvVars []corev1.EnvVar
 	// Replicas specifies the number of gateway replicas.
 	Replicas int32
 	// ResourceRequirementsMultiplier is a coefficient affecting the CPU and memory resource limits for each replica.
@@ -157,6 +159,7 @@ func (gad *GatewayApplierDeleter) makeGatewayDeployment(configChecksum string, o
 		withEnvVarFromSource(config.EnvVarCurrentPodIP, fieldPathPodIP),
 		withEnvVarFromSource(config.EnvVarCurrentNodeName, fieldPathNodeName),
 		commonresources.WithGoMemLimitEnvVar(resources.Limits[corev1.ResourceMemory]),
+		withEnvVars(opts.ProxyEnvVars),
 	)
 
 	return &appsv1.Deployment{
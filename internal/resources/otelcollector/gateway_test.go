@@ -417,6 +417,37 @@ func TestApplyGatewayResourcesWithIstioEnabled(t *testing.T) {
 	})
 }
 
+func TestApplyGatewayResourcesWithProxy(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	sut := GatewayApplierDeleter{
+		Config: createGatewayConfig(),
+		RBAC:   createGatewayRBAC(),
+	}
+
+	proxyEnvVars := []corev1.EnvVar{
+		{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+		{Name: "NO_PROXY", Value: "localhost,.svc"},
+	}
+
+	err := sut.ApplyResources(ctx, client, GatewayApplyOptions{
+		CollectorConfigYAML: gatewayCfg,
+		CollectorEnvVars:    envVars,
+		ProxyEnvVars:        proxyEnvVars,
+		Replicas:            replicas,
+	})
+	require.NoError(t, err)
+
+	var dep appsv1.Deployment
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: gatewayNamespace, Name: gatewayName}, &dep))
+
+	env := dep.Spec.Template.Spec.Containers[0].Env
+	require.Subset(t, env, proxyEnvVars, "must have proxy env vars")
+}
+
 func TestDeleteGatewayResources(t *testing.T) {
 	ctx := context.Background()
 	scheme := runtime.NewScheme()
@@ -3,6 +3,8 @@ package selfmonitor
 type Config struct {
 	BaseName  string
 	Namespace string
+	// MetricAgentName is the name of the metric agent, which pushes the health of its scrape targets to the self monitor
+	MetricAgentName string
 
 	Deployment DeploymentConfig
 }
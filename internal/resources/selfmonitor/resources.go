@@ -19,15 +19,18 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/k8sutils"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/ports"
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/webhook"
 )
 
 const (
-	retentionTime       = "2h"
-	retentionSize       = "50MB"
-	logFormat           = "json"
-	configFileMountName = "prometheus-config-volume"
-	storageMountName    = "prometheus-storage-volume"
-	storagePath         = "/prometheus/"
+	retentionTime         = "2h"
+	retentionSize         = "50MB"
+	logFormat             = "json"
+	configFileMountName   = "prometheus-config-volume"
+	alertTargetsMountName = "alert-targets-volume"
+	webhookTokenMountName = "webhook-token-volume"
+	storageMountName      = "prometheus-storage-volume"
+	storagePath           = "/prometheus/"
 )
 
 var (
@@ -48,6 +51,13 @@ type ApplyOptions struct {
 	PrometheusConfigFileName string
 	PrometheusConfigPath     string
 	PrometheusConfigYAML     string
+	// AlertTargetSecretData holds the credentials of the additional alert targets, which are mounted as files to AlertTargetSecretPath.
+	AlertTargetSecretData map[string][]byte
+	AlertTargetSecretPath string
+	// WebhookTokenSecretName is the Secret holding the token for the calls to the Telemetry Manager webhook, which is mounted to WebhookTokenPath.
+	// The Secret is managed by the Telemetry Manager and not part of the config checksum, because the token is read on every call, so a rotation does not require a restart.
+	WebhookTokenSecretName string
+	WebhookTokenPath       string
 }
 
 func (ad *ApplierDeleter) DeleteResources(ctx context.Context, c client.Client) error {
@@ -64,6 +74,10 @@ func (ad *ApplierDeleter) DeleteResources(ctx context.Context, c client.Client)
 		return err
 	}
 
+	if err := k8sutils.DeleteObject(ctx, c, &corev1.Secret{ObjectMeta: objectMeta}); err != nil {
+		return err
+	}
+
 	if err := k8sutils.DeleteObject(ctx, c, &networkingv1.NetworkPolicy{ObjectMeta: objectMeta}); err != nil {
 		return err
 	}
@@ -110,8 +124,33 @@ func (ad *ApplierDeleter) ApplyResources(ctx context.Context, c client.Client, o
 		return fmt.Errorf("failed to create self-monitor configmap: %w", err)
 	}
 
-	checksum := configchecksum.Calculate([]corev1.ConfigMap{*configMap}, nil)
-	if err := k8sutils.CreateOrUpdateDeployment(ctx, c, ad.makeDeployment(checksum, opts.PrometheusConfigPath, opts.PrometheusConfigFileName)); err != nil {
+	var secrets []corev1.Secret
+
+	if len(opts.AlertTargetSecretData) > 0 {
+		secret := ad.makeSecret(opts.AlertTargetSecretData)
+		if err := k8sutils.CreateOrUpdateSecret(ctx, c, secret); err != nil {
+			return fmt.Errorf("failed to create self-monitor secret: %w", err)
+		}
+
+		secrets = append(secrets, *secret)
+	} else {
+		if err := k8sutils.DeleteObject(ctx, c, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ad.Config.BaseName, Namespace: ad.Config.Namespace}}); err != nil {
+			return fmt.Errorf("failed to delete self-monitor secret: %w", err)
+		}
+	}
+
+	checksum := configchecksum.Calculate([]corev1.ConfigMap{*configMap}, secrets)
+	deployment := ad.makeDeployment(checksum, opts.PrometheusConfigPath, opts.PrometheusConfigFileName)
+
+	if len(secrets) > 0 {
+		mountAlertTargetSecret(&deployment.Spec.Template.Spec, ad.Config.BaseName, opts.AlertTargetSecretPath)
+	}
+
+	if opts.WebhookTokenSecretName != "" {
+		mountWebhookTokenSecret(&deployment.Spec.Template.Spec, opts.WebhookTokenSecretName, opts.WebhookTokenPath)
+	}
+
+	if err := k8sutils.CreateOrUpdateDeployment(ctx, c, deployment); err != nil {
 		return fmt.Errorf("failed to create sel-monitor deployment: %w", err)
 	}
 
@@ -188,14 +227,15 @@ func (ad *ApplierDeleter) makeNetworkPolicy() *networkingv1.NetworkPolicy {
 				networkingv1.PolicyTypeIngress,
 				networkingv1.PolicyTypeEgress,
 			},
+			// Only Telemetry Manager queries the self monitor, and only the metric agent writes to it with OTLP
 			Ingress: []networkingv1.NetworkPolicyIngressRule{
 				{
 					From: []networkingv1.NetworkPolicyPeer{
 						{
-							IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"},
+							PodSelector: &metav1.LabelSelector{MatchLabels: commonresources.ManagerLabels()},
 						},
 						{
-							IPBlock: &networkingv1.IPBlock{CIDR: "::/0"},
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": ad.Config.MetricAgentName}},
 						},
 					},
 					Ports: ad.makeNetworkPolicyPorts(allowedPorts),
@@ -247,6 +287,17 @@ func (ad *ApplierDeleter) makeConfigMap(prometheusConfigFileName, prometheusConf
 	}
 }
 
+func (ad *ApplierDeleter) makeSecret(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ad.Config.BaseName,
+			Namespace: ad.Config.Namespace,
+			Labels:    ad.defaultLabels(),
+		},
+		Data: data,
+	}
+}
+
 func (ad *ApplierDeleter) makeDeployment(configChecksum, configPath, configFile string) *appsv1.Deployment {
 	var replicas int32 = 1
 
@@ -307,6 +358,8 @@ func makePodSpec(baseName, image, configPath, configFile string, opts ...commonr
 					"--config.file=" + configPath + configFile,
 					"--storage.tsdb.path=" + storagePath,
 					"--log.format=" + logFormat,
+					// The metric agent pushes the health of its Prometheus scrape targets with OTLP
+					"--enable-feature=otlp-write-receiver",
 				},
 				SecurityContext: &corev1.SecurityContext{
 					Privileged:               ptr.To(false),
@@ -387,6 +440,44 @@ func makePodSpec(baseName, image, configPath, configFile string, opts ...commonr
 	return pod
 }
 
+func mountAlertTargetSecret(pod *corev1.PodSpec, secretName, mountPath string) {
+	// The mounted files are owned by root, so they must be readable for the Prometheus user
+	var defaultMode int32 = 0o444
+
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: alertTargetsMountName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  secretName,
+				DefaultMode: &defaultMode,
+			},
+		},
+	})
+
+	container := &pod.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: alertTargetsMountName, MountPath: mountPath, ReadOnly: true})
+}
+
+func mountWebhookTokenSecret(pod *corev1.PodSpec, secretName, mountPath string) {
+	// The mounted files are owned by root, so they must be readable for the Prometheus user
+	var defaultMode int32 = 0o444
+
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: webhookTokenMountName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  secretName,
+				DefaultMode: &defaultMode,
+				// Only the current token is mounted, the previous one is only accepted by the webhook
+				Items: []corev1.KeyToPath{{Key: webhook.TokenKey, Path: webhook.TokenKey}},
+			},
+		},
+	})
+
+	container := &pod.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: webhookTokenMountName, MountPath: mountPath, ReadOnly: true})
+}
+
 func makeResourceRequirements() corev1.ResourceRequirements {
 	return corev1.ResourceRequirements{
 		Limits: map[corev1.ResourceName]resource.Quantity{
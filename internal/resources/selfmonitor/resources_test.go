@@ -9,6 +9,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -30,8 +31,9 @@ func TestDeleteSelfMonitorResources(t *testing.T) {
 
 	sut := ApplierDeleter{
 		Config: Config{
-			BaseName:  name,
-			Namespace: namespace,
+			BaseName:        name,
+			Namespace:       namespace,
+			MetricAgentName: "my-metric-agent",
 		},
 	}
 
@@ -103,8 +105,9 @@ func TestApplySelfMonitorResources(t *testing.T) {
 
 	sut := ApplierDeleter{
 		Config: Config{
-			BaseName:  name,
-			Namespace: namespace,
+			BaseName:        name,
+			Namespace:       namespace,
+			MetricAgentName: "my-metric-agent",
 		},
 	}
 
@@ -207,6 +210,7 @@ func verifyDeploymentIsPreset(ctx context.Context, t *testing.T, client client.C
 		"--config.file=" + configPath + configFileName,
 		"--storage.tsdb.path=" + storagePath,
 		"--log.format=" + logFormat,
+		"--enable-feature=otlp-write-receiver",
 	}
 	require.Equal(t, container.Args, expectedArgs)
 }
@@ -300,8 +304,13 @@ func verifyNetworkPolicy(ctx context.Context, t *testing.T, client client.Client
 	require.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, np.Spec.PolicyTypes)
 	require.Len(t, np.Spec.Ingress, 1)
 	require.Len(t, np.Spec.Ingress[0].From, 2)
-	require.Equal(t, "0.0.0.0/0", np.Spec.Ingress[0].From[0].IPBlock.CIDR)
-	require.Equal(t, "::/0", np.Spec.Ingress[0].From[1].IPBlock.CIDR)
+	require.Equal(t, map[string]string{
+		"app.kubernetes.io/name": "manager",
+		"control-plane":          "telemetry-manager",
+	}, np.Spec.Ingress[0].From[0].PodSelector.MatchLabels)
+	require.Equal(t, map[string]string{
+		"app.kubernetes.io/name": "my-metric-agent",
+	}, np.Spec.Ingress[0].From[1].PodSelector.MatchLabels)
 	require.Len(t, np.Spec.Ingress[0].Ports, 1)
 
 	tcpProtocol := corev1.ProtocolTCP
@@ -339,3 +348,96 @@ func verifyService(ctx context.Context, t *testing.T, client client.Client) {
 		TargetPort: intstr.FromInt32(9090),
 	}, svc.Spec.Ports[0])
 }
+
+func TestApplySelfMonitorResourcesWithAlertTargets(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+
+	sut := ApplierDeleter{
+		Config: Config{
+			BaseName:        name,
+			Namespace:       namespace,
+			MetricAgentName: "my-metric-agent",
+		},
+	}
+
+	opts := ApplyOptions{
+		AlertRulesFileName:       alertRulesFileName,
+		AlertRulesYAML:           alertRulesYAML,
+		PrometheusConfigFileName: configFileName,
+		PrometheusConfigPath:     configPath,
+		PrometheusConfigYAML:     prometheusConfigYAML,
+		AlertTargetSecretData:    map[string][]byte{"0-password": []byte("secret")},
+		AlertTargetSecretPath:    "/alert-targets/",
+	}
+	require.NoError(t, sut.ApplyResources(ctx, client, opts))
+
+	t.Run("should create secret", func(t *testing.T) {
+		var secret corev1.Secret
+
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &secret))
+		require.Equal(t, []byte("secret"), secret.Data["0-password"])
+	})
+
+	t.Run("should mount secret", func(t *testing.T) {
+		var dep appsv1.Deployment
+
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &dep))
+		require.Contains(t, dep.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: alertTargetsMountName, MountPath: "/alert-targets/", ReadOnly: true})
+		require.Len(t, dep.Spec.Template.Spec.Volumes, 3)
+	})
+
+	opts.AlertTargetSecretData = nil
+	require.NoError(t, sut.ApplyResources(ctx, client, opts))
+
+	t.Run("should delete secret without alert targets", func(t *testing.T) {
+		var secrets corev1.SecretList
+
+		require.NoError(t, client.List(ctx, &secrets))
+		require.Empty(t, secrets.Items)
+	})
+}
+
+func TestApplySelfMonitorResourcesWithWebhookToken(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+
+	sut := ApplierDeleter{
+		Config: Config{
+			BaseName:        name,
+			Namespace:       namespace,
+			MetricAgentName: "my-metric-agent",
+		},
+	}
+
+	opts := ApplyOptions{
+		AlertRulesFileName:       alertRulesFileName,
+		AlertRulesYAML:           alertRulesYAML,
+		PrometheusConfigFileName: configFileName,
+		PrometheusConfigPath:     configPath,
+		PrometheusConfigYAML:     prometheusConfigYAML,
+		WebhookTokenSecretName:   "webhook-token",
+		WebhookTokenPath:         "/webhook-token/",
+	}
+	require.NoError(t, sut.ApplyResources(ctx, client, opts))
+
+	var dep appsv1.Deployment
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &dep))
+
+	podSpec := dep.Spec.Template.Spec
+	require.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: webhookTokenMountName, MountPath: "/webhook-token/", ReadOnly: true})
+	require.Len(t, podSpec.Volumes, 3)
+
+	tokenVolume := podSpec.Volumes[2]
+	require.Equal(t, webhookTokenMountName, tokenVolume.Name)
+	require.NotNil(t, tokenVolume.Secret)
+	require.Equal(t, "webhook-token", tokenVolume.Secret.SecretName)
+	require.Equal(t, []corev1.KeyToPath{{Key: "token", Path: "token"}}, tokenVolume.Secret.Items)
+
+	t.Run("should not create the token secret", func(t *testing.T) {
+		var secrets corev1.SecretList
+
+		require.NoError(t, client.List(ctx, &secrets))
+		require.Empty(t, secrets.Items)
+	})
+}
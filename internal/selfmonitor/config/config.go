@@ -21,10 +21,13 @@ type AlertingConfig struct {
 }
 
 type AlertManagerConfig struct {
-	Scheme        string                     `yaml:"scheme,omitempty"`
-	PathPrefix    string                     `yaml:"path_prefix,omitempty"`
-	StaticConfigs []AlertManagerStaticConfig `yaml:"static_configs"`
-	TLSConfig     TLSConfig                  `yaml:"tls_config,omitempty"`
+	Scheme              string                     `yaml:"scheme,omitempty"`
+	PathPrefix          string                     `yaml:"path_prefix,omitempty"`
+	StaticConfigs       []AlertManagerStaticConfig `yaml:"static_configs"`
+	TLSConfig           TLSConfig                  `yaml:"tls_config,omitempty"`
+	BasicAuth           *BasicAuth                 `yaml:"basic_auth,omitempty"`
+	Authorization       *Authorization             `yaml:"authorization,omitempty"`
+	AlertRelabelConfigs []RelabelConfig            `yaml:"alert_relabel_configs,omitempty"`
 }
 
 type AlertManagerStaticConfig struct {
@@ -32,7 +35,20 @@ type AlertManagerStaticConfig struct {
 }
 
 type TLSConfig struct {
-	InsecureSkipVerify bool `yaml:"insecure_skip_verify,omitempty"`
+	CAFile             string `yaml:"ca_file,omitempty"`
+	CertFile           string `yaml:"cert_file,omitempty"`
+	KeyFile            string `yaml:"key_file,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+}
+
+type BasicAuth struct {
+	Username     string `yaml:"username"`
+	PasswordFile string `yaml:"password_file"`
+}
+
+type Authorization struct {
+	Type            string `yaml:"type,omitempty"`
+	CredentialsFile string `yaml:"credentials_file"`
 }
 
 type ScrapeConfig struct {
@@ -1,24 +1,64 @@
 package config
 
 import (
+	"regexp"
 	"strings"
 	"time"
+
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
 )
 
 const defaultInterval = 30 * time.Second
 
+const (
+	labelAlertName = "alertname"
+	labelReason    = "reason"
+)
+
 type BuilderConfig struct {
-	ScrapeNamespace   string
-	WebhookURL        string
-	WebhookScheme     string
+	ScrapeNamespace string
+	WebhookURL      string
+	WebhookScheme   string
+	// WebhookTokenFile is the file holding the bearer token that authenticates the alerts sent to the Telemetry Manager.
+	WebhookTokenFile  string
 	ConfigPath        string
 	AlertRuleFileName string
+	AlertTargets      []AlertTarget
+}
+
+// AlertTarget is an additional receiver of the alerts next to the Telemetry Manager.
+// Credentials are referenced as files, so that they do not show up in the configuration.
+type AlertTarget struct {
+	Scheme             string
+	Host               string
+	PathPrefix         string
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	InsecureSkipVerify bool
+	Username           string
+	PasswordFile       string
+	BearerTokenFile    string
+}
+
+// alertTargetReasons are the reasons that the alerts sent to additional alert targets are labeled with, so that receivers can route them without knowing the rule names.
+var alertTargetReasons = []struct {
+	reason string
+	rules  []string
+}{
+	{"TelemetryDataDropped", []string{RuleNameGatewayExporterDroppedData, RuleNameGatewayExporterEnqueueFailed, RuleNameLogAgentExporterDroppedLogs, RuleNameLogAgentBufferFull}},
+	{"AgentExportFailing", []string{RuleNameMetricAgentExporterDroppedData}},
+	{"BufferFillingUp", []string{RuleNameGatewayExporterQueueAlmostFull, RuleNameLogAgentBufferInUse}},
+	{"GatewayThrottling", []string{RuleNameGatewayReceiverRefusedData}},
+	{"NoLogsDelivered", []string{RuleNameLogAgentNoLogsDelivered}},
+	{"AgentThrottling", []string{RuleNameLogAgentThrottling, RuleNameMetricAgentReceiverRefusedData}},
+	{"ScrapeTargetsDown", []string{RuleNameMetricAgentScrapeTargetsDown}},
 }
 
 func MakeConfig(builderCfg BuilderConfig) Config {
 	promConfig := Config{}
 	promConfig.GlobalConfig = makeGlobalConfig()
-	promConfig.AlertingConfig = makeAlertConfig(builderCfg.WebhookURL, builderCfg.WebhookScheme)
+	promConfig.AlertingConfig = makeAlertConfig(builderCfg.WebhookURL, builderCfg.WebhookScheme, builderCfg.WebhookTokenFile, builderCfg.AlertTargets)
 	promConfig.RuleFiles = []string{builderCfg.ConfigPath + builderCfg.AlertRuleFileName}
 	promConfig.ScrapeConfigs = makeScrapeConfig(builderCfg.ScrapeNamespace)
 
@@ -32,18 +72,94 @@ func makeGlobalConfig() GlobalConfig {
 	}
 }
 
-func makeAlertConfig(webhookURL, webhookScheme string) AlertingConfig {
+func makeAlertConfig(webhookURL, webhookScheme, webhookTokenFile string, alertTargets []AlertTarget) AlertingConfig {
+	webhook := AlertManagerConfig{
+		Scheme: webhookScheme,
+		StaticConfigs: []AlertManagerStaticConfig{{
+			Targets: []string{webhookURL},
+		}},
+		TLSConfig: TLSConfig{
+			InsecureSkipVerify: true,
+		},
+	}
+
+	if webhookTokenFile != "" {
+		webhook.Authorization = &Authorization{
+			Type:            "Bearer",
+			CredentialsFile: webhookTokenFile,
+		}
+	}
+
+	alertManagers := []AlertManagerConfig{webhook}
+
+	for _, target := range alertTargets {
+		alertManagers = append(alertManagers, makeAlertTargetConfig(target))
+	}
+
 	return AlertingConfig{
-		AlertManagers: []AlertManagerConfig{{
-			Scheme: webhookScheme,
-			StaticConfigs: []AlertManagerStaticConfig{{
-				Targets: []string{webhookURL},
-			}},
-			TLSConfig: TLSConfig{
-				InsecureSkipVerify: true,
-			},
+		AlertManagers: alertManagers,
+	}
+}
+
+func makeAlertTargetConfig(target AlertTarget) AlertManagerConfig {
+	config := AlertManagerConfig{
+		Scheme:     target.Scheme,
+		PathPrefix: target.PathPrefix,
+		StaticConfigs: []AlertManagerStaticConfig{{
+			Targets: []string{target.Host},
 		}},
+		TLSConfig: TLSConfig{
+			CAFile:             target.CAFile,
+			CertFile:           target.CertFile,
+			KeyFile:            target.KeyFile,
+			InsecureSkipVerify: target.InsecureSkipVerify,
+		},
+		AlertRelabelConfigs: makeAlertTargetRelabelConfigs(),
+	}
+
+	if target.Username != "" {
+		config.BasicAuth = &BasicAuth{
+			Username:     target.Username,
+			PasswordFile: target.PasswordFile,
+		}
+	}
+
+	if target.BearerTokenFile != "" {
+		config.Authorization = &Authorization{
+			Type:            "Bearer",
+			CredentialsFile: target.BearerTokenFile,
+		}
+	}
+
+	return config
+}
+
+// makeAlertTargetRelabelConfigs drops the alerts that report healthy data flow, and labels the remaining alerts with the reason of the problem.
+// The pipeline_name label is already part of the alerts.
+func makeAlertTargetRelabelConfigs() []RelabelConfig {
+	relabelConfigs := []RelabelConfig{{
+		SourceLabels: []string{labelAlertName},
+		Action:       Drop,
+		Regex:        alertNameRegex(RuleNameGatewayExporterSentData, RuleNameLogAgentExporterSentLogs, RuleNameLogAgentReceiverReadLogs),
+	}}
+
+	for _, alertReason := range alertTargetReasons {
+		relabelConfigs = append(relabelConfigs, RelabelConfig{
+			SourceLabels: []string{labelAlertName},
+			Action:       Replace,
+			Regex:        alertNameRegex(alertReason.rules...),
+			TargetLabel:  labelReason,
+			Replacement:  alertReason.reason,
+		})
 	}
+
+	return relabelConfigs
+}
+
+// alertNameRegex matches the alerts of the given unprefixed rules for all pipeline types.
+func alertNameRegex(unprefixedRuleNames ...string) string {
+	prefixes := []string{ruleNamePrefix(typeMetricPipeline), ruleNamePrefix(typeTracePipeline), ruleNamePrefix(typeLogPipeline)}
+	return "(" + strings.Join(prefixes, "|") + ")(" + strings.Join(unprefixedRuleNames, "|") + ")"
 }
 
 func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
@@ -104,17 +220,18 @@ func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
 				// The following relabel configs add an artificial pipeline_name label to the Fluent Bit and OTel Collector metrics to simplify pipeline matching
 				// For Fluent Bit metrics, the pipeline_name is based on the name label. Note that a regex group matching Kubernetes resource names (alphanumerical chars and hyphens) is used to extract the pipeline name.
 				// It allows to filter out timeseries with technical names (storage_backend.0, tail.0, etc.)
-				// For OTel Collector metrics, the pipeline_name is extracted from the exporter label, which has the format [otlp|otlphttp]/<pipeline_name>
+				// For OTel Collector metrics, the pipeline_name is extracted from the exporter label, which has the format [otlp|otlphttp|kafka]/<pipeline_name>
+				// The components that deliver only the test data of a pipeline get the pipeline_name of that pipeline
 				{
 					SourceLabels: []string{"__name__", "name"},
 					Action:       Replace,
-					Regex:        "fluentbit_.+;([a-zA-Z0-9-]+)",
+					Regex:        "fluentbit_.+;([a-zA-Z0-9-]+)" + testDataSuffixRegex(),
 					TargetLabel:  "pipeline_name",
 				},
 				{
 					SourceLabels: []string{"__name__", "exporter"},
 					Action:       Replace,
-					Regex:        "otelcol_.+;.+/([a-zA-Z0-9-]+)",
+					Regex:        "otelcol_.+;.+/([a-zA-Z0-9-]+)" + testDataSuffixRegex(),
 					TargetLabel:  "pipeline_name",
 				},
 			},
@@ -129,7 +246,9 @@ func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
 func scrapableMetricsRegex() string {
 	fluentBitMetrics := []string{
 		metricFluentBitOutputProcBytesTotal,
+		metricFluentBitOutputProcRecordsTotal,
 		metricFluentBitOutputDroppedRecordsTotal,
+		metricFluentBitFilterDropRecordsTotal,
 		metricFluentBitInputBytesTotal,
 		metricFluentBitBufferUsageBytes,
 	}
@@ -151,3 +270,8 @@ func scrapableMetricsRegex() string {
 	return strings.Join(append(fluentBitMetrics,
 		otelCollectorMetrics...), "|")
 }
+
+// testDataSuffixRegex matches the optional suffix that the components delivering only the test data of a pipeline have in addition to the pipeline name.
+func testDataSuffixRegex() string {
+	return "(?:" + regexp.QuoteMeta(synthetic.TestDataName("")) + ")?"
+}
@@ -13,6 +13,7 @@ func TestMakeConfigMarshalling(t *testing.T) {
 	config := MakeConfig(BuilderConfig{
 		ScrapeNamespace:   "kyma-system",
 		WebhookURL:        "http://webhook:9090",
+		WebhookTokenFile:  "/dummy-secretpath/webhook-token",
 		ConfigPath:        "/dummy-configpath/",
 		AlertRuleFileName: "dymma-alerts.yml",
 	})
@@ -24,3 +25,34 @@ func TestMakeConfigMarshalling(t *testing.T) {
 	require.NoError(t, err, "failed to load golden monitoring file")
 	require.Equal(t, string(goldenMonitoringFile), string(monitorConfigYaml))
 }
+
+func TestMakeConfigWithAlertTargets(t *testing.T) {
+	config := MakeConfig(BuilderConfig{
+		ScrapeNamespace:   "kyma-system",
+		WebhookURL:        "http://webhook:9090",
+		ConfigPath:        "/dummy-configpath/",
+		AlertRuleFileName: "dymma-alerts.yml",
+		AlertTargets: []AlertTarget{
+			{
+				Scheme:       "https",
+				Host:         "alertmanager.example.com:9093",
+				CAFile:       "/etc/prometheus/alert-targets/0-ca.crt",
+				Username:     "user",
+				PasswordFile: "/etc/prometheus/alert-targets/0-password",
+			},
+			{
+				Scheme:          "http",
+				Host:            "webhook.example.com",
+				PathPrefix:      "/alerts",
+				BearerTokenFile: "/etc/prometheus/alert-targets/1-token",
+			},
+		},
+	})
+	alertingConfigYaml, err := yaml.Marshal(config.AlertingConfig)
+	require.NoError(t, err)
+
+	goldenAlertingConfigPath := filepath.Join("testdata", "alerting_config.yaml")
+	goldenAlertingFile, err := os.ReadFile(goldenAlertingConfigPath)
+	require.NoError(t, err, "failed to load golden alerting file")
+	require.Equal(t, string(goldenAlertingFile), string(alertingConfigYaml))
+}
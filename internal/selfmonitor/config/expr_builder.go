@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
 )
 
 const defaultRateDuration = "5m"
@@ -14,12 +17,46 @@ type exprBuilder struct {
 	expr string
 }
 
-type labelSelector func(string) string
+type labelSelector func() string
 
 func selectService(serviceName string) labelSelector {
-	return func(metric string) string {
-		return fmt.Sprintf("%s{%s=\"%s\"}", metric, labelService, serviceName)
+	return func() string {
+		return fmt.Sprintf("%s=\"%s\"", labelService, serviceName)
+	}
+}
+
+// selectLabelSet selects only the time series that have the given label set to a non-empty value.
+func selectLabelSet(label string) labelSelector {
+	return func() string {
+		return fmt.Sprintf("%s!=\"\"", label)
+	}
+}
+
+// selectLabel selects only the time series that have the given label set to the given value.
+func selectLabel(label, value string) labelSelector {
+	return func() string {
+		return fmt.Sprintf("%s=\"%s\"", label, value)
+	}
+}
+
+// selectLabelMatch selects only the time series that have the given label set to a value that matches the given regex.
+func selectLabelMatch(label, regex string) labelSelector {
+	return func() string {
+		return fmt.Sprintf("%s=~\"%s\"", label, regex)
+	}
+}
+
+func withSelectors(metric string, selectors ...labelSelector) string {
+	if len(selectors) == 0 {
+		return metric
 	}
+
+	matchers := make([]string, len(selectors))
+	for i, s := range selectors {
+		matchers[i] = s()
+	}
+
+	return fmt.Sprintf("%s{%s}", metric, strings.Join(matchers, ","))
 }
 
 func ignoringLabelsMatch(labels ...string) vectorMatch {
@@ -29,34 +66,24 @@ func ignoringLabelsMatch(labels ...string) vectorMatch {
 }
 
 func instant(metric string, selectors ...labelSelector) *exprBuilder {
-	for _, s := range selectors {
-		metric = s(metric)
-	}
-
 	eb := &exprBuilder{
-		expr: metric,
+		expr: withSelectors(metric, selectors...),
 	}
 
 	return eb
 }
 
 func rate(metric string, selectors ...labelSelector) *exprBuilder {
-	for _, s := range selectors {
-		metric = s(metric)
-	}
-
 	eb := &exprBuilder{
-		expr: fmt.Sprintf("rate(%s[%s])", metric, defaultRateDuration),
+		expr: fmt.Sprintf("rate(%s[%s])", withSelectors(metric, selectors...), defaultRateDuration),
 	}
 
 	return eb
 }
 
 func div(nominator, denominator string, vOpt vectorMatch, selectors ...labelSelector) *exprBuilder {
-	for _, s := range selectors {
-		nominator = s(nominator)
-		denominator = s(denominator)
-	}
+	nominator = withSelectors(nominator, selectors...)
+	denominator = withSelectors(denominator, selectors...)
 
 	vMatch := vOpt()
 	eb := &exprBuilder{
@@ -76,6 +103,11 @@ func (eb *exprBuilder) maxBy(labels ...string) *exprBuilder {
 	return eb
 }
 
+func (eb *exprBuilder) countBy(labels ...string) *exprBuilder {
+	eb.expr = fmt.Sprintf("count by (%s) (%s)", strings.Join(labels, ","), eb.expr)
+	return eb
+}
+
 func (eb *exprBuilder) greaterThan(value float64) *exprBuilder {
 	eb.expr = fmt.Sprintf("%s > %s", eb.expr, strconv.FormatFloat(value, 'f', -1, 64))
 	return eb
@@ -102,3 +134,39 @@ func wrapInParentheses(input []string) []string {
 
 	return wrapped
 }
+
+// selectPipeline selects only the time series of the given pipeline.
+func selectPipeline(pipelineName string) labelSelector {
+	return func() string {
+		return fmt.Sprintf("%s=\"%s\"", labelPipelineName, pipelineName)
+	}
+}
+
+func increase(metric string, window time.Duration, selectors ...labelSelector) *exprBuilder {
+	eb := &exprBuilder{
+		expr: fmt.Sprintf("increase(%s[%s])", withSelectors(metric, selectors...), model.Duration(window)),
+	}
+
+	return eb
+}
+
+func (eb *exprBuilder) sum() *exprBuilder {
+	eb.expr = fmt.Sprintf("sum(%s)", eb.expr)
+	return eb
+}
+
+// selectMetricNames selects the time series of any of the given metrics. It is used without a metric name.
+func selectMetricNames(metrics ...string) labelSelector {
+	return func() string {
+		return fmt.Sprintf("__name__=~\"%s\"", strings.Join(metrics, "|"))
+	}
+}
+
+// share builds the share of the part in the sum of the part and the rest. If the rest has no time series for a label set of the part, the share is 1.
+func share(part, rest string) *exprBuilder {
+	eb := &exprBuilder{
+		expr: fmt.Sprintf("%s / (%s + (%s or %s * 0))", part, part, rest, part),
+	}
+
+	return eb
+}
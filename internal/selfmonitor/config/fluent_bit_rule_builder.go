@@ -9,8 +9,10 @@ const (
 	fluentBitSidecarMetricsServiceName = "telemetry-fluent-bit-exporter-metrics"
 
 	metricFluentBitOutputProcBytesTotal      = "fluentbit_output_proc_bytes_total"
+	metricFluentBitOutputProcRecordsTotal    = "fluentbit_output_proc_records_total"
 	metricFluentBitInputBytesTotal           = "fluentbit_input_bytes_total"
 	metricFluentBitOutputDroppedRecordsTotal = "fluentbit_output_dropped_records_total"
+	metricFluentBitFilterDropRecordsTotal    = "fluentbit_filter_drop_records_total"
 	metricFluentBitBufferUsageBytes          = "telemetry_fsbuffer_usage_bytes"
 
 	bufferUsage300MB = 300000000
@@ -21,6 +23,7 @@ const (
 )
 
 type fluentBitRuleBuilder struct {
+	thresholds Thresholds
 }
 
 func (rb fluentBitRuleBuilder) rules() []Rule {
@@ -30,6 +33,7 @@ func (rb fluentBitRuleBuilder) rules() []Rule {
 		rb.bufferInUseRule(),
 		rb.bufferFullRule(),
 		rb.noLogsDeliveredRule(),
+		rb.throttlingRule(),
 	}
 }
 
@@ -44,12 +48,23 @@ func (rb fluentBitRuleBuilder) exporterSentRule() Rule {
 }
 
 func (rb fluentBitRuleBuilder) exporterDroppedRule() Rule {
+	dropped := rate(metricFluentBitOutputDroppedRecordsTotal, selectService(fluentBitMetricsServiceName)).
+		sumBy(labelPipelineName)
+
+	if rb.thresholds.DroppedDataTolerancePercentage > 0 {
+		// The share is based on records, because the dropped logs are only counted in records
+		sent := rate(metricFluentBitOutputProcRecordsTotal, selectService(fluentBitMetricsServiceName)).
+			sumBy(labelPipelineName).
+			build()
+		dropped = share(dropped.build(), sent)
+	}
+
 	return Rule{
 		Alert: rb.namePrefix() + RuleNameLogAgentExporterDroppedLogs,
-		Expr: rate(metricFluentBitOutputDroppedRecordsTotal, selectService(fluentBitMetricsServiceName)).
-			sumBy(labelPipelineName).
-			greaterThan(0).
+		Expr: dropped.
+			greaterThan(percentageToRatio(rb.thresholds.DroppedDataTolerancePercentage)).
 			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
 
@@ -59,6 +74,7 @@ func (rb fluentBitRuleBuilder) bufferInUseRule() Rule {
 		Expr: instant(metricFluentBitBufferUsageBytes, selectService(fluentBitSidecarMetricsServiceName)).
 			greaterThan(bufferUsage300MB).
 			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
 
@@ -68,6 +84,7 @@ func (rb fluentBitRuleBuilder) bufferFullRule() Rule {
 		Expr: instant(metricFluentBitBufferUsageBytes, selectService(fluentBitSidecarMetricsServiceName)).
 			greaterThan(bufferUsage900MB).
 			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
 
@@ -85,7 +102,20 @@ func (rb fluentBitRuleBuilder) noLogsDeliveredRule() Rule {
 	return Rule{
 		Alert: rb.namePrefix() + RuleNameLogAgentNoLogsDelivered,
 		Expr:  and(receiverReadExpr, exporterNotSentExpr),
-		For:   alertWaitTime,
+		For:   rb.thresholds.alertFor(alertWaitTime),
+	}
+}
+
+// throttlingRule fires if the throttle filter of a pipeline drops logs. Only the throttle filter carries the pipeline name as alias,
+// so other filters that drop records (for example, custom grep filters) do not have the pipeline_name label and are ignored.
+func (rb fluentBitRuleBuilder) throttlingRule() Rule {
+	return Rule{
+		Alert: rb.namePrefix() + RuleNameLogAgentThrottling,
+		Expr: rate(metricFluentBitFilterDropRecordsTotal, selectService(fluentBitMetricsServiceName), selectLabelSet(labelPipelineName)).
+			sumBy(labelPipelineName).
+			greaterThan(0).
+			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
 
@@ -0,0 +1,70 @@
+package config
+
+const (
+	metricAgentMetricsServiceName = "telemetry-metric-agent-metrics"
+
+	// metricAgentGatewayExporter is the exporter of the metric agent that sends the metrics to the metric gateway
+	metricAgentGatewayExporter = "otlp"
+
+	// metricScrapeTargetUp is the up metric of the Prometheus scrape targets of the metric agent, which the agent pushes to the self monitor with OTLP
+	metricScrapeTargetUp = "scrape_target_up"
+
+	// metricScrapeTargetUpJobs matches the scrape jobs of the Prometheus receivers of the metric agent, which the OTLP receiver of the self monitor
+	// takes over as job label from the service name of the pushed metrics
+	metricScrapeTargetUpJobs = "app-(pods|services)(-secure)?"
+
+	// LabelTargetNamespace is the Namespace of a Prometheus scrape target of the metric agent
+	LabelTargetNamespace = "target_namespace"
+)
+
+// metricAgentRuleBuilder builds the rules for the metric agent. The agent exports to the metric gateway and not to the pipeline outputs,
+// so the rules are not related to a particular pipeline.
+type metricAgentRuleBuilder struct {
+	thresholds Thresholds
+}
+
+func (rb metricAgentRuleBuilder) rules() []Rule {
+	return []Rule{
+		rb.exporterDroppedRule(),
+		rb.receiverRefusedRule(),
+		rb.scrapeTargetsDownRule(),
+	}
+}
+
+func (rb metricAgentRuleBuilder) namePrefix() string {
+	return ruleNamePrefix(typeMetricPipeline)
+}
+
+func (rb metricAgentRuleBuilder) exporterDroppedRule() Rule {
+	return Rule{
+		Alert: rb.namePrefix() + RuleNameMetricAgentExporterDroppedData,
+		Expr: rate("", selectMetricNames(metricOtelCollectorExporterSendFailed+"_metric_points", metricOtelCollectorExporterEnqueueFailed+"_metric_points"), selectService(metricAgentMetricsServiceName), selectLabel(labelExporter, metricAgentGatewayExporter)).
+			sum().
+			greaterThan(0).
+			build(),
+		For: rb.thresholds.alertFor(0),
+	}
+}
+
+func (rb metricAgentRuleBuilder) receiverRefusedRule() Rule {
+	return Rule{
+		Alert: rb.namePrefix() + RuleNameMetricAgentReceiverRefusedData,
+		Expr: rate(metricOtelCollectorReceiverRefused+"_metric_points", selectService(metricAgentMetricsServiceName)).
+			sum().
+			greaterThan(0).
+			build(),
+		For: rb.thresholds.alertFor(0),
+	}
+}
+
+// scrapeTargetsDownRule fires for each Namespace with Prometheus scrape targets that are down. The value of the alert is the number of these targets.
+func (rb metricAgentRuleBuilder) scrapeTargetsDownRule() Rule {
+	return Rule{
+		Alert: rb.namePrefix() + RuleNameMetricAgentScrapeTargetsDown,
+		Expr: instant(metricScrapeTargetUp, selectLabelMatch(labelJob, metricScrapeTargetUpJobs)).
+			equal(0).
+			countBy(LabelTargetNamespace).
+			build(),
+		For: rb.thresholds.alertFor(alertWaitTime),
+	}
+}
@@ -17,6 +17,7 @@ type otelCollectorRuleBuilder struct {
 	serviceName string
 	dataType    string
 	namePrefix  string
+	thresholds  Thresholds
 }
 
 func (rb otelCollectorRuleBuilder) rules() []Rule {
@@ -47,13 +48,22 @@ func (rb otelCollectorRuleBuilder) exporterSentRule() Rule {
 
 func (rb otelCollectorRuleBuilder) exporterDroppedRule() Rule {
 	metric := rb.formatMetricName(metricOtelCollectorExporterSendFailed)
+	dropped := rate(metric, selectService(rb.serviceName)).
+		sumBy(labelPipelineName)
+
+	if rb.thresholds.DroppedDataTolerancePercentage > 0 {
+		sent := rate(rb.formatMetricName(metricOtelCollectorExporterSent), selectService(rb.serviceName)).
+			sumBy(labelPipelineName).
+			build()
+		dropped = share(dropped.build(), sent)
+	}
 
 	return Rule{
 		Alert: rb.namePrefix + RuleNameGatewayExporterDroppedData,
-		Expr: rate(metric, selectService(rb.serviceName)).
-			sumBy(labelPipelineName).
-			greaterThan(0).
+		Expr: dropped.
+			greaterThan(percentageToRatio(rb.thresholds.DroppedDataTolerancePercentage)).
 			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
 
@@ -62,8 +72,9 @@ func (rb otelCollectorRuleBuilder) exporterQueueAlmostFullRule() Rule {
 		Alert: rb.namePrefix + RuleNameGatewayExporterQueueAlmostFull,
 		Expr: div(metricOtelCollectorExporterQueueSize, metricOtelCollectorExporterQueueCapacity, ignoringLabelsMatch("data_type"), selectService(rb.serviceName)).
 			maxBy(labelPipelineName).
-			greaterThan(0.8). //nolint:mnd // alert on 80% full
+			greaterThan(percentageToRatio(rb.thresholds.QueueAlmostFullPercentage)).
 			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
 
@@ -76,6 +87,7 @@ func (rb otelCollectorRuleBuilder) exporterEnqueueFailedRule() Rule {
 			sumBy(labelPipelineName).
 			greaterThan(0).
 			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
 
@@ -88,5 +100,6 @@ func (rb otelCollectorRuleBuilder) receiverRefusedRule() Rule {
 			sumBy(labelReceiver).
 			greaterThan(0).
 			build(),
+		For: rb.thresholds.alertFor(0),
 	}
 }
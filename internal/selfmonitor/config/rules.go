@@ -15,6 +15,11 @@ const (
 	RuleNameGatewayExporterEnqueueFailed   = "GatewayExporterEnqueueFailed"
 	RuleNameGatewayReceiverRefusedData     = "GatewayReceiverRefusedData"
 
+	// Metric agent rule names. Note that the actual full names will be prefixed with Metric
+	RuleNameMetricAgentExporterDroppedData = "AgentExporterDroppedData"
+	RuleNameMetricAgentReceiverRefusedData = "AgentReceiverRefusedData"
+	RuleNameMetricAgentScrapeTargetsDown   = "AgentScrapeTargetsDown"
+
 	// Fluent Bit rule names. Note that the actual full names will be prefixed with Log
 	RuleNameLogAgentExporterSentLogs    = "AgentExporterSentLogs"
 	RuleNameLogAgentReceiverReadLogs    = "AgentReceiverReadLogs"
@@ -22,13 +27,19 @@ const (
 	RuleNameLogAgentBufferInUse         = "AgentBufferInUse"
 	RuleNameLogAgentBufferFull          = "AgentBufferFull"
 	RuleNameLogAgentNoLogsDelivered     = "AgentNoLogsDelivered"
+	RuleNameLogAgentThrottling          = "AgentThrottling"
 
 	// Common rule labels
 	labelService      = "service"
 	labelPipelineName = "pipeline_name"
+	labelJob          = "job"
 
 	// OTel Collector rule labels
 	labelReceiver = "receiver"
+	labelExporter = "exporter"
+
+	// Fluent Bit rule labels
+	labelName = "name"
 )
 
 // RuleGroups is a set of rule groups that are typically exposed in a file.
@@ -59,13 +70,14 @@ const (
 	typeLogPipeline
 )
 
-func MakeRules() RuleGroups {
+func MakeRules(thresholds Thresholds) RuleGroups {
 	var rules []Rule
 
 	metricRuleBuilder := otelCollectorRuleBuilder{
 		dataType:    "metric_points",
 		serviceName: "telemetry-metric-gateway-metrics",
 		namePrefix:  ruleNamePrefix(typeMetricPipeline),
+		thresholds:  thresholds,
 	}
 	rules = append(rules, metricRuleBuilder.rules()...)
 
@@ -73,12 +85,16 @@ func MakeRules() RuleGroups {
 		dataType:    "spans",
 		serviceName: "telemetry-trace-gateway-metrics",
 		namePrefix:  ruleNamePrefix(typeTracePipeline),
+		thresholds:  thresholds,
 	}
 	rules = append(rules, traceRuleBuilder.rules()...)
 
-	logRuleBuilder := fluentBitRuleBuilder{}
+	logRuleBuilder := fluentBitRuleBuilder{thresholds: thresholds}
 	rules = append(rules, logRuleBuilder.rules()...)
 
+	metricAgentRuleBuilder := metricAgentRuleBuilder{thresholds: thresholds}
+	rules = append(rules, metricAgentRuleBuilder.rules()...)
+
 	return RuleGroups{
 		Groups: []RuleGroup{
 			{
@@ -2,19 +2,24 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
 )
 
 func TestMakeRules(t *testing.T) {
-	rules := MakeRules()
+	rules := MakeRules(DefaultThresholds())
 
 	require.Len(t, rules.Groups, 1)
 
 	ruleGroup := rules.Groups[0]
 	require.Equal(t, "default", ruleGroup.Name)
 
-	require.Len(t, ruleGroup.Rules, 15)
+	require.Len(t, ruleGroup.Rules, 19)
 	require.Equal(t, "MetricGatewayExporterSentData", ruleGroup.Rules[0].Alert)
 	require.Equal(t, "sum by (pipeline_name) (rate(otelcol_exporter_sent_metric_points{service=\"telemetry-metric-gateway-metrics\"}[5m])) > 0", ruleGroup.Rules[0].Expr)
 
@@ -59,6 +64,70 @@ func TestMakeRules(t *testing.T) {
 
 	require.Equal(t, "LogAgentNoLogsDelivered", ruleGroup.Rules[14].Alert)
 	require.Equal(t, "(sum by (pipeline_name) (rate(fluentbit_input_bytes_total{service=\"telemetry-fluent-bit-metrics\"}[5m])) > 0) and (sum by (pipeline_name) (rate(fluentbit_output_proc_bytes_total{service=\"telemetry-fluent-bit-metrics\"}[5m])) == 0)", ruleGroup.Rules[14].Expr)
+
+	require.Equal(t, "LogAgentThrottling", ruleGroup.Rules[15].Alert)
+	require.Equal(t, "sum by (pipeline_name) (rate(fluentbit_filter_drop_records_total{service=\"telemetry-fluent-bit-metrics\",pipeline_name!=\"\"}[5m])) > 0", ruleGroup.Rules[15].Expr)
+
+	require.Equal(t, "MetricAgentExporterDroppedData", ruleGroup.Rules[16].Alert)
+	require.Equal(t, "sum(rate({__name__=~\"otelcol_exporter_send_failed_metric_points|otelcol_exporter_enqueue_failed_metric_points\",service=\"telemetry-metric-agent-metrics\",exporter=\"otlp\"}[5m])) > 0", ruleGroup.Rules[16].Expr)
+
+	require.Equal(t, "MetricAgentReceiverRefusedData", ruleGroup.Rules[17].Alert)
+	require.Equal(t, "sum(rate(otelcol_receiver_refused_metric_points{service=\"telemetry-metric-agent-metrics\"}[5m])) > 0", ruleGroup.Rules[17].Expr)
+
+	require.Equal(t, "MetricAgentScrapeTargetsDown", ruleGroup.Rules[18].Alert)
+	require.Equal(t, "count by (target_namespace) (scrape_target_up{job=~\"app-(pods|services)(-secure)?\"} == 0)", ruleGroup.Rules[18].Expr)
+	require.Equal(t, time.Minute, ruleGroup.Rules[18].For)
+}
+
+func TestMakeRulesWithThresholds(t *testing.T) {
+	rules := MakeRules(Thresholds{
+		QueueAlmostFullPercentage:      90,
+		DroppedDataTolerancePercentage: 5,
+		AlertFor:                       5 * time.Minute,
+	})
+
+	ruleGroup := rules.Groups[0]
+	require.Len(t, ruleGroup.Rules, 19)
+
+	require.Equal(t, "MetricGatewayExporterSentData", ruleGroup.Rules[0].Alert)
+	require.Zero(t, ruleGroup.Rules[0].For, "sent data is not a problem and is reported immediately")
+
+	require.Equal(t, "MetricGatewayExporterDroppedData", ruleGroup.Rules[1].Alert)
+	require.Equal(t, "sum by (pipeline_name) (rate(otelcol_exporter_send_failed_metric_points{service=\"telemetry-metric-gateway-metrics\"}[5m])) / "+
+		"(sum by (pipeline_name) (rate(otelcol_exporter_send_failed_metric_points{service=\"telemetry-metric-gateway-metrics\"}[5m])) + "+
+		"(sum by (pipeline_name) (rate(otelcol_exporter_sent_metric_points{service=\"telemetry-metric-gateway-metrics\"}[5m])) or "+
+		"sum by (pipeline_name) (rate(otelcol_exporter_send_failed_metric_points{service=\"telemetry-metric-gateway-metrics\"}[5m])) * 0)) > 0.05", ruleGroup.Rules[1].Expr)
+	require.Equal(t, 5*time.Minute, ruleGroup.Rules[1].For)
+
+	require.Equal(t, "MetricGatewayExporterQueueAlmostFull", ruleGroup.Rules[2].Alert)
+	require.Equal(t, "max by (pipeline_name) (otelcol_exporter_queue_size{service=\"telemetry-metric-gateway-metrics\"} / ignoring(data_type) otelcol_exporter_queue_capacity{service=\"telemetry-metric-gateway-metrics\"}) > 0.9", ruleGroup.Rules[2].Expr)
+	require.Equal(t, 5*time.Minute, ruleGroup.Rules[2].For)
+
+	require.Equal(t, "LogAgentExporterDroppedLogs", ruleGroup.Rules[11].Alert)
+	require.Equal(t, "sum by (pipeline_name) (rate(fluentbit_output_dropped_records_total{service=\"telemetry-fluent-bit-metrics\"}[5m])) / "+
+		"(sum by (pipeline_name) (rate(fluentbit_output_dropped_records_total{service=\"telemetry-fluent-bit-metrics\"}[5m])) + "+
+		"(sum by (pipeline_name) (rate(fluentbit_output_proc_records_total{service=\"telemetry-fluent-bit-metrics\"}[5m])) or "+
+		"sum by (pipeline_name) (rate(fluentbit_output_dropped_records_total{service=\"telemetry-fluent-bit-metrics\"}[5m])) * 0)) > 0.05", ruleGroup.Rules[11].Expr)
+
+	require.Equal(t, "LogAgentNoLogsDelivered", ruleGroup.Rules[14].Alert)
+	require.Equal(t, 5*time.Minute, ruleGroup.Rules[14].For)
+
+	require.Equal(t, "MetricAgentScrapeTargetsDown", ruleGroup.Rules[18].Alert)
+	require.Equal(t, 5*time.Minute, ruleGroup.Rules[18].For)
+}
+
+func TestThresholdsFor(t *testing.T) {
+	require.Equal(t, DefaultThresholds(), ThresholdsFor(nil))
+
+	thresholds := ThresholdsFor(&operatorv1alpha1.SelfMonitorSpec{
+		DroppedDataTolerancePercentage: ptr.To[int32](10),
+		AlertFor:                       &metav1.Duration{Duration: time.Minute},
+	})
+	require.Equal(t, Thresholds{
+		QueueAlmostFullPercentage:      80,
+		DroppedDataTolerancePercentage: 10,
+		AlertFor:                       time.Minute,
+	}, thresholds)
 }
 
 func TestMatchesLogPipelineRule(t *testing.T) {
@@ -0,0 +1,57 @@
+package config
+
+// StatisticsQueries are PromQL queries that return the recent throughput of a pipeline as a single sample.
+// An empty query means that the statistic is not available for the type of the pipeline.
+type StatisticsQueries struct {
+	Sent    string
+	Dropped string
+	Refused string
+	Queued  string
+}
+
+// TracePipelineStatisticsQueries returns the queries for the span throughput of the given trace pipeline.
+func TracePipelineStatisticsQueries(pipelineName string) StatisticsQueries {
+	return otelCollectorStatisticsQueries("spans", "telemetry-trace-gateway-metrics", pipelineName)
+}
+
+// MetricPipelineStatisticsQueries returns the queries for the metric point throughput of the given metric pipeline.
+func MetricPipelineStatisticsQueries(pipelineName string) StatisticsQueries {
+	return otelCollectorStatisticsQueries("metric_points", "telemetry-metric-gateway-metrics", pipelineName)
+}
+
+// LogPipelineStatisticsQueries returns the queries for the log record throughput of the given log pipeline.
+// Fluent Bit neither refuses logs nor has a per-pipeline queue, so only the sent and dropped rates are available.
+func LogPipelineStatisticsQueries(pipelineName string) StatisticsQueries {
+	return StatisticsQueries{
+		Sent: rate(metricFluentBitOutputProcRecordsTotal, selectService(fluentBitMetricsServiceName), selectPipeline(pipelineName)).
+			sum().
+			build(),
+		Dropped: rate(metricFluentBitOutputDroppedRecordsTotal, selectService(fluentBitMetricsServiceName), selectPipeline(pipelineName)).
+			sum().
+			build(),
+	}
+}
+
+func otelCollectorStatisticsQueries(dataType, serviceName, pipelineName string) StatisticsQueries {
+	rb := otelCollectorRuleBuilder{dataType: dataType, serviceName: serviceName}
+	droppedMetrics := selectMetricNames(
+		rb.formatMetricName(metricOtelCollectorExporterSendFailed),
+		rb.formatMetricName(metricOtelCollectorExporterEnqueueFailed),
+	)
+
+	return StatisticsQueries{
+		Sent: rate(rb.formatMetricName(metricOtelCollectorExporterSent), selectService(serviceName), selectPipeline(pipelineName)).
+			sum().
+			build(),
+		Dropped: rate("", droppedMetrics, selectService(serviceName), selectPipeline(pipelineName)).
+			sum().
+			build(),
+		// The receivers are shared by all pipelines of the gateway, so the refused data cannot be attributed to a single pipeline
+		Refused: rate(rb.formatMetricName(metricOtelCollectorReceiverRefused), selectService(serviceName)).
+			sum().
+			build(),
+		Queued: instant(metricOtelCollectorExporterQueueSize, selectService(serviceName), selectPipeline(pipelineName)).
+			sum().
+			build(),
+	}
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatisticsQueries(t *testing.T) {
+	tests := []struct {
+		name     string
+		queries  StatisticsQueries
+		expected StatisticsQueries
+	}{
+		{
+			name:    "trace pipeline",
+			queries: TracePipelineStatisticsQueries("my-pipeline"),
+			expected: StatisticsQueries{
+				Sent:    "sum(rate(otelcol_exporter_sent_spans{service=\"telemetry-trace-gateway-metrics\",pipeline_name=\"my-pipeline\"}[5m]))",
+				Dropped: "sum(rate({__name__=~\"otelcol_exporter_send_failed_spans|otelcol_exporter_enqueue_failed_spans\",service=\"telemetry-trace-gateway-metrics\",pipeline_name=\"my-pipeline\"}[5m]))",
+				Refused: "sum(rate(otelcol_receiver_refused_spans{service=\"telemetry-trace-gateway-metrics\"}[5m]))",
+				Queued:  "sum(otelcol_exporter_queue_size{service=\"telemetry-trace-gateway-metrics\",pipeline_name=\"my-pipeline\"})",
+			},
+		},
+		{
+			name:    "metric pipeline",
+			queries: MetricPipelineStatisticsQueries("my-pipeline"),
+			expected: StatisticsQueries{
+				Sent:    "sum(rate(otelcol_exporter_sent_metric_points{service=\"telemetry-metric-gateway-metrics\",pipeline_name=\"my-pipeline\"}[5m]))",
+				Dropped: "sum(rate({__name__=~\"otelcol_exporter_send_failed_metric_points|otelcol_exporter_enqueue_failed_metric_points\",service=\"telemetry-metric-gateway-metrics\",pipeline_name=\"my-pipeline\"}[5m]))",
+				Refused: "sum(rate(otelcol_receiver_refused_metric_points{service=\"telemetry-metric-gateway-metrics\"}[5m]))",
+				Queued:  "sum(otelcol_exporter_queue_size{service=\"telemetry-metric-gateway-metrics\",pipeline_name=\"my-pipeline\"})",
+			},
+		},
+		{
+			name:    "log pipeline",
+			queries: LogPipelineStatisticsQueries("my-pipeline"),
+			expected: StatisticsQueries{
+				Sent:    "sum(rate(fluentbit_output_proc_records_total{service=\"telemetry-fluent-bit-metrics\",pipeline_name=\"my-pipeline\"}[5m]))",
+				Dropped: "sum(rate(fluentbit_output_dropped_records_total{service=\"telemetry-fluent-bit-metrics\",pipeline_name=\"my-pipeline\"}[5m]))",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.queries)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kyma-project/telemetry-manager/internal/synthetic"
+)
+
+// TestDataQueries are PromQL queries that return a positive value if a pipeline exported its test data, or failed to export it, within a time window.
+// They only count the component that delivers the test data of the pipeline, so other data that the pipeline exports does not confirm the test data.
+type TestDataQueries struct {
+	Exported     string
+	ExportFailed string
+}
+
+// TracePipelineTestDataQueries returns the queries that tell whether the given trace pipeline exported its test span within the given window.
+func TracePipelineTestDataQueries(pipelineName string, window time.Duration) TestDataQueries {
+	return otelCollectorTestDataQueries("spans", "telemetry-trace-gateway-metrics", pipelineName, window)
+}
+
+// MetricPipelineTestDataQueries returns the queries that tell whether the given metric pipeline exported its test metric point within the given window.
+func MetricPipelineTestDataQueries(pipelineName string, window time.Duration) TestDataQueries {
+	return otelCollectorTestDataQueries("metric_points", "telemetry-metric-gateway-metrics", pipelineName, window)
+}
+
+// LogPipelineTestDataQueries returns the queries that tell whether the given log pipeline exported its test log record within the given window.
+func LogPipelineTestDataQueries(pipelineName string, window time.Duration) TestDataQueries {
+	window = testDataWindow(window)
+	selectTestDataOutput := selectLabel(labelName, synthetic.TestDataName(pipelineName))
+
+	return TestDataQueries{
+		Exported: increase(metricFluentBitOutputProcBytesTotal, window, selectService(fluentBitMetricsServiceName), selectPipeline(pipelineName), selectTestDataOutput).
+			sum().
+			build(),
+		ExportFailed: increase(metricFluentBitOutputDroppedRecordsTotal, window, selectService(fluentBitMetricsServiceName), selectPipeline(pipelineName), selectTestDataOutput).
+			sum().
+			build(),
+	}
+}
+
+func otelCollectorTestDataQueries(dataType, serviceName, pipelineName string, window time.Duration) TestDataQueries {
+	rb := otelCollectorRuleBuilder{dataType: dataType, serviceName: serviceName}
+	window = testDataWindow(window)
+	selectTestDataExporter := selectLabelMatch(labelExporter, ".+/"+synthetic.TestDataName(pipelineName))
+
+	return TestDataQueries{
+		Exported: increase(rb.formatMetricName(metricOtelCollectorExporterSent), window, selectService(serviceName), selectPipeline(pipelineName), selectTestDataExporter).
+			sum().
+			build(),
+		ExportFailed: increase(rb.formatMetricName(metricOtelCollectorExporterSendFailed), window, selectService(serviceName), selectPipeline(pipelineName), selectTestDataExporter).
+			sum().
+			build(),
+	}
+}
+
+// testDataWindow extends the window by one scrape interval, so that an export right after sending the test data is scraped in time,
+// and rounds it up to full seconds.
+func testDataWindow(window time.Duration) time.Duration {
+	return (window + defaultInterval).Truncate(time.Second) + time.Second
+}
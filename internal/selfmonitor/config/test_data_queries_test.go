@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestDataQueries(t *testing.T) {
+	tests := []struct {
+		name     string
+		queries  TestDataQueries
+		expected TestDataQueries
+	}{
+		{
+			name:    "trace pipeline",
+			queries: TracePipelineTestDataQueries("my-pipeline", 2*time.Minute),
+			expected: TestDataQueries{
+				Exported:     "sum(increase(otelcol_exporter_sent_spans{service=\"telemetry-trace-gateway-metrics\",pipeline_name=\"my-pipeline\",exporter=~\".+/my-pipeline_test-data\"}[2m31s]))",
+				ExportFailed: "sum(increase(otelcol_exporter_send_failed_spans{service=\"telemetry-trace-gateway-metrics\",pipeline_name=\"my-pipeline\",exporter=~\".+/my-pipeline_test-data\"}[2m31s]))",
+			},
+		},
+		{
+			name:    "metric pipeline",
+			queries: MetricPipelineTestDataQueries("my-pipeline", 1500*time.Millisecond),
+			expected: TestDataQueries{
+				Exported:     "sum(increase(otelcol_exporter_sent_metric_points{service=\"telemetry-metric-gateway-metrics\",pipeline_name=\"my-pipeline\",exporter=~\".+/my-pipeline_test-data\"}[32s]))",
+				ExportFailed: "sum(increase(otelcol_exporter_send_failed_metric_points{service=\"telemetry-metric-gateway-metrics\",pipeline_name=\"my-pipeline\",exporter=~\".+/my-pipeline_test-data\"}[32s]))",
+			},
+		},
+		{
+			name:    "log pipeline",
+			queries: LogPipelineTestDataQueries("my-pipeline", time.Minute),
+			expected: TestDataQueries{
+				Exported:     "sum(increase(fluentbit_output_proc_bytes_total{service=\"telemetry-fluent-bit-metrics\",pipeline_name=\"my-pipeline\",name=\"my-pipeline_test-data\"}[1m31s]))",
+				ExportFailed: "sum(increase(fluentbit_output_dropped_records_total{service=\"telemetry-fluent-bit-metrics\",pipeline_name=\"my-pipeline\",name=\"my-pipeline_test-data\"}[1m31s]))",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.queries)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"time"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+)
+
+const defaultQueueAlmostFullPercentage = 80
+
+// Thresholds tune when the alert rules of the self monitor fire.
+type Thresholds struct {
+	// QueueAlmostFullPercentage is the fill level of an export queue, in percent, from which the queue is considered almost full.
+	QueueAlmostFullPercentage int32
+	// DroppedDataTolerancePercentage is the share of data, in percent, that a pipeline can drop without firing the dropped data alerts.
+	DroppedDataTolerancePercentage int32
+	// AlertFor is the time that the condition of an alert must hold before the alert fires. If zero, the default of each rule applies.
+	AlertFor time.Duration
+}
+
+func DefaultThresholds() Thresholds {
+	return Thresholds{
+		QueueAlmostFullPercentage: defaultQueueAlmostFullPercentage,
+	}
+}
+
+// ThresholdsFor returns the thresholds that are configured in the given Telemetry spec, falling back to the defaults for unset values.
+func ThresholdsFor(spec *operatorv1alpha1.SelfMonitorSpec) Thresholds {
+	thresholds := DefaultThresholds()
+	if spec == nil {
+		return thresholds
+	}
+
+	if spec.QueueAlmostFullPercentage != nil {
+		thresholds.QueueAlmostFullPercentage = *spec.QueueAlmostFullPercentage
+	}
+
+	if spec.DroppedDataTolerancePercentage != nil {
+		thresholds.DroppedDataTolerancePercentage = *spec.DroppedDataTolerancePercentage
+	}
+
+	if spec.AlertFor != nil {
+		thresholds.AlertFor = spec.AlertFor.Duration
+	}
+
+	return thresholds
+}
+
+// IsQueueAlmostFullCustomized returns whether the queue threshold differs from the default.
+func (t Thresholds) IsQueueAlmostFullCustomized() bool {
+	return t.QueueAlmostFullPercentage != defaultQueueAlmostFullPercentage
+}
+
+// alertFor returns the wait time of a rule, which is the configured one or the default of the rule.
+func (t Thresholds) alertFor(ruleDefault time.Duration) time.Duration {
+	if t.AlertFor > 0 {
+		return t.AlertFor
+	}
+
+	return ruleDefault
+}
+
+func percentageToRatio(percentage int32) float64 {
+	return float64(percentage) / 100 //nolint:mnd // percent
+}
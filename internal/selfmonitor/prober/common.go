@@ -3,16 +3,23 @@ package prober
 import (
 	"context"
 	"fmt"
+	"time"
 
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
 )
 
 type alertGetter interface {
 	Alerts(ctx context.Context) (promv1.AlertsResult, error)
 }
 
+type querier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promv1.Option) (model.Value, promv1.Warnings, error)
+}
+
 type PipelineProbeResult struct {
 	AllDataDropped  bool
 	SomeDataDropped bool
@@ -42,6 +49,115 @@ func isFiringWithMatcher(alerts []promv1.Alert, ruleName, pipelineName string, m
 	return false
 }
 
+// TestDataProbeResult tells whether a pipeline exported data, or failed to export data, since its test data was sent.
+type TestDataProbeResult struct {
+	Exported     bool
+	ExportFailed bool
+}
+
+type testDataQueriesFunc func(pipelineName string, window time.Duration) config.TestDataQueries
+
+func probeTestData(ctx context.Context, q querier, queries config.TestDataQueries) (TestDataProbeResult, error) {
+	exported, err := queryPositive(ctx, q, queries.Exported)
+	if err != nil {
+		return TestDataProbeResult{}, err
+	}
+
+	exportFailed, err := queryPositive(ctx, q, queries.ExportFailed)
+	if err != nil {
+		return TestDataProbeResult{}, err
+	}
+
+	return TestDataProbeResult{
+		Exported:     exported,
+		ExportFailed: exportFailed,
+	}, nil
+}
+
+// StatisticsProbeResult holds the recent throughput of a pipeline. Statistics that are not available for the type of the pipeline are nil.
+type StatisticsProbeResult struct {
+	SentPerSecond    *float64
+	DroppedPerSecond *float64
+	RefusedPerSecond *float64
+	Queued           *float64
+}
+
+type statisticsQueriesFunc func(pipelineName string) config.StatisticsQueries
+
+func probeStatistics(ctx context.Context, q querier, queries config.StatisticsQueries) (StatisticsProbeResult, error) {
+	var result StatisticsProbeResult
+
+	for _, statistic := range []struct {
+		query string
+		dest  **float64
+	}{
+		{queries.Sent, &result.SentPerSecond},
+		{queries.Dropped, &result.DroppedPerSecond},
+		{queries.Refused, &result.RefusedPerSecond},
+		{queries.Queued, &result.Queued},
+	} {
+		if statistic.query == "" {
+			continue
+		}
+
+		value, err := querySum(ctx, q, statistic.query)
+		if err != nil {
+			return StatisticsProbeResult{}, err
+		}
+
+		*statistic.dest = &value
+	}
+
+	return result, nil
+}
+
+// queryPositive runs an instant query and reports whether any of the returned samples is greater than zero.
+func queryPositive(ctx context.Context, q querier, query string) (bool, error) {
+	vector, err := queryVector(ctx, q, query)
+	if err != nil {
+		return false, err
+	}
+
+	for _, sample := range vector {
+		if sample.Value > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// querySum runs an instant query and returns the sum of the returned samples, which is zero if no time series matches.
+func querySum(ctx context.Context, q querier, query string) (float64, error) {
+	vector, err := queryVector(ctx, q, query)
+	if err != nil {
+		return 0, err
+	}
+
+	var sum float64
+	for _, sample := range vector {
+		sum += float64(sample.Value)
+	}
+
+	return sum, nil
+}
+
+func queryVector(ctx context.Context, q querier, query string) (model.Vector, error) {
+	value, _, err := q.Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Prometheus: %w", err)
+	}
+
+	logf.FromContext(ctx).V(1).Info("Queried Prometheus", "query", query, "result", value)
+
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected query result type: %s", value.Type())
+	}
+
+	return vector, nil
+}
+
 func toRawLabels(ls model.LabelSet) map[string]string {
 	rawLabels := make(map[string]string, len(ls))
 	for k, v := range ls {
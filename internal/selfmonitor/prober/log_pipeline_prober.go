@@ -3,6 +3,7 @@ package prober
 import (
 	"context"
 	"fmt"
+	"time"
 
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -11,7 +12,8 @@ import (
 )
 
 type LogPipelineProber struct {
-	getter alertGetter
+	getter  alertGetter
+	querier querier
 }
 
 type LogPipelineProbeResult struct {
@@ -19,6 +21,7 @@ type LogPipelineProbeResult struct {
 
 	NoLogsDelivered bool
 	BufferFillingUp bool
+	Throttling      bool
 }
 
 func NewLogPipelineProber(selfMonitorName types.NamespacedName) (*LogPipelineProber, error) {
@@ -28,7 +31,8 @@ func NewLogPipelineProber(selfMonitorName types.NamespacedName) (*LogPipelinePro
 	}
 
 	return &LogPipelineProber{
-		getter: promClient,
+		getter:  promClient,
+		querier: promClient,
 	}, nil
 }
 
@@ -46,9 +50,20 @@ func (p *LogPipelineProber) Probe(ctx context.Context, pipelineName string) (Log
 		},
 		NoLogsDelivered: p.noLogsDelivered(alerts, pipelineName),
 		BufferFillingUp: p.bufferFillingUp(alerts, pipelineName),
+		Throttling:      p.throttling(alerts, pipelineName),
 	}, nil
 }
 
+// ProbeTestData checks whether the given pipeline exported logs, or failed to export logs, since the test data was sent at the given time.
+func (p *LogPipelineProber) ProbeTestData(ctx context.Context, pipelineName string, sentAt time.Time) (TestDataProbeResult, error) {
+	return probeTestData(ctx, p.querier, config.LogPipelineTestDataQueries(pipelineName, time.Since(sentAt)))
+}
+
+// ProbeStatistics queries the recent log record throughput of the given pipeline.
+func (p *LogPipelineProber) ProbeStatistics(ctx context.Context, pipelineName string) (StatisticsProbeResult, error) {
+	return probeStatistics(ctx, p.querier, config.LogPipelineStatisticsQueries(pipelineName))
+}
+
 func (p *LogPipelineProber) allDataDropped(alerts []promv1.Alert, pipelineName string) bool {
 	exporterSentLogs := p.isFiring(alerts, config.RuleNameLogAgentExporterSentLogs, pipelineName)
 	exporterDroppedLogs := p.isFiring(alerts, config.RuleNameLogAgentExporterDroppedLogs, pipelineName)
@@ -73,14 +88,19 @@ func (p *LogPipelineProber) bufferFillingUp(alerts []promv1.Alert, pipelineName
 	return p.isFiring(alerts, config.RuleNameLogAgentBufferInUse, pipelineName)
 }
 
+func (p *LogPipelineProber) throttling(alerts []promv1.Alert, pipelineName string) bool {
+	return p.isFiring(alerts, config.RuleNameLogAgentThrottling, pipelineName)
+}
+
 func (p *LogPipelineProber) healthy(alerts []promv1.Alert, pipelineName string) bool {
 	// The pipeline is healthy if none of the following conditions are met:
 	bufferInUse := p.isFiring(alerts, config.RuleNameLogAgentBufferInUse, pipelineName)
 	bufferFull := p.isFiring(alerts, config.RuleNameLogAgentBufferFull, pipelineName)
 	exporterDroppedLogs := p.isFiring(alerts, config.RuleNameLogAgentExporterDroppedLogs, pipelineName)
 	noLogsDelivered := p.isFiring(alerts, config.RuleNameLogAgentNoLogsDelivered, pipelineName)
+	throttling := p.isFiring(alerts, config.RuleNameLogAgentThrottling, pipelineName)
 
-	return !(bufferInUse || bufferFull || exporterDroppedLogs || noLogsDelivered)
+	return !(bufferInUse || bufferFull || exporterDroppedLogs || noLogsDelivered || throttling)
 }
 
 func (p *LogPipelineProber) isFiring(alerts []promv1.Alert, ruleName, pipelineName string) bool {
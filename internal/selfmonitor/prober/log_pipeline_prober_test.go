@@ -258,6 +258,24 @@ func TestLogPipelineProber(t *testing.T) {
 				NoLogsDelivered: true,
 			},
 		},
+		{
+			name:         "throttling firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "LogAgentThrottling",
+							"pipeline_name": "cls",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: LogPipelineProbeResult{
+				Throttling: true,
+			},
+		},
 		{
 			name:         "exporter sent logs firing",
 			pipelineName: "cls",
@@ -0,0 +1,162 @@
+package prober
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+const (
+	oauth2TokenProbeInterval = 5 * time.Minute
+	oauth2TokenProbeTimeout  = 10 * time.Second
+)
+
+var (
+	ErrOAuth2NotConfigured    = errors.New("output does not use OAuth2 authentication")
+	ErrOAuth2TokenFetchFailed = errors.New("failed to fetch OAuth2 access token")
+)
+
+// OAuth2TokenProber checks whether an access token can be fetched for an OTLP output that authenticates with OAuth2 client credentials.
+// The OTel Collector metrics do not tell failures of the token endpoint apart from failures of the backend,
+// so the token is requested the same way the oauth2client extension of the gateway requests it.
+// Token requests reach the identity provider of the customer, so they are rate-limited: the result for a pipeline is cached for the probe interval,
+// unless the resolved credentials of the pipeline change, for example, because the Secret was rotated.
+type OAuth2TokenProber struct {
+	Reader     client.Reader
+	HTTPClient *http.Client
+	Interval   time.Duration
+
+	now     func() time.Time
+	mu      sync.Mutex
+	results map[string]cachedProbeResult
+}
+
+func NewOAuth2TokenProber(reader client.Reader) *OAuth2TokenProber {
+	return &OAuth2TokenProber{
+		Reader:     reader,
+		HTTPClient: &http.Client{Timeout: oauth2TokenProbeTimeout},
+		Interval:   oauth2TokenProbeInterval,
+		now:        time.Now,
+		results:    make(map[string]cachedProbeResult),
+	}
+}
+
+// Probe checks whether an access token can be fetched for the output of the given pipeline. A recent result is returned without requesting a token again.
+func (p *OAuth2TokenProber) Probe(ctx context.Context, pipelineName string, output *telemetryv1alpha1.OtlpOutput) error {
+	if output == nil || output.Authentication == nil || !output.Authentication.OAuth2.IsDefined() {
+		return ErrOAuth2NotConfigured
+	}
+
+	cfg, err := p.clientCredentialsConfig(ctx, output.Authentication.OAuth2)
+	if err != nil {
+		return err
+	}
+
+	fingerprint := clientCredentialsFingerprint(cfg)
+
+	p.mu.Lock()
+	now := p.now()
+	p.pruneResults(now)
+
+	if result, found := p.results[pipelineName]; found && result.fingerprint == fingerprint {
+		p.mu.Unlock()
+		return result.err
+	}
+	p.mu.Unlock()
+
+	err = p.fetchToken(ctx, cfg)
+
+	p.mu.Lock()
+	p.results[pipelineName] = cachedProbeResult{fingerprint: fingerprint, probedAt: now, err: err}
+	p.mu.Unlock()
+
+	return err
+}
+
+// pruneResults removes the results that are older than the probe interval, including those of deleted pipelines. The caller must hold the lock.
+func (p *OAuth2TokenProber) pruneResults(now time.Time) {
+	for pipelineName, result := range p.results {
+		if now.Sub(result.probedAt) >= p.Interval {
+			delete(p.results, pipelineName)
+		}
+	}
+}
+
+func (p *OAuth2TokenProber) clientCredentialsConfig(ctx context.Context, oauth2Options *telemetryv1alpha1.OAuth2Options) (*clientcredentials.Config, error) {
+	tokenURL, err := p.resolveValue(ctx, oauth2Options.TokenURL)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID, err := p.resolveValue(ctx, oauth2Options.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientSecret, err := p.resolveValue(ctx, oauth2Options.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       oauth2Options.Scopes,
+	}
+
+	if oauth2Options.Audience != "" {
+		cfg.EndpointParams = url.Values{"audience": {oauth2Options.Audience}}
+	}
+
+	return cfg, nil
+}
+
+func (p *OAuth2TokenProber) fetchToken(ctx context.Context, cfg *clientcredentials.Config) error {
+	if p.HTTPClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, p.HTTPClient)
+	}
+
+	if _, err := cfg.Token(ctx); err != nil {
+		return fmt.Errorf("%w: %w", ErrOAuth2TokenFetchFailed, err)
+	}
+
+	return nil
+}
+
+// clientCredentialsFingerprint identifies the resolved credentials, so that changed credentials are probed again before the probe interval elapses.
+func clientCredentialsFingerprint(cfg *clientcredentials.Config) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%q|%q|%q|%q|%q", cfg.TokenURL, cfg.ClientID, cfg.ClientSecret, cfg.Scopes, cfg.EndpointParams.Encode())
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func (p *OAuth2TokenProber) resolveValue(ctx context.Context, value telemetryv1alpha1.ValueType) (string, error) {
+	if value.Value != "" {
+		return value.Value, nil
+	}
+
+	if value.ValueFrom == nil || !value.ValueFrom.IsSecretKeyRef() {
+		return "", fmt.Errorf("%w: value is not defined", ErrOAuth2TokenFetchFailed)
+	}
+
+	resolved, err := secretref.GetValue(ctx, p.Reader, *value.ValueFrom.SecretKeyRef)
+	if err != nil {
+		return "", err
+	}
+
+	return string(resolved), nil
+}
@@ -0,0 +1,157 @@
+package prober
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestOAuth2TokenProber(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "client-id" || clientSecret != "client-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("audience") != "backend" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "oauth2-client",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"client-secret": []byte("client-secret"),
+		},
+	}
+
+	makeOutput := func(tokenURL, clientSecretKey string) *telemetryv1alpha1.OtlpOutput {
+		return &telemetryv1alpha1.OtlpOutput{
+			Endpoint: telemetryv1alpha1.ValueType{Value: "https://backend.example.com:4317"},
+			Authentication: &telemetryv1alpha1.AuthenticationOptions{
+				OAuth2: &telemetryv1alpha1.OAuth2Options{
+					TokenURL: telemetryv1alpha1.ValueType{Value: tokenURL},
+					ClientID: telemetryv1alpha1.ValueType{Value: "client-id"},
+					ClientSecret: telemetryv1alpha1.ValueType{
+						ValueFrom: &telemetryv1alpha1.ValueFromSource{
+							SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{
+								Name:      "oauth2-client",
+								Namespace: "default",
+								Key:       clientSecretKey,
+							},
+						},
+					},
+					Audience: "backend",
+				},
+			},
+		}
+	}
+
+	sut := NewOAuth2TokenProber(fake.NewClientBuilder().WithObjects(secret).Build())
+
+	t.Run("token fetched", func(t *testing.T) {
+		err := sut.Probe(context.Background(), "test", makeOutput(tokenServer.URL, "client-secret"))
+		require.NoError(t, err)
+	})
+
+	t.Run("token endpoint rejects credentials", func(t *testing.T) {
+		secret := secret.DeepCopy()
+		secret.Data["client-secret"] = []byte("wrong")
+
+		sut := NewOAuth2TokenProber(fake.NewClientBuilder().WithObjects(secret).Build())
+		err := sut.Probe(context.Background(), "test", makeOutput(tokenServer.URL, "client-secret"))
+		require.ErrorIs(t, err, ErrOAuth2TokenFetchFailed)
+	})
+
+	t.Run("token endpoint not reachable", func(t *testing.T) {
+		err := sut.Probe(context.Background(), "test", makeOutput("http://127.0.0.1:1/token", "client-secret"))
+		require.ErrorIs(t, err, ErrOAuth2TokenFetchFailed)
+	})
+
+	t.Run("secret key missing", func(t *testing.T) {
+		err := sut.Probe(context.Background(), "test", makeOutput(tokenServer.URL, "missing"))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrOAuth2TokenFetchFailed)
+	})
+
+	t.Run("oauth2 not configured", func(t *testing.T) {
+		err := sut.Probe(context.Background(), "test", &telemetryv1alpha1.OtlpOutput{})
+		require.ErrorIs(t, err, ErrOAuth2NotConfigured)
+	})
+}
+
+func TestOAuth2TokenProberCachesResults(t *testing.T) {
+	var requests int
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "oauth2-client",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"client-secret": []byte("client-secret"),
+		},
+	}
+	output := &telemetryv1alpha1.OtlpOutput{
+		Endpoint: telemetryv1alpha1.ValueType{Value: "https://backend.example.com:4317"},
+		Authentication: &telemetryv1alpha1.AuthenticationOptions{
+			OAuth2: &telemetryv1alpha1.OAuth2Options{
+				TokenURL: telemetryv1alpha1.ValueType{Value: tokenServer.URL},
+				ClientID: telemetryv1alpha1.ValueType{Value: "client-id"},
+				ClientSecret: telemetryv1alpha1.ValueType{
+					ValueFrom: &telemetryv1alpha1.ValueFromSource{
+						SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{Name: "oauth2-client", Namespace: "default", Key: "client-secret"},
+					},
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+	sut := NewOAuth2TokenProber(fakeClient)
+
+	now := time.Now()
+	sut.now = func() time.Time { return now }
+
+	require.NoError(t, sut.Probe(context.Background(), "test", output))
+	require.NoError(t, sut.Probe(context.Background(), "test", output))
+	require.Equal(t, 1, requests, "a recent result must be reused")
+
+	require.NoError(t, sut.Probe(context.Background(), "other", output))
+	require.Equal(t, 2, requests, "results must be cached per pipeline")
+
+	secret.Data["client-secret"] = []byte("rotated")
+	require.NoError(t, fakeClient.Update(context.Background(), secret))
+	require.NoError(t, sut.Probe(context.Background(), "test", output))
+	require.Equal(t, 3, requests, "rotated credentials must be probed again")
+
+	now = now.Add(sut.Interval)
+	require.NoError(t, sut.Probe(context.Background(), "test", output))
+	require.Equal(t, 4, requests, "an expired result must be probed again")
+}
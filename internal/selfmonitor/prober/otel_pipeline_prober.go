@@ -3,6 +3,8 @@ package prober
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -12,8 +14,11 @@ import (
 
 // OTelPipelineProber is a prober for OTel Collector pipelines
 type OTelPipelineProber struct {
-	getter  alertGetter
-	matcher matcherFunc
+	getter            alertGetter
+	matcher           matcherFunc
+	querier           querier
+	testDataQueries   testDataQueriesFunc
+	statisticsQueries statisticsQueriesFunc
 }
 
 type OTelPipelineProbeResult struct {
@@ -21,25 +26,34 @@ type OTelPipelineProbeResult struct {
 
 	QueueAlmostFull bool
 	Throttling      bool
+
+	// AgentExportFailing and AgentThrottling report problems of the metric agent, which are not related to a particular pipeline
+	AgentExportFailing bool
+	AgentThrottling    bool
+	// ScrapeTargetsDown holds the number of Prometheus scrape targets of the metric agent that are down, by Namespace
+	ScrapeTargetsDown map[string]int
 }
 
 func NewMetricPipelineProber(selfMonitorName types.NamespacedName) (*OTelPipelineProber, error) {
-	return newOTelPipelineProber(selfMonitorName, config.MatchesMetricPipelineRule)
+	return newOTelPipelineProber(selfMonitorName, config.MatchesMetricPipelineRule, config.MetricPipelineTestDataQueries, config.MetricPipelineStatisticsQueries)
 }
 
 func NewTracePipelineProber(selfMonitorName types.NamespacedName) (*OTelPipelineProber, error) {
-	return newOTelPipelineProber(selfMonitorName, config.MatchesTracePipelineRule)
+	return newOTelPipelineProber(selfMonitorName, config.MatchesTracePipelineRule, config.TracePipelineTestDataQueries, config.TracePipelineStatisticsQueries)
 }
 
-func newOTelPipelineProber(selfMonitorName types.NamespacedName, matcher matcherFunc) (*OTelPipelineProber, error) {
+func newOTelPipelineProber(selfMonitorName types.NamespacedName, matcher matcherFunc, testDataQueries testDataQueriesFunc, statisticsQueries statisticsQueriesFunc) (*OTelPipelineProber, error) {
 	promClient, err := newPrometheusClient(selfMonitorName)
 	if err != nil {
 		return nil, err
 	}
 
 	return &OTelPipelineProber{
-		getter:  promClient,
-		matcher: matcher,
+		getter:            promClient,
+		matcher:           matcher,
+		querier:           promClient,
+		testDataQueries:   testDataQueries,
+		statisticsQueries: statisticsQueries,
 	}, nil
 }
 
@@ -55,11 +69,24 @@ func (p *OTelPipelineProber) Probe(ctx context.Context, pipelineName string) (OT
 			SomeDataDropped: p.someDataDropped(alerts, pipelineName),
 			Healthy:         p.healthy(alerts, pipelineName),
 		},
-		QueueAlmostFull: p.queueAlmostFull(alerts, pipelineName),
-		Throttling:      p.throttling(alerts, pipelineName),
+		QueueAlmostFull:    p.queueAlmostFull(alerts, pipelineName),
+		Throttling:         p.throttling(alerts, pipelineName),
+		AgentExportFailing: p.isFiring(alerts, config.RuleNameMetricAgentExporterDroppedData, pipelineName),
+		AgentThrottling:    p.isFiring(alerts, config.RuleNameMetricAgentReceiverRefusedData, pipelineName),
+		ScrapeTargetsDown:  p.scrapeTargetsDown(alerts, pipelineName),
 	}, nil
 }
 
+// ProbeTestData checks whether the given pipeline exported data, or failed to export data, since the test data was sent at the given time.
+func (p *OTelPipelineProber) ProbeTestData(ctx context.Context, pipelineName string, sentAt time.Time) (TestDataProbeResult, error) {
+	return probeTestData(ctx, p.querier, p.testDataQueries(pipelineName, time.Since(sentAt)))
+}
+
+// ProbeStatistics queries the recent throughput of the given pipeline.
+func (p *OTelPipelineProber) ProbeStatistics(ctx context.Context, pipelineName string) (StatisticsProbeResult, error) {
+	return probeStatistics(ctx, p.querier, p.statisticsQueries(pipelineName))
+}
+
 func (p *OTelPipelineProber) allDataDropped(alerts []promv1.Alert, pipelineName string) bool {
 	exporterSentData := p.isFiring(alerts, config.RuleNameGatewayExporterSentData, pipelineName)
 	exporterDroppedData := p.isFiring(alerts, config.RuleNameGatewayExporterDroppedData, pipelineName)
@@ -91,6 +118,31 @@ func (p *OTelPipelineProber) healthy(alerts []promv1.Alert, pipelineName string)
 		p.isFiring(alerts, config.RuleNameGatewayReceiverRefusedData, pipelineName))
 }
 
+// scrapeTargetsDown collects the number of scrape targets that are down from the values of the firing alerts, which are reported per Namespace.
+func (p *OTelPipelineProber) scrapeTargetsDown(alerts []promv1.Alert, pipelineName string) map[string]int {
+	var targetsDown map[string]int
+
+	for _, alert := range alerts {
+		labels := toRawLabels(alert.Labels)
+		if alert.State != promv1.AlertStateFiring || !p.matcher(labels, config.RuleNameMetricAgentScrapeTargetsDown, pipelineName) {
+			continue
+		}
+
+		count, err := strconv.ParseFloat(alert.Value, 64)
+		if err != nil || count < 1 {
+			continue
+		}
+
+		if targetsDown == nil {
+			targetsDown = make(map[string]int)
+		}
+
+		targetsDown[labels[config.LabelTargetNamespace]] += int(count)
+	}
+
+	return targetsDown
+}
+
 func (p *OTelPipelineProber) isFiring(alerts []promv1.Alert, ruleName, pipelineName string) bool {
 	return isFiringWithMatcher(alerts, ruleName, pipelineName, p.matcher)
 }
@@ -2,7 +2,10 @@ package prober
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
@@ -10,6 +13,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober/mocks"
 )
@@ -351,3 +355,144 @@ func TestOTelPipelineProber(t *testing.T) {
 		})
 	}
 }
+
+func TestMetricPipelineProberAgentAlerts(t *testing.T) {
+	sut, err := NewMetricPipelineProber(types.NamespacedName{Name: "test"})
+	require.NoError(t, err)
+
+	alertGetterMock := &mocks.AlertGetter{}
+	alertGetterMock.On("Alerts", mock.Anything).Return(promv1.AlertsResult{
+		Alerts: []promv1.Alert{
+			{
+				Labels: model.LabelSet{"alertname": "MetricAgentExporterDroppedData"},
+				State:  promv1.AlertStateFiring,
+			},
+			{
+				Labels: model.LabelSet{"alertname": "MetricAgentReceiverRefusedData"},
+				State:  promv1.AlertStatePending,
+			},
+			{
+				Labels: model.LabelSet{"alertname": "MetricAgentScrapeTargetsDown", "target_namespace": "default"},
+				State:  promv1.AlertStateFiring,
+				Value:  "3e+00",
+			},
+			{
+				Labels: model.LabelSet{"alertname": "MetricAgentScrapeTargetsDown", "target_namespace": "shop"},
+				State:  promv1.AlertStateFiring,
+				Value:  "1",
+			},
+			{
+				Labels: model.LabelSet{"alertname": "MetricAgentScrapeTargetsDown", "target_namespace": "pending"},
+				State:  promv1.AlertStatePending,
+				Value:  "2",
+			},
+		},
+	}, nil)
+
+	sut.getter = alertGetterMock
+
+	result, err := sut.Probe(context.Background(), "cls")
+	require.NoError(t, err)
+
+	require.True(t, result.Healthy, "agent alerts do not affect the health of the gateway flow")
+	require.True(t, result.AgentExportFailing)
+	require.False(t, result.AgentThrottling)
+	require.Equal(t, map[string]int{"default": 3, "shop": 1}, result.ScrapeTargetsDown)
+}
+
+func TestOTelPipelineProberTestData(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sent       model.Vector
+		sendFailed model.Vector
+		queryErr   error
+		expected   TestDataProbeResult
+		expectErr  bool
+	}{
+		{
+			name:       "no export",
+			sent:       model.Vector{},
+			sendFailed: model.Vector{},
+			expected:   TestDataProbeResult{},
+		},
+		{
+			name:       "exported",
+			sent:       model.Vector{{Value: 12}},
+			sendFailed: model.Vector{{Value: 0}},
+			expected:   TestDataProbeResult{Exported: true},
+		},
+		{
+			name:       "export failed",
+			sent:       model.Vector{{Value: 0}},
+			sendFailed: model.Vector{{Value: 3}},
+			expected:   TestDataProbeResult{ExportFailed: true},
+		},
+		{
+			name:      "query error",
+			queryErr:  errors.New("prometheus unavailable"),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut, err := NewTracePipelineProber(types.NamespacedName{})
+			require.NoError(t, err)
+
+			querierMock := &mocks.Querier{}
+			if tc.queryErr != nil {
+				querierMock.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, tc.queryErr)
+			} else {
+				querierMock.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "otelcol_exporter_sent_spans") && strings.Contains(query, `pipeline_name="cls"`)
+				}), mock.Anything).Return(tc.sent, nil, nil)
+				querierMock.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "otelcol_exporter_send_failed_spans") && strings.Contains(query, `pipeline_name="cls"`)
+				}), mock.Anything).Return(tc.sendFailed, nil, nil)
+			}
+
+			sut.querier = querierMock
+
+			result, err := sut.ProbeTestData(context.Background(), "cls", time.Now().Add(-time.Minute))
+
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestOTelPipelineProberStatistics(t *testing.T) {
+	sut, err := NewMetricPipelineProber(types.NamespacedName{})
+	require.NoError(t, err)
+
+	querierMock := &mocks.Querier{}
+	querierMock.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "otelcol_exporter_sent_metric_points")
+	}), mock.Anything).Return(model.Vector{{Value: 12.5}}, nil, nil)
+	querierMock.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "otelcol_exporter_send_failed_metric_points")
+	}), mock.Anything).Return(model.Vector{}, nil, nil)
+	querierMock.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "otelcol_receiver_refused_metric_points")
+	}), mock.Anything).Return(model.Vector{{Value: 1}, {Value: 2}}, nil, nil)
+	querierMock.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "otelcol_exporter_queue_size")
+	}), mock.Anything).Return(model.Vector{{Value: 4}}, nil, nil)
+
+	sut.querier = querierMock
+
+	result, err := sut.ProbeStatistics(context.Background(), "cls")
+	require.NoError(t, err)
+	assert.Equal(t, StatisticsProbeResult{
+		SentPerSecond:    ptr.To(12.5),
+		DroppedPerSecond: ptr.To(0.0),
+		RefusedPerSecond: ptr.To(3.0),
+		Queued:           ptr.To(4.0),
+	}, result)
+
+	querierMock.AssertExpectations(t)
+}
@@ -0,0 +1,325 @@
+package prober
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	outputReachabilityProbeInterval = 5 * time.Minute
+	outputReachabilityProbeTimeout  = 3 * time.Second
+)
+
+var (
+	ErrOutputProbeNotSupported = errors.New("output does not support reachability probing")
+	ErrDNSResolutionFailed     = errors.New("DNS resolution failed")
+	ErrConnectionRefused       = errors.New("connection refused")
+	ErrConnectionFailed        = errors.New("connection failed")
+	ErrProxyConnectionFailed   = errors.New("connection through proxy failed")
+	ErrTLSVerificationFailed   = errors.New("TLS certificate verification failed")
+	ErrTLSHandshakeFailed      = errors.New("TLS handshake failed")
+)
+
+// OutputTarget describes how the backend of a pipeline output is connected.
+type OutputTarget struct {
+	// Addresses are the backend addresses in the format `host:port`. The backend is reachable if any of them is reachable.
+	Addresses []string
+	// TLS is the TLS configuration of the connection, or nil for plaintext connections.
+	TLS *OutputTargetTLS
+	// ProxyURL is the URL of the HTTP proxy that tunnels the connection, or empty for direct connections.
+	ProxyURL string
+}
+
+// OutputTargetTLS holds the TLS settings of an output in resolved form.
+type OutputTargetTLS struct {
+	CA                 []byte
+	Cert               []byte
+	Key                []byte
+	InsecureSkipVerify bool
+	MinVersion         uint16
+	MaxVersion         uint16
+}
+
+// OutputReachabilityProber checks whether the backend of a pipeline output can be reached from the cluster by resolving its host name,
+// opening a TCP connection, and performing a TLS handshake against the configured CA.
+// Data that cannot be delivered is only detected by the self-monitor after several minutes, so a wrong host name or CA shows up much earlier this way.
+// Probes are rate-limited: the result for a pipeline is cached for the probe interval, unless the target of the pipeline changes.
+// A probe runs as part of the status update, so it is bounded by a short timeout that covers all addresses of the target.
+type OutputReachabilityProber struct {
+	Dialer   *net.Dialer
+	Resolver *net.Resolver
+	Interval time.Duration
+	Timeout  time.Duration
+
+	now     func() time.Time
+	mu      sync.Mutex
+	results map[string]cachedProbeResult
+}
+
+type cachedProbeResult struct {
+	fingerprint string
+	probedAt    time.Time
+	err         error
+}
+
+func NewOutputReachabilityProber() *OutputReachabilityProber {
+	return &OutputReachabilityProber{
+		Dialer:   &net.Dialer{},
+		Resolver: net.DefaultResolver,
+		Interval: outputReachabilityProbeInterval,
+		Timeout:  outputReachabilityProbeTimeout,
+		now:      time.Now,
+		results:  make(map[string]cachedProbeResult),
+	}
+}
+
+// Probe checks whether the target of the given pipeline is reachable. A recent result is returned without probing again.
+func (p *OutputReachabilityProber) Probe(ctx context.Context, pipelineName string, target *OutputTarget) error {
+	fingerprint := target.fingerprint()
+
+	p.mu.Lock()
+	now := p.now()
+	p.pruneResults(now)
+
+	if result, found := p.results[pipelineName]; found && result.fingerprint == fingerprint {
+		p.mu.Unlock()
+		return result.err
+	}
+	p.mu.Unlock()
+
+	err := p.probe(ctx, target)
+
+	p.mu.Lock()
+	p.results[pipelineName] = cachedProbeResult{fingerprint: fingerprint, probedAt: now, err: err}
+	p.mu.Unlock()
+
+	return err
+}
+
+// pruneResults removes the results that are older than the probe interval, including those of deleted pipelines. The caller must hold the lock.
+func (p *OutputReachabilityProber) pruneResults(now time.Time) {
+	for pipelineName, result := range p.results {
+		if now.Sub(result.probedAt) >= p.Interval {
+			delete(p.results, pipelineName)
+		}
+	}
+}
+
+func (p *OutputReachabilityProber) probe(ctx context.Context, target *OutputTarget) error {
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	var firstErr error
+
+	for _, address := range target.Addresses {
+		err := p.probeAddress(ctx, address, target)
+		if err == nil {
+			return nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+func (p *OutputReachabilityProber) probeAddress(ctx context.Context, address string, target *OutputTarget) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrConnectionFailed, err)
+	}
+
+	var conn net.Conn
+
+	if target.ProxyURL != "" {
+		// The proxy resolves the host name, so it is not resolved locally
+		conn, err = p.dialProxy(ctx, target.ProxyURL, address)
+		if err != nil {
+			return err
+		}
+	} else {
+		if _, err = p.Resolver.LookupHost(ctx, host); err != nil {
+			return fmt.Errorf("%w: %w", ErrDNSResolutionFailed, err)
+		}
+
+		conn, err = p.Dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return dialError(err)
+		}
+	}
+
+	defer conn.Close()
+
+	if target.TLS == nil {
+		return nil
+	}
+
+	tlsConfig, err := target.TLS.clientConfig(host)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTLSHandshakeFailed, err)
+	}
+
+	if err := tls.Client(conn, tlsConfig).HandshakeContext(ctx); err != nil {
+		return tlsError(err)
+	}
+
+	return nil
+}
+
+// dialProxy opens a tunnel to the given address through an HTTP proxy using the CONNECT method.
+func (p *OutputReachabilityProber) dialProxy(ctx context.Context, proxyURL, address string) (net.Conn, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProxyConnectionFailed, err)
+	}
+
+	proxyAddress := u.Host
+	if u.Port() == "" {
+		proxyAddress = net.JoinHostPort(u.Hostname(), defaultPortForScheme(u.Scheme))
+	}
+
+	conn, err := p.Dialer.DialContext(ctx, "tcp", proxyAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProxyConnectionFailed, err)
+	}
+
+	if u.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("%w: %w", ErrProxyConnectionFailed, err)
+		}
+
+		conn = tlsConn
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+
+	if u.User != nil {
+		password, _ := u.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(u.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("%w: %w", ErrProxyConnectionFailed, err)
+	}
+
+	// The body of a successful CONNECT response is the tunnel, so it is not closed separately from the connection
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req) //nolint:bodyclose // closed with the connection
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("%w: %w", ErrProxyConnectionFailed, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("%w: proxy responded with %s", ErrProxyConnectionFailed, resp.Status)
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+
+	return conn, nil
+}
+
+func dialError(err error) error {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return fmt.Errorf("%w: %w", ErrDNSResolutionFailed, err)
+	}
+
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return fmt.Errorf("%w: %w", ErrConnectionRefused, err)
+	}
+
+	return fmt.Errorf("%w: %w", ErrConnectionFailed, err)
+}
+
+func tlsError(err error) error {
+	var (
+		errVerification     *tls.CertificateVerificationError
+		errUnknownAuthority x509.UnknownAuthorityError
+		errHostname         x509.HostnameError
+		errInvalid          x509.CertificateInvalidError
+	)
+
+	if errors.As(err, &errVerification) || errors.As(err, &errUnknownAuthority) || errors.As(err, &errHostname) || errors.As(err, &errInvalid) {
+		return fmt.Errorf("%w: %w", ErrTLSVerificationFailed, err)
+	}
+
+	return fmt.Errorf("%w: %w", ErrTLSHandshakeFailed, err)
+}
+
+func (t *OutputTargetTLS) clientConfig(serverName string) (*tls.Config, error) {
+	//nolint:gosec // the TLS settings of the output are probed as they are configured
+	config := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+		MinVersion:         t.MinVersion,
+		MaxVersion:         t.MaxVersion,
+	}
+
+	if len(t.CA) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(t.CA) {
+			return nil, errors.New("failed to parse CA certificate")
+		}
+
+		config.RootCAs = pool
+	}
+
+	if len(t.Cert) > 0 && len(t.Key) > 0 {
+		cert, err := tls.X509KeyPair(t.Cert, t.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// fingerprint identifies the target, so that a changed target is probed again before the probe interval elapses.
+func (t *OutputTarget) fingerprint() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%v|%s|", t.Addresses, t.ProxyURL)
+
+	if t.TLS != nil {
+		fmt.Fprintf(h, "%x|%x|%x|%t|%d|%d", t.TLS.CA, t.TLS.Cert, t.TLS.Key, t.TLS.InsecureSkipVerify, t.TLS.MinVersion, t.TLS.MaxVersion)
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func defaultPortForScheme(scheme string) string {
+	if scheme == "https" {
+		return "443"
+	}
+
+	return "80"
+}
@@ -0,0 +1,196 @@
+package prober
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputReachabilityProber(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer tlsServer.Close()
+
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+	tlsServerAddress := tlsServer.Listener.Addr().String()
+
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer tcpListener.Close()
+
+	go acceptAndClose(tcpListener)
+
+	tests := []struct {
+		name        string
+		target      *OutputTarget
+		expectedErr error
+	}{
+		{
+			name:   "plaintext",
+			target: &OutputTarget{Addresses: []string{tcpListener.Addr().String()}},
+		},
+		{
+			name:   "tls with configured CA",
+			target: &OutputTarget{Addresses: []string{tlsServerAddress}, TLS: &OutputTargetTLS{CA: serverCA}},
+		},
+		{
+			name:   "tls without verification",
+			target: &OutputTarget{Addresses: []string{tlsServerAddress}, TLS: &OutputTargetTLS{InsecureSkipVerify: true}},
+		},
+		{
+			name:        "tls with unknown CA",
+			target:      &OutputTarget{Addresses: []string{tlsServerAddress}, TLS: &OutputTargetTLS{}},
+			expectedErr: ErrTLSVerificationFailed,
+		},
+		{
+			name:        "tls handshake with plaintext server",
+			target:      &OutputTarget{Addresses: []string{tcpListener.Addr().String()}, TLS: &OutputTargetTLS{}},
+			expectedErr: ErrTLSHandshakeFailed,
+		},
+		{
+			name:        "unknown host",
+			target:      &OutputTarget{Addresses: []string{"backend.invalid:4317"}},
+			expectedErr: ErrDNSResolutionFailed,
+		},
+		{
+			name:        "connection refused",
+			target:      &OutputTarget{Addresses: []string{closedAddress(t)}},
+			expectedErr: ErrConnectionRefused,
+		},
+		{
+			name:   "one of several addresses reachable",
+			target: &OutputTarget{Addresses: []string{closedAddress(t), tcpListener.Addr().String()}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := NewOutputReachabilityProber()
+
+			err := sut.Probe(context.Background(), "test", tt.target)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestOutputReachabilityProberThroughProxy(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer tlsServer.Close()
+
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect || r.Header.Get("Proxy-Authorization") != "Basic dXNlcjpwYXNzd29yZA==" {
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+
+		backendConn, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		clientConn, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			backendConn.Close()
+			return
+		}
+
+		_, _ = clientConn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+
+		go func() {
+			defer backendConn.Close()
+			defer clientConn.Close()
+
+			go func() { _, _ = io.Copy(backendConn, clientConn) }()
+
+			_, _ = io.Copy(clientConn, backendConn)
+		}()
+	}))
+	defer proxyServer.Close()
+
+	t.Run("tunneled", func(t *testing.T) {
+		sut := NewOutputReachabilityProber()
+		target := &OutputTarget{
+			Addresses: []string{tlsServer.Listener.Addr().String()},
+			TLS:       &OutputTargetTLS{CA: serverCA},
+			ProxyURL:  "http://user:password@" + proxyServer.Listener.Addr().String(),
+		}
+
+		require.NoError(t, sut.Probe(context.Background(), "test", target))
+	})
+
+	t.Run("rejected by proxy", func(t *testing.T) {
+		sut := NewOutputReachabilityProber()
+		target := &OutputTarget{
+			Addresses: []string{tlsServer.Listener.Addr().String()},
+			ProxyURL:  proxyServer.URL,
+		}
+
+		require.ErrorIs(t, sut.Probe(context.Background(), "test", target), ErrProxyConnectionFailed)
+	})
+}
+
+func TestOutputReachabilityProberRateLimit(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go acceptAndClose(listener)
+
+	now := time.Now()
+	sut := NewOutputReachabilityProber()
+	sut.now = func() time.Time { return now }
+
+	target := &OutputTarget{Addresses: []string{listener.Addr().String()}}
+	require.NoError(t, sut.Probe(context.Background(), "test", target))
+
+	listener.Close()
+
+	// the recent result is returned without probing again
+	now = now.Add(time.Minute)
+	require.NoError(t, sut.Probe(context.Background(), "test", target))
+
+	// a changed target is probed again immediately
+	changedTarget := &OutputTarget{Addresses: target.Addresses, TLS: &OutputTargetTLS{}}
+	require.ErrorIs(t, sut.Probe(context.Background(), "test", changedTarget), ErrConnectionRefused)
+
+	// the target is probed again after the probe interval
+	now = now.Add(sut.Interval)
+	require.ErrorIs(t, sut.Probe(context.Background(), "test", target), ErrConnectionRefused)
+}
+
+func acceptAndClose(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		conn.Close()
+	}
+}
+
+func closedAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	return address
+}
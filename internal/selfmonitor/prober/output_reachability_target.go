@@ -0,0 +1,300 @@
+package prober
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/proxy"
+	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
+)
+
+var tlsVersions = map[string]uint16{
+	telemetryv1alpha1.TLSVersion10: tls.VersionTLS10,
+	telemetryv1alpha1.TLSVersion11: tls.VersionTLS11,
+	telemetryv1alpha1.TLSVersion12: tls.VersionTLS12,
+	telemetryv1alpha1.TLSVersion13: tls.VersionTLS13,
+}
+
+// OTLPOutputTarget returns the target of an OTLP output, connected the same way the OTel Collector exporter connects it.
+func OTLPOutputTarget(ctx context.Context, c client.Reader, output *telemetryv1alpha1.OtlpOutput) (*OutputTarget, error) {
+	if output == nil {
+		return nil, fmt.Errorf("%w: no output defined", ErrOutputProbeNotSupported)
+	}
+
+	endpoint, err := resolveTargetValue(ctx, c, output.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, host, port, err := splitEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsEnabled := (output.TLS == nil || !output.TLS.Insecure) && scheme != "http"
+
+	if port == "" {
+		port = "80"
+		if tlsEnabled {
+			port = "443"
+		}
+	}
+
+	target := &OutputTarget{Addresses: []string{net.JoinHostPort(host, port)}}
+
+	if tlsEnabled {
+		if target.TLS, err = otlpTargetTLS(ctx, c, output.TLS); err != nil {
+			return nil, err
+		}
+	}
+
+	// The gRPC exporter always selects the proxy for HTTPS requests
+	proxyScheme := "https"
+	if output.Protocol == telemetryv1alpha1.OtlpProtocolHTTP && !tlsEnabled {
+		proxyScheme = "http"
+	}
+
+	if target.ProxyURL, err = outputProxyURL(ctx, c, output.Proxy, target.Addresses[0], func(settings *operatorv1alpha1.ProxySpec, address string) (*url.URL, error) {
+		return proxy.URLFor(settings, proxyScheme, address)
+	}); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// KafkaOutputTarget returns the target of a Kafka output. Kafka clients connect to the brokers directly, without a proxy.
+func KafkaOutputTarget(ctx context.Context, c client.Reader, output *telemetryv1alpha1.KafkaOutput) (*OutputTarget, error) {
+	target := &OutputTarget{Addresses: output.Brokers}
+
+	if output.TLS == nil || !output.TLS.Insecure {
+		var err error
+		if target.TLS, err = otlpTargetTLS(ctx, c, output.TLS); err != nil {
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+// LogOutputTarget returns the target of a LogPipeline output, connected the same way the Fluent Bit output plugin connects it.
+// Custom outputs and outputs that send over UDP cannot be probed.
+func LogOutputTarget(ctx context.Context, c client.Reader, output *telemetryv1alpha1.Output) (*OutputTarget, error) {
+	switch {
+	case output.IsHTTPDefined():
+		host, err := resolveTargetValue(ctx, c, output.HTTP.Host)
+		if err != nil {
+			return nil, err
+		}
+
+		port := output.HTTP.Port
+		if port == "" {
+			port = "443"
+		}
+
+		return fluentBitHTTPTarget(ctx, c, []string{net.JoinHostPort(host, port)}, output.HTTP.TLSConfig, output.HTTP.Proxy)
+	case output.IsLokiDefined():
+		lokiURL, err := resolveTargetValue(ctx, c, output.Loki.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		_, host, port, err := splitEndpoint(lokiURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if port == "" {
+			port = "443"
+			if strings.HasPrefix(lokiURL, "http://") {
+				port = "80"
+			}
+		}
+
+		return fluentBitHTTPTarget(ctx, c, []string{net.JoinHostPort(host, port)}, output.Loki.TLSConfig, nil)
+	case output.IsElasticsearchDefined():
+		var addresses []string
+
+		for _, hostport := range output.Elasticsearch.Hosts {
+			if _, _, err := net.SplitHostPort(hostport); err != nil {
+				hostport = net.JoinHostPort(hostport, "9200")
+			}
+
+			addresses = append(addresses, hostport)
+		}
+
+		return fluentBitHTTPTarget(ctx, c, addresses, output.Elasticsearch.TLSConfig, nil)
+	case output.IsSyslogDefined():
+		return transportTarget(ctx, c, output.Syslog.Host, output.Syslog.Port, output.Syslog.Mode, output.Syslog.TLSConfig, "514", "6514")
+	case output.IsGELFDefined():
+		return transportTarget(ctx, c, output.GELF.Host, output.GELF.Port, output.GELF.Mode, output.GELF.TLSConfig, "12201", "12201")
+	case output.IsKafkaDefined():
+		return KafkaOutputTarget(ctx, c, output.Kafka)
+	case output.Otlp != nil:
+		return OTLPOutputTarget(ctx, c, output.Otlp)
+	}
+
+	return nil, fmt.Errorf("%w: custom outputs cannot be probed", ErrOutputProbeNotSupported)
+}
+
+func fluentBitHTTPTarget(ctx context.Context, c client.Reader, addresses []string, tlsConfig telemetryv1alpha1.TLSConfig, outputProxy *telemetryv1alpha1.OutputProxy) (*OutputTarget, error) {
+	target := &OutputTarget{Addresses: addresses}
+
+	var err error
+	if target.TLS, err = logTargetTLS(ctx, c, tlsConfig); err != nil {
+		return nil, err
+	}
+
+	if target.ProxyURL, err = outputProxyURL(ctx, c, outputProxy, addresses[0], func(settings *operatorv1alpha1.ProxySpec, address string) (*url.URL, error) {
+		return proxy.FluentBitURLFor(settings, address)
+	}); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+func transportTarget(ctx context.Context, c client.Reader, hostValue telemetryv1alpha1.ValueType, port string, mode telemetryv1alpha1.TransportMode, tlsConfig telemetryv1alpha1.TLSConfig, defaultPort, defaultTLSPort string) (*OutputTarget, error) {
+	if mode == telemetryv1alpha1.TransportModeUDP {
+		return nil, fmt.Errorf("%w: outputs using UDP cannot be probed", ErrOutputProbeNotSupported)
+	}
+
+	host, err := resolveTargetValue(ctx, c, hostValue)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsEnabled := mode == "" || mode == telemetryv1alpha1.TransportModeTLS
+	if port == "" {
+		port = defaultPort
+		if tlsEnabled {
+			port = defaultTLSPort
+		}
+	}
+
+	target := &OutputTarget{Addresses: []string{net.JoinHostPort(host, port)}}
+
+	if tlsEnabled {
+		if target.TLS, err = logTargetTLS(ctx, c, tlsConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+// outputProxyURL returns the proxy of an output, which is either defined by the output itself or by the cluster-wide proxy settings.
+func outputProxyURL(ctx context.Context, c client.Reader, outputProxy *telemetryv1alpha1.OutputProxy, address string, urlFor func(*operatorv1alpha1.ProxySpec, string) (*url.URL, error)) (string, error) {
+	if outputProxy != nil && outputProxy.URL != "" {
+		return outputProxy.URL, nil
+	}
+
+	if proxy.IsBypassed(outputProxy) {
+		return "", nil
+	}
+
+	settings, err := proxy.GetSettings(ctx, c)
+	if err != nil {
+		return "", err
+	}
+
+	proxyURL, err := urlFor(settings, address)
+	if err != nil || proxyURL == nil {
+		return "", err
+	}
+
+	return proxyURL.String(), nil
+}
+
+func otlpTargetTLS(ctx context.Context, c client.Reader, otlpTLS *telemetryv1alpha1.OtlpTLS) (*OutputTargetTLS, error) {
+	if otlpTLS == nil {
+		return &OutputTargetTLS{}, nil
+	}
+
+	return resolveTargetTLS(ctx, c, otlpTLS.GetCA(), otlpTLS.GetCert(), otlpTLS.GetKey(), otlpTLS.InsecureSkipVerify, otlpTLS.MinVersion, otlpTLS.MaxVersion)
+}
+
+func logTargetTLS(ctx context.Context, c client.Reader, tlsConfig telemetryv1alpha1.TLSConfig) (*OutputTargetTLS, error) {
+	if tlsConfig.Disabled {
+		return nil, nil
+	}
+
+	return resolveTargetTLS(ctx, c, tlsConfig.GetCA(), tlsConfig.GetCert(), tlsConfig.GetKey(), tlsConfig.SkipCertificateValidation, "", "")
+}
+
+func resolveTargetTLS(ctx context.Context, c client.Reader, ca, cert, key *telemetryv1alpha1.ValueType, insecureSkipVerify bool, minVersion, maxVersion string) (*OutputTargetTLS, error) {
+	targetTLS := &OutputTargetTLS{
+		InsecureSkipVerify: insecureSkipVerify,
+		MinVersion:         tlsVersions[minVersion],
+		MaxVersion:         tlsVersions[maxVersion],
+	}
+
+	for _, field := range []struct {
+		value *telemetryv1alpha1.ValueType
+		dest  *[]byte
+	}{
+		{ca, &targetTLS.CA},
+		{cert, &targetTLS.Cert},
+		{key, &targetTLS.Key},
+	} {
+		if !field.value.IsDefined() {
+			continue
+		}
+
+		resolved, err := resolveTargetValue(ctx, c, *field.value)
+		if err != nil {
+			return nil, err
+		}
+
+		*field.dest = []byte(resolved)
+	}
+
+	return targetTLS, nil
+}
+
+func resolveTargetValue(ctx context.Context, c client.Reader, value telemetryv1alpha1.ValueType) (string, error) {
+	if value.Value != "" {
+		return value.Value, nil
+	}
+
+	if value.ValueFrom == nil || !value.ValueFrom.IsSecretKeyRef() {
+		return "", errors.New("value is not defined")
+	}
+
+	resolved, err := secretref.GetValue(ctx, c, *value.ValueFrom.SecretKeyRef)
+	if err != nil {
+		return "", err
+	}
+
+	return string(resolved), nil
+}
+
+// splitEndpoint splits an endpoint, which is either a URL or a host with an optional port.
+func splitEndpoint(endpoint string) (scheme, host, port string, err error) {
+	endpoint = strings.TrimSpace(endpoint)
+
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to parse endpoint: %w", err)
+		}
+
+		return u.Scheme, u.Hostname(), u.Port(), nil
+	}
+
+	hostport, _, _ := strings.Cut(endpoint, "/")
+	if host, port, err := net.SplitHostPort(hostport); err == nil {
+		return "", host, port, nil
+	}
+
+	return "", hostport, "", nil
+}
@@ -0,0 +1,252 @@
+package prober
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/telemetry-manager/apis/operator/v1alpha1"
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestOTLPOutputTarget(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, operatorv1alpha1.AddToScheme(scheme))
+
+	telemetry := &operatorv1alpha1.Telemetry{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"},
+		Spec: operatorv1alpha1.TelemetrySpec{
+			Proxy: &operatorv1alpha1.ProxySpec{
+				HTTPSProxy: "http://proxy.example.com:3128",
+				NoProxy:    []string{".internal.example.com"},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-cert", Namespace: "default"},
+		Data: map[string][]byte{
+			"ca.crt":  []byte("ca"),
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(telemetry, secret).Build()
+
+	tests := []struct {
+		name     string
+		output   *telemetryv1alpha1.OtlpOutput
+		expected *OutputTarget
+	}{
+		{
+			name: "grpc",
+			output: &telemetryv1alpha1.OtlpOutput{
+				Endpoint: telemetryv1alpha1.ValueType{Value: "backend.internal.example.com:4317"},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"backend.internal.example.com:4317"},
+				TLS:       &OutputTargetTLS{},
+			},
+		},
+		{
+			name: "grpc insecure",
+			output: &telemetryv1alpha1.OtlpOutput{
+				Endpoint: telemetryv1alpha1.ValueType{Value: "http://backend.internal.example.com:4317"},
+				TLS:      &telemetryv1alpha1.OtlpTLS{Insecure: true},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"backend.internal.example.com:4317"},
+			},
+		},
+		{
+			name: "http with default port and tls secret",
+			output: &telemetryv1alpha1.OtlpOutput{
+				Protocol: telemetryv1alpha1.OtlpProtocolHTTP,
+				Endpoint: telemetryv1alpha1.ValueType{Value: "https://backend.internal.example.com/otlp"},
+				TLS: &telemetryv1alpha1.OtlpTLS{
+					SecretRef:  &telemetryv1alpha1.TLSSecretRef{Name: "client-cert", Namespace: "default", UseCA: true},
+					MinVersion: telemetryv1alpha1.TLSVersion12,
+				},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"backend.internal.example.com:443"},
+				TLS: &OutputTargetTLS{
+					CA:         []byte("ca"),
+					Cert:       []byte("cert"),
+					Key:        []byte("key"),
+					MinVersion: tls.VersionTLS12,
+				},
+			},
+		},
+		{
+			name: "cluster-wide proxy",
+			output: &telemetryv1alpha1.OtlpOutput{
+				Endpoint: telemetryv1alpha1.ValueType{Value: "backend.example.com:4317"},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"backend.example.com:4317"},
+				TLS:       &OutputTargetTLS{},
+				ProxyURL:  "http://proxy.example.com:3128",
+			},
+		},
+		{
+			name: "proxy bypassed",
+			output: &telemetryv1alpha1.OtlpOutput{
+				Endpoint: telemetryv1alpha1.ValueType{Value: "backend.example.com:4317"},
+				Proxy:    &telemetryv1alpha1.OutputProxy{Disabled: true},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"backend.example.com:4317"},
+				TLS:       &OutputTargetTLS{},
+			},
+		},
+		{
+			name: "output proxy",
+			output: &telemetryv1alpha1.OtlpOutput{
+				Protocol: telemetryv1alpha1.OtlpProtocolHTTP,
+				Endpoint: telemetryv1alpha1.ValueType{Value: "https://backend.internal.example.com:4318"},
+				Proxy:    &telemetryv1alpha1.OutputProxy{URL: "http://output-proxy.example.com:3128"},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"backend.internal.example.com:4318"},
+				TLS:       &OutputTargetTLS{},
+				ProxyURL:  "http://output-proxy.example.com:3128",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := OTLPOutputTarget(context.Background(), fakeClient, tt.output)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, target)
+		})
+	}
+}
+
+func TestLogOutputTarget(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, operatorv1alpha1.AddToScheme(scheme))
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	tests := []struct {
+		name        string
+		output      *telemetryv1alpha1.Output
+		expected    *OutputTarget
+		expectedErr error
+	}{
+		{
+			name: "http with default port",
+			output: &telemetryv1alpha1.Output{
+				HTTP: &telemetryv1alpha1.HTTPOutput{
+					Host: telemetryv1alpha1.ValueType{Value: "logs.example.com"},
+				},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"logs.example.com:443"},
+				TLS:       &OutputTargetTLS{},
+			},
+		},
+		{
+			name: "http without tls",
+			output: &telemetryv1alpha1.Output{
+				HTTP: &telemetryv1alpha1.HTTPOutput{
+					Host:      telemetryv1alpha1.ValueType{Value: "logs.example.com"},
+					Port:      "80",
+					TLSConfig: telemetryv1alpha1.TLSConfig{Disabled: true},
+				},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"logs.example.com:80"},
+			},
+		},
+		{
+			name: "loki",
+			output: &telemetryv1alpha1.Output{
+				Loki: &telemetryv1alpha1.LokiOutput{
+					URL:       telemetryv1alpha1.ValueType{Value: "http://loki.example.com/loki/api/v1/push"},
+					TLSConfig: telemetryv1alpha1.TLSConfig{Disabled: true},
+				},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"loki.example.com:80"},
+			},
+		},
+		{
+			name: "elasticsearch",
+			output: &telemetryv1alpha1.Output{
+				Elasticsearch: &telemetryv1alpha1.ElasticsearchOutput{
+					Hosts: []string{"es-1.example.com", "es-2.example.com:9300"},
+				},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"es-1.example.com:9200", "es-2.example.com:9300"},
+				TLS:       &OutputTargetTLS{},
+			},
+		},
+		{
+			name: "syslog with default mode",
+			output: &telemetryv1alpha1.Output{
+				Syslog: &telemetryv1alpha1.SyslogOutput{
+					Host: telemetryv1alpha1.ValueType{Value: "syslog.example.com"},
+				},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"syslog.example.com:6514"},
+				TLS:       &OutputTargetTLS{},
+			},
+		},
+		{
+			name: "syslog over udp",
+			output: &telemetryv1alpha1.Output{
+				Syslog: &telemetryv1alpha1.SyslogOutput{
+					Host: telemetryv1alpha1.ValueType{Value: "syslog.example.com"},
+					Mode: telemetryv1alpha1.TransportModeUDP,
+				},
+			},
+			expectedErr: ErrOutputProbeNotSupported,
+		},
+		{
+			name: "kafka",
+			output: &telemetryv1alpha1.Output{
+				Kafka: &telemetryv1alpha1.KafkaOutput{
+					Brokers: []string{"kafka-1.example.com:9092", "kafka-2.example.com:9092"},
+					Topic:   "logs",
+					TLS:     &telemetryv1alpha1.OtlpTLS{Insecure: true},
+				},
+			},
+			expected: &OutputTarget{
+				Addresses: []string{"kafka-1.example.com:9092", "kafka-2.example.com:9092"},
+			},
+		},
+		{
+			name: "custom",
+			output: &telemetryv1alpha1.Output{
+				Custom: "Name stdout",
+			},
+			expectedErr: ErrOutputProbeNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := LogOutputTarget(context.Background(), fakeClient, tt.output)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, target)
+		})
+	}
+}
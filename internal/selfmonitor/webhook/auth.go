@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricPrefix = "telemetry_self_monitor_webhook_"
+
+	rejectReasonMissingToken     = "missing_token"
+	rejectReasonInvalidToken     = "invalid_token"
+	rejectReasonTokenUnavailable = "token_unavailable"
+
+	// tokenCacheTTL bounds how long a token that got rotated out is still accepted.
+	tokenCacheTTL = 1 * time.Minute
+	// tokenMinRefreshInterval limits how often an unknown token leads to a lookup of the Secret, so that invalid calls cannot flood the API server.
+	tokenMinRefreshInterval = 10 * time.Second
+)
+
+var rejectedRequestsTotal = promauto.With(metrics.Registry).NewCounterVec(
+	prometheus.CounterOpts{
+		Name: metricPrefix + "rejected_requests_total",
+		Help: "Total number of calls to the self-monitor webhook that were rejected because they were not authenticated.",
+	},
+	[]string{"reason"},
+)
+
+// tokenVerifier checks the bearer token of a request against the tokens stored in the webhook token Secret.
+// The tokens are cached, so that not every call results in a request to the API server.
+type tokenVerifier struct {
+	c          client.Reader
+	secretName types.NamespacedName
+
+	mu        sync.Mutex
+	tokens    [][]byte
+	fetchErr  error
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+func newTokenVerifier(c client.Reader, secretName types.NamespacedName) *tokenVerifier {
+	return &tokenVerifier{
+		c:          c,
+		secretName: secretName,
+		now:        time.Now,
+	}
+}
+
+// verify returns an empty reason if the request is authenticated, or the reason of the rejection otherwise.
+func (v *tokenVerifier) verify(ctx context.Context, r *http.Request) (string, error) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		return rejectReasonMissingToken, nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	age := v.now().Sub(v.fetchedAt)
+	if age >= tokenCacheTTL || (!v.matches(token) && age >= tokenMinRefreshInterval) {
+		v.refresh(ctx)
+	}
+
+	if v.fetchErr != nil {
+		return rejectReasonTokenUnavailable, v.fetchErr
+	}
+
+	if !v.matches(token) {
+		return rejectReasonInvalidToken, nil
+	}
+
+	return "", nil
+}
+
+func (v *tokenVerifier) matches(token string) bool {
+	for _, t := range v.tokens {
+		if subtle.ConstantTimeCompare(t, []byte(token)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// refresh reloads the tokens from the Secret. A failed lookup is cached as well, so that it is not retried on every call.
+func (v *tokenVerifier) refresh(ctx context.Context) {
+	v.fetchedAt = v.now()
+
+	var secret corev1.Secret
+	if err := v.c.Get(ctx, v.secretName, &secret); err != nil {
+		v.tokens = nil
+		v.fetchErr = fmt.Errorf("failed to get webhook token secret: %w", err)
+
+		return
+	}
+
+	var tokens [][]byte
+
+	for _, key := range []string{TokenKey, PreviousTokenKey} {
+		if token := secret.Data[key]; len(token) > 0 {
+			tokens = append(tokens, token)
+		}
+	}
+
+	v.tokens = tokens
+	v.fetchErr = nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -19,6 +20,7 @@ type Handler struct {
 	c           client.Reader
 	subscribers map[subscriberType]chan<- event.GenericEvent
 	logger      logr.Logger
+	verifier    *tokenVerifier
 }
 
 type Option = func(*Handler)
@@ -55,6 +57,13 @@ func WithLogger(logger logr.Logger) Option {
 	}
 }
 
+// WithTokenSecret makes the handler reject all calls that do not present one of the bearer tokens stored in the given Secret.
+func WithTokenSecret(secretName types.NamespacedName) Option {
+	return func(h *Handler) {
+		h.verifier = newTokenVerifier(h.c, secretName)
+	}
+}
+
 // NewHandler creates a new self-monitor webhook handler.
 // This handler serves an endpoint that mimics Alertmanager, allowing Prometheus to send alerts to it.
 // The handler then notifies the subscribers, typically controllers, about the alerts that match the pipelines.
@@ -87,6 +96,10 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !h.authenticate(w, r) {
+		return
+	}
+
 	alertsYAML, err := io.ReadAll(r.Body)
 	if err != nil {
 		h.logger.Error(err, "Failed to read request body")
@@ -129,6 +142,28 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func (h *Handler) authenticate(w http.ResponseWriter, r *http.Request) bool {
+	if h.verifier == nil {
+		return true
+	}
+
+	reason, err := h.verifier.verify(r.Context(), r)
+	if reason == "" {
+		return true
+	}
+
+	if err != nil {
+		h.logger.Error(err, "Failed to verify webhook token")
+	} else {
+		h.logger.Info("Rejected unauthenticated call", "reason", reason, "remoteAddr", r.RemoteAddr)
+	}
+
+	rejectedRequestsTotal.WithLabelValues(reason).Inc()
+	w.WriteHeader(http.StatusUnauthorized)
+
+	return false
+}
+
 func (h *Handler) toMetricPipelineReconcileEvents(ctx context.Context, alerts []Alert) []event.GenericEvent { //nolint:dupl // The functions are similar but not identical
 	var events []event.GenericEvent
 
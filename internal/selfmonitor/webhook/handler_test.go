@@ -7,11 +7,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -192,6 +197,146 @@ func TestHandler(t *testing.T) {
 	}
 }
 
+func TestHandlerAuthentication(t *testing.T) {
+	secretName := types.NamespacedName{Name: "webhook-token", Namespace: "kyma-system"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName.Name, Namespace: secretName.Namespace},
+		Data: map[string][]byte{
+			TokenKey:         []byte("current"),
+			PreviousTokenKey: []byte("previous"),
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		authorization        string
+		resources            []client.Object
+		expectedStatus       int
+		expectedRejectReason string
+	}{
+		{
+			name:           "current token",
+			authorization:  "Bearer current",
+			resources:      []client.Object{secret},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "previous token",
+			authorization:  "Bearer previous",
+			resources:      []client.Object{secret},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "missing token",
+			resources:            []client.Object{secret},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedRejectReason: rejectReasonMissingToken,
+		},
+		{
+			name:                 "basic auth instead of token",
+			authorization:        "Basic current",
+			resources:            []client.Object{secret},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedRejectReason: rejectReasonMissingToken,
+		},
+		{
+			name:                 "invalid token",
+			authorization:        "Bearer other",
+			resources:            []client.Object{secret},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedRejectReason: rejectReasonInvalidToken,
+		},
+		{
+			name:                 "token secret missing",
+			authorization:        "Bearer current",
+			expectedStatus:       http.StatusUnauthorized,
+			expectedRejectReason: rejectReasonTokenUnavailable,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			metricPipelineEvents := make(chan event.GenericEvent, 1024)
+
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = telemetryv1alpha1.AddToScheme(scheme)
+			resources := append(tc.resources, ptr.To(testutils.NewMetricPipelineBuilder().WithName("cls").Build()))
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(resources...).Build()
+			handler := NewHandler(fakeClient,
+				WithTokenSecret(secretName),
+				WithMetricPipelineSubscriber(metricPipelineEvents),
+				WithLogger(logr.New(logf.NullLogSink{})))
+
+			var rejectedBefore float64
+			if tc.expectedRejectReason != "" {
+				rejectedBefore = testutil.ToFloat64(rejectedRequestsTotal.WithLabelValues(tc.expectedRejectReason))
+			}
+
+			body := bytes.NewBuffer([]byte(`[{"labels":{"alertname":"MetricGatewayExporterDroppedData","pipeline_name":"cls"}}]`))
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/", body)
+			require.NoError(t, err)
+
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expectedStatus, rr.Code)
+
+			if tc.expectedRejectReason != "" {
+				require.Empty(t, metricPipelineEvents)
+				require.Equal(t, rejectedBefore+1, testutil.ToFloat64(rejectedRequestsTotal.WithLabelValues(tc.expectedRejectReason)))
+			} else {
+				require.ElementsMatch(t, []string{"cls"}, readAllNamesFromChannel(metricPipelineEvents))
+			}
+		})
+	}
+}
+
+func TestTokenVerifierRefresh(t *testing.T) {
+	secretName := types.NamespacedName{Name: "webhook-token", Namespace: "kyma-system"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName.Name, Namespace: secretName.Namespace},
+		Data:       map[string][]byte{TokenKey: []byte("old")},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut := newTokenVerifier(fakeClient, secretName)
+	sut.now = func() time.Time { return now }
+
+	verify := func(token string) string {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		reason, err := sut.verify(context.Background(), req)
+		require.NoError(t, err)
+
+		return reason
+	}
+
+	require.Empty(t, verify("old"))
+
+	secret.Data = map[string][]byte{TokenKey: []byte("new")}
+	require.NoError(t, fakeClient.Update(context.Background(), secret))
+
+	// Unknown tokens do not trigger a lookup before the minimal refresh interval is over
+	now = now.Add(tokenMinRefreshInterval / 2)
+	require.Equal(t, rejectReasonInvalidToken, verify("new"))
+	require.Empty(t, verify("old"))
+
+	now = now.Add(tokenMinRefreshInterval)
+	require.Empty(t, verify("new"))
+
+	// Tokens that are no longer in the secret expire with the cache
+	now = now.Add(tokenCacheTTL)
+	require.Equal(t, rejectReasonInvalidToken, verify("old"))
+}
+
 func readAllNamesFromChannel(ch <-chan event.GenericEvent) []string {
 	var names []string
 
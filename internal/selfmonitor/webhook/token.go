@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// TokenKey is the key of the Secret that holds the bearer token the self-monitor must present.
+	TokenKey = "token"
+	// PreviousTokenKey is the key of the Secret that holds the token replaced by the last rotation.
+	// It is still accepted until the next rotation, so that the self-monitor can pick up the new token without rejected calls.
+	PreviousTokenKey = "previous-token"
+
+	rotatedAtAnnotation = "telemetry.kyma-project.io/token-rotated-at"
+	tokenLength         = 32
+	tokenRotationPeriod = 24 * time.Hour
+)
+
+// EnsureToken creates the Secret holding the webhook token if it does not exist, and rotates the token once the rotation period is over.
+// It returns the current token.
+func EnsureToken(ctx context.Context, c client.Client, name types.NamespacedName, now time.Time) (string, error) {
+	var secret corev1.Secret
+
+	err := c.Get(ctx, name, &secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to get webhook token secret: %w", err)
+	}
+
+	if apierrors.IsNotFound(err) {
+		token, err := generateToken()
+		if err != nil {
+			return "", err
+		}
+
+		secret = corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name.Name,
+				Namespace:   name.Namespace,
+				Annotations: map[string]string{rotatedAtAnnotation: now.UTC().Format(time.RFC3339)},
+			},
+			Data: map[string][]byte{TokenKey: []byte(token)},
+		}
+
+		if err := c.Create(ctx, &secret); err != nil {
+			return "", fmt.Errorf("failed to create webhook token secret: %w", err)
+		}
+
+		return token, nil
+	}
+
+	if !rotationDue(&secret, now) {
+		return string(secret.Data[TokenKey]), nil
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	data := map[string][]byte{TokenKey: []byte(token)}
+	if previousToken, ok := secret.Data[TokenKey]; ok && len(previousToken) > 0 {
+		data[PreviousTokenKey] = previousToken
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+
+	secret.Annotations[rotatedAtAnnotation] = now.UTC().Format(time.RFC3339)
+	secret.Data = data
+
+	if err := c.Update(ctx, &secret); err != nil {
+		return "", fmt.Errorf("failed to rotate webhook token: %w", err)
+	}
+
+	return token, nil
+}
+
+func rotationDue(secret *corev1.Secret, now time.Time) bool {
+	if len(secret.Data[TokenKey]) == 0 {
+		return true
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[rotatedAtAnnotation])
+	if err != nil {
+		return true
+	}
+
+	return now.Sub(rotatedAt) >= tokenRotationPeriod
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureToken(t *testing.T) {
+	ctx := context.Background()
+	name := types.NamespacedName{Name: "webhook-token", Namespace: "kyma-system"}
+	fakeClient := fake.NewClientBuilder().Build()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	token, err := EnsureToken(ctx, fakeClient, name, now)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	var secret corev1.Secret
+	require.NoError(t, fakeClient.Get(ctx, name, &secret))
+	require.Equal(t, token, string(secret.Data[TokenKey]))
+	require.NotContains(t, secret.Data, PreviousTokenKey)
+
+	t.Run("keeps token within rotation period", func(t *testing.T) {
+		sameToken, err := EnsureToken(ctx, fakeClient, name, now.Add(tokenRotationPeriod-time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, token, sameToken)
+	})
+
+	t.Run("rotates token after rotation period", func(t *testing.T) {
+		newToken, err := EnsureToken(ctx, fakeClient, name, now.Add(tokenRotationPeriod))
+		require.NoError(t, err)
+		require.NotEqual(t, token, newToken)
+
+		var rotated corev1.Secret
+		require.NoError(t, fakeClient.Get(ctx, name, &rotated))
+		require.Equal(t, newToken, string(rotated.Data[TokenKey]))
+		require.Equal(t, token, string(rotated.Data[PreviousTokenKey]))
+	})
+
+	t.Run("rotates token without rotation timestamp", func(t *testing.T) {
+		var current corev1.Secret
+		require.NoError(t, fakeClient.Get(ctx, name, &current))
+		delete(current.Annotations, rotatedAtAnnotation)
+		require.NoError(t, fakeClient.Update(ctx, &current))
+
+		newToken, err := EnsureToken(ctx, fakeClient, name, now.Add(tokenRotationPeriod))
+		require.NoError(t, err)
+		require.NotEqual(t, string(current.Data[TokenKey]), newToken)
+	})
+}
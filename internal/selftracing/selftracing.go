@@ -0,0 +1,195 @@
+package selftracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	instrumentationName = "github.com/kyma-project/telemetry-manager"
+	serviceName         = "telemetry-manager"
+
+	// shutdownTimeout bounds how long spans of a replaced provider are flushed.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Names of the spans that cover the phases of a reconciliation. They are the same for all reconcilers.
+const (
+	SpanResolveSecrets  = "ResolveSecrets"
+	SpanBuildConfig     = "BuildConfig"
+	SpanApplyResources  = "ApplyResources"
+	SpanDeleteResources = "DeleteResources"
+	SpanProbe           = "Probe"
+)
+
+// ReconcileSpanName returns the name of the root span of a reconciliation of the given kind.
+func ReconcileSpanName(kind string) string {
+	return kind + ".Reconcile"
+}
+
+var (
+	globalProvider *Provider
+	once           sync.Once
+)
+
+// Global returns the Provider shared by all reconcilers of the Telemetry Manager.
+// It starts disabled and is configured by the overrides handler whenever the overrides are loaded.
+func Global() *Provider {
+	once.Do(func() {
+		globalProvider = NewProvider()
+	})
+
+	return globalProvider
+}
+
+// Start starts a span using the global Provider. If self-tracing is disabled, the returned span is a no-op.
+func Start(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Global().Start(ctx, spanName, attrs...)
+}
+
+// End records the error, if any, on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Provider creates the spans of the Telemetry Manager and exports them over OTLP/HTTP.
+// The export endpoint can be changed at runtime; the previous exporter is flushed and shut down in the background.
+type Provider struct {
+	mu           sync.RWMutex
+	endpoint     string
+	tracer       trace.Tracer
+	sdkProvider  *sdktrace.TracerProvider
+	newProcessor func(ctx context.Context, endpoint string) (sdktrace.SpanProcessor, error)
+}
+
+func NewProvider() *Provider {
+	return &Provider{
+		tracer:       noop.NewTracerProvider().Tracer(instrumentationName),
+		newProcessor: newBatchProcessor,
+	}
+}
+
+func newBatchProcessor(ctx context.Context, endpoint string) (sdktrace.SpanProcessor, error) {
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	return sdktrace.NewBatchSpanProcessor(exporter), nil
+}
+
+// validateEndpoint rejects endpoints that the OTLP exporter would silently replace with its default endpoint.
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid self-tracing endpoint: %w", err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid self-tracing endpoint %q: must be an http or https URL", endpoint)
+	}
+
+	return nil
+}
+
+func (p *Provider) Start(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	p.mu.RLock()
+	tracer := p.tracer
+	p.mu.RUnlock()
+
+	return tracer.Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+// Sync enables the export of spans to the given OTLP/HTTP endpoint URL, or disables self-tracing if the endpoint is empty.
+// Nothing happens if the endpoint did not change since the last call.
+func (p *Provider) Sync(ctx context.Context, endpoint string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if endpoint == p.endpoint {
+		return nil
+	}
+
+	var (
+		sdkProvider *sdktrace.TracerProvider
+		tracer      = noop.NewTracerProvider().Tracer(instrumentationName)
+	)
+
+	if endpoint != "" {
+		if err := validateEndpoint(endpoint); err != nil {
+			return err
+		}
+
+		processor, err := p.newProcessor(ctx, endpoint)
+		if err != nil {
+			return err
+		}
+
+		sdkProvider = sdktrace.NewTracerProvider(
+			sdktrace.WithSpanProcessor(processor),
+			sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+		)
+		tracer = sdkProvider.Tracer(instrumentationName)
+	}
+
+	previous := p.sdkProvider
+	p.endpoint = endpoint
+	p.sdkProvider = sdkProvider
+	p.tracer = tracer
+
+	if previous != nil {
+		go shutdown(previous)
+	}
+
+	return nil
+}
+
+// Endpoint returns the endpoint to which spans are exported, or an empty string if self-tracing is disabled.
+func (p *Provider) Endpoint() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.endpoint
+}
+
+// Shutdown flushes the pending spans and stops the export. It is called when the Telemetry Manager terminates.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.sdkProvider == nil {
+		return nil
+	}
+
+	err := p.sdkProvider.Shutdown(ctx)
+
+	p.endpoint = ""
+	p.sdkProvider = nil
+	p.tracer = noop.NewTracerProvider().Tracer(instrumentationName)
+
+	return err
+}
+
+func shutdown(sdkProvider *sdktrace.TracerProvider) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	sdkProvider.Shutdown(ctx) //nolint:errcheck // spans of a replaced provider are best-effort
+}
@@ -0,0 +1,79 @@
+package selftracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestProvider(exporter *tracetest.InMemoryExporter) *Provider {
+	p := NewProvider()
+	p.newProcessor = func(context.Context, string) (sdktrace.SpanProcessor, error) {
+		return sdktrace.NewSimpleSpanProcessor(exporter), nil
+	}
+
+	return p
+}
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+	exporter := tracetest.NewInMemoryExporter()
+	sut := newTestProvider(exporter)
+
+	t.Run("disabled by default", func(t *testing.T) {
+		_, span := sut.Start(ctx, "Reconcile")
+		span.End()
+
+		require.False(t, span.SpanContext().IsValid())
+		require.Empty(t, exporter.GetSpans())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		require.NoError(t, sut.Sync(ctx, "http://telemetry-otlp-traces.kyma-system:4318"))
+
+		parentCtx, parent := sut.Start(ctx, "Reconcile", attribute.String("name", "test"))
+		_, child := sut.Start(parentCtx, "ApplyResources")
+		End(child, errors.New("apply failed"))
+		End(parent, nil)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+
+		require.Equal(t, "ApplyResources", spans[0].Name)
+		require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		require.Equal(t, codes.Error, spans[0].Status.Code)
+		require.Equal(t, "apply failed", spans[0].Status.Description)
+
+		require.Equal(t, "Reconcile", spans[1].Name)
+		require.Equal(t, codes.Unset, spans[1].Status.Code)
+		require.Contains(t, spans[1].Attributes, attribute.String("name", "test"))
+		require.Contains(t, spans[1].Resource.Attributes(), attribute.String("service.name", "telemetry-manager"))
+	})
+
+	t.Run("disabled again", func(t *testing.T) {
+		exporter.Reset()
+
+		require.NoError(t, sut.Sync(ctx, ""))
+
+		_, span := sut.Start(ctx, "Reconcile")
+		span.End()
+
+		require.False(t, span.SpanContext().IsValid())
+		require.Empty(t, exporter.GetSpans())
+	})
+}
+
+func TestProviderInvalidEndpoint(t *testing.T) {
+	sut := NewProvider()
+
+	require.Error(t, sut.Sync(context.Background(), "://invalid"))
+
+	_, span := sut.Start(context.Background(), "Reconcile")
+	require.False(t, span.SpanContext().IsValid())
+}
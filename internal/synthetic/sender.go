@@ -0,0 +1,193 @@
+// Package synthetic sends small batches of test data through the telemetry gateways and the log agent, so that users can check that a pipeline delivers data to its backend.
+package synthetic
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"k8s.io/apimachinery/pkg/types"
+
+	fluentbitports "github.com/kyma-project/telemetry-manager/internal/fluentbit/ports"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+)
+
+const (
+	// AttributePipeline is the resource attribute that holds the name of the pipeline that the test data is meant for.
+	// The gateways deliver test data only to the test data exporter of that pipeline.
+	AttributePipeline = "telemetry.test_data.pipeline"
+	// AttributeRequest is the resource attribute that holds the value of the annotation that requested the test data.
+	AttributeRequest = "telemetry.test_data.request"
+	// ScopeName is the instrumentation scope of the test data.
+	ScopeName = "io.kyma-project.telemetry/test-data"
+
+	// LogMessage is the message of the test log record.
+	LogMessage = "Test data sent by Telemetry"
+
+	serviceName    = "telemetry-test-data"
+	testDataSuffix = "_test-data"
+	sendTimeout    = 10 * time.Second
+)
+
+// TestDataName returns the name of the components that deliver only the test data of the given pipeline, such as the exporter of a gateway or the output of the log agent.
+// The self monitor tells the export of the test data apart from the export of other data by this name.
+// Pipeline names cannot contain an underscore, so the name does not collide with the names of the components of other pipelines.
+func TestDataName(pipelineName string) string {
+	return pipelineName + testDataSuffix
+}
+
+// OTLPSender sends test data to an OTel Collector gateway over OTLP/HTTP.
+type OTLPSender struct {
+	url     string
+	client  *http.Client
+	payload func(pipelineName, request string) ([]byte, error)
+}
+
+// NewTraceSender returns a sender that sends a single span to the given OTLP service of the trace gateway.
+func NewTraceSender(otlpServiceName types.NamespacedName) *OTLPSender {
+	return newOTLPSender(otlpServiceName, "/v1/traces", tracePayload)
+}
+
+// NewMetricSender returns a sender that sends a single metric data point to the given OTLP service of the metric gateway.
+func NewMetricSender(otlpServiceName types.NamespacedName) *OTLPSender {
+	return newOTLPSender(otlpServiceName, "/v1/metrics", metricPayload)
+}
+
+func newOTLPSender(otlpServiceName types.NamespacedName, path string, payload func(pipelineName, request string) ([]byte, error)) *OTLPSender {
+	return &OTLPSender{
+		url:     fmt.Sprintf("http://%s.%s:%d%s", otlpServiceName.Name, otlpServiceName.Namespace, ports.OTLPHTTP, path),
+		client:  &http.Client{Timeout: sendTimeout},
+		payload: payload,
+	}
+}
+
+// Send sends test data for the given pipeline. The request identifies the annotation value that the test data is sent for.
+func (s *OTLPSender) Send(ctx context.Context, pipelineName, request string) error {
+	body, err := s.payload(pipelineName, request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal test data: %w", err)
+	}
+
+	return post(ctx, s.client, s.url, "application/x-protobuf", body, "gateway")
+}
+
+// LogSender sends test data to the HTTP input of Fluent Bit.
+// The input takes the tag of a record from the URL path, so the record is routed to the test data output of the pipeline.
+type LogSender struct {
+	url    string
+	client *http.Client
+}
+
+// NewLogSender returns a sender that sends a single log record to the given test data service of Fluent Bit.
+func NewLogSender(testDataServiceName types.NamespacedName) *LogSender {
+	return &LogSender{
+		url:    fmt.Sprintf("http://%s.%s:%d", testDataServiceName.Name, testDataServiceName.Namespace, fluentbitports.TestData),
+		client: &http.Client{Timeout: sendTimeout},
+	}
+}
+
+// Send sends test data for the given pipeline. The request identifies the annotation value that the test data is sent for.
+func (s *LogSender) Send(ctx context.Context, pipelineName, request string) error {
+	body, err := json.Marshal(map[string]string{
+		"log":             LogMessage,
+		AttributePipeline: pipelineName,
+		AttributeRequest:  request,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal test data: %w", err)
+	}
+
+	return post(ctx, s.client, fmt.Sprintf("%s/%s", s.url, TestDataName(pipelineName)), "application/json", body, "log agent")
+}
+
+func post(ctx context.Context, client *http.Client, url, contentType string, body []byte, receiver string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s responded with %s", receiver, resp.Status)
+	}
+
+	return nil
+}
+
+func tracePayload(pipelineName, request string) ([]byte, error) {
+	traces := ptrace.NewTraces()
+	resourceSpans := traces.ResourceSpans().AppendEmpty()
+	setResourceAttributes(resourceSpans.Resource(), pipelineName, request)
+
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	scopeSpans.Scope().SetName(ScopeName)
+
+	now := time.Now()
+	span := scopeSpans.Spans().AppendEmpty()
+	span.SetName("test-data")
+	span.SetKind(ptrace.SpanKindInternal)
+	span.SetTraceID(newTraceID())
+	span.SetSpanID(newSpanID())
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(now))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(now))
+
+	return ptraceotlp.NewExportRequestFromTraces(traces).MarshalProto()
+}
+
+func metricPayload(pipelineName, request string) ([]byte, error) {
+	metrics := pmetric.NewMetrics()
+	resourceMetrics := metrics.ResourceMetrics().AppendEmpty()
+	setResourceAttributes(resourceMetrics.Resource(), pipelineName, request)
+
+	scopeMetrics := resourceMetrics.ScopeMetrics().AppendEmpty()
+	scopeMetrics.Scope().SetName(ScopeName)
+
+	metric := scopeMetrics.Metrics().AppendEmpty()
+	metric.SetName("telemetry_test_data")
+	metric.SetDescription("Test data sent on request of the pipeline annotation")
+
+	dataPoint := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dataPoint.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dataPoint.SetIntValue(1)
+
+	return pmetricotlp.NewExportRequestFromMetrics(metrics).MarshalProto()
+}
+
+func setResourceAttributes(resource pcommon.Resource, pipelineName, request string) {
+	resource.Attributes().PutStr("service.name", serviceName)
+	resource.Attributes().PutStr(AttributePipeline, pipelineName)
+	resource.Attributes().PutStr(AttributeRequest, request)
+}
+
+func newTraceID() pcommon.TraceID {
+	var id pcommon.TraceID
+	_, _ = rand.Read(id[:])
+
+	return id
+}
+
+func newSpanID() pcommon.SpanID {
+	var id pcommon.SpanID
+	_, _ = rand.Read(id[:])
+
+	return id
+}
@@ -0,0 +1,115 @@
+package synthetic
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTraceSender(t *testing.T) {
+	var received ptraceotlp.ExportRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		received = ptraceotlp.NewExportRequest()
+		require.NoError(t, received.UnmarshalProto(body))
+	}))
+	defer server.Close()
+
+	sut := NewTraceSender(types.NamespacedName{Name: "telemetry-otlp-traces", Namespace: "kyma-system"})
+	require.Equal(t, "http://telemetry-otlp-traces.kyma-system:4318/v1/traces", sut.url)
+
+	sut.url = server.URL + "/v1/traces"
+	require.NoError(t, sut.Send(context.Background(), "my-pipeline", "1"))
+
+	resourceSpans := received.Traces().ResourceSpans()
+	require.Equal(t, 1, resourceSpans.Len())
+	requireResourceAttribute(t, resourceSpans.At(0).Resource().Attributes().AsRaw(), AttributePipeline, "my-pipeline")
+	requireResourceAttribute(t, resourceSpans.At(0).Resource().Attributes().AsRaw(), AttributeRequest, "1")
+	require.Equal(t, ScopeName, resourceSpans.At(0).ScopeSpans().At(0).Scope().Name())
+	require.Equal(t, 1, received.Traces().SpanCount())
+}
+
+func TestMetricSender(t *testing.T) {
+	var received pmetricotlp.ExportRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/metrics", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		received = pmetricotlp.NewExportRequest()
+		require.NoError(t, received.UnmarshalProto(body))
+	}))
+	defer server.Close()
+
+	sut := NewMetricSender(types.NamespacedName{Name: "telemetry-otlp-metrics", Namespace: "kyma-system"})
+	sut.url = server.URL + "/v1/metrics"
+	require.NoError(t, sut.Send(context.Background(), "my-pipeline", "2024-05-01"))
+
+	resourceMetrics := received.Metrics().ResourceMetrics()
+	require.Equal(t, 1, resourceMetrics.Len())
+	requireResourceAttribute(t, resourceMetrics.At(0).Resource().Attributes().AsRaw(), AttributePipeline, "my-pipeline")
+	requireResourceAttribute(t, resourceMetrics.At(0).Resource().Attributes().AsRaw(), AttributeRequest, "2024-05-01")
+	require.Equal(t, 1, received.Metrics().DataPointCount())
+}
+
+func TestLogSender(t *testing.T) {
+	var received map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/my-pipeline_test-data", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	sut := NewLogSender(types.NamespacedName{Name: "telemetry-fluent-bit-test-data", Namespace: "kyma-system"})
+	require.Equal(t, "http://telemetry-fluent-bit-test-data.kyma-system:2022", sut.url)
+
+	sut.url = server.URL
+	require.NoError(t, sut.Send(context.Background(), "my-pipeline", "1"))
+
+	require.Equal(t, map[string]string{
+		"log":             LogMessage,
+		AttributePipeline: "my-pipeline",
+		AttributeRequest:  "1",
+	}, received)
+}
+
+func TestSendRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sut := NewTraceSender(types.NamespacedName{})
+	sut.url = server.URL
+
+	require.ErrorContains(t, sut.Send(context.Background(), "my-pipeline", "1"), "503 Service Unavailable")
+}
+
+func requireResourceAttribute(t *testing.T, attributes map[string]any, key, value string) {
+	t.Helper()
+
+	require.Equal(t, value, attributes[key])
+}
@@ -17,6 +17,7 @@ type LogPipelineBuilder struct {
 
 	name              string
 	labels            map[string]string
+	annotations       map[string]string
 	finalizers        []string
 	deletionTimeStamp metav1.Time
 
@@ -47,6 +48,11 @@ func (b *LogPipelineBuilder) WithLabels(labels map[string]string) *LogPipelineBu
 	return b
 }
 
+func (b *LogPipelineBuilder) WithAnnotations(annotations map[string]string) *LogPipelineBuilder {
+	b.annotations = annotations
+	return b
+}
+
 func (b *LogPipelineBuilder) WithFinalizer(finalizer string) *LogPipelineBuilder {
 	b.finalizers = append(b.finalizers, finalizer)
 	return b
@@ -151,9 +157,10 @@ func (b *LogPipelineBuilder) Build() telemetryv1alpha1.LogPipeline {
 
 	logPipeline := telemetryv1alpha1.LogPipeline{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:       b.name,
-			Labels:     b.labels,
-			Finalizers: b.finalizers,
+			Name:        b.name,
+			Labels:      b.labels,
+			Annotations: b.annotations,
+			Finalizers:  b.finalizers,
 		},
 		Spec: telemetryv1alpha1.LogPipelineSpec{
 			Input:   b.input,
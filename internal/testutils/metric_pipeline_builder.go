@@ -22,7 +22,8 @@ type MetricPipelineBuilder struct {
 	inIstio      *telemetryv1alpha1.MetricPipelineIstioInput
 	inOTLP       *telemetryv1alpha1.MetricPipelineOtlpInput
 
-	outOTLP *telemetryv1alpha1.OtlpOutput
+	outOTLP  *telemetryv1alpha1.OtlpOutput
+	outKafka *telemetryv1alpha1.KafkaOutput
 
 	statusConditions []metav1.Condition
 }
@@ -263,6 +264,14 @@ func (b *MetricPipelineBuilder) WithOTLPOutput(opts ...OTLPOutputOption) *Metric
 	return b
 }
 
+// WithKafkaOutput replaces the default OTLP output with the given Kafka output.
+func (b *MetricPipelineBuilder) WithKafkaOutput(output *telemetryv1alpha1.KafkaOutput) *MetricPipelineBuilder {
+	b.outOTLP = nil
+	b.outKafka = output
+
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithStatusCondition(cond metav1.Condition) *MetricPipelineBuilder {
 	b.statusConditions = append(b.statusConditions, cond)
 	return b
@@ -291,7 +300,8 @@ func (b *MetricPipelineBuilder) Build() telemetryv1alpha1.MetricPipeline {
 				Otlp:       b.inOTLP,
 			},
 			Output: telemetryv1alpha1.MetricPipelineOutput{
-				Otlp: b.outOTLP,
+				Otlp:  b.outOTLP,
+				Kafka: b.outKafka,
 			},
 		},
 	}
@@ -66,6 +66,19 @@ func OTLPBasicAuthFromSecret(secretName, secretNamespace, userKey, passwordKey s
 	}
 }
 
+func OTLPOAuth2(tokenURL, clientID, clientSecret string, scopes ...string) OTLPOutputOption {
+	return func(output *telemetryv1alpha1.OtlpOutput) {
+		output.Authentication = &telemetryv1alpha1.AuthenticationOptions{
+			OAuth2: &telemetryv1alpha1.OAuth2Options{
+				TokenURL:     telemetryv1alpha1.ValueType{Value: tokenURL},
+				ClientID:     telemetryv1alpha1.ValueType{Value: clientID},
+				ClientSecret: telemetryv1alpha1.ValueType{Value: clientSecret},
+				Scopes:       scopes,
+			},
+		}
+	}
+}
+
 func OTLPCustomHeader(name, value, prefix string) OTLPOutputOption {
 	return func(output *telemetryv1alpha1.OtlpOutput) {
 		output.Headers = append(output.Headers, telemetryv1alpha1.Header{
@@ -106,6 +119,30 @@ func OTLPEndpointPath(path string) OTLPOutputOption {
 	}
 }
 
+func OTLPCompression(compression string) OTLPOutputOption {
+	return func(output *telemetryv1alpha1.OtlpOutput) {
+		output.Compression = compression
+	}
+}
+
+func OTLPTimeout(timeout string) OTLPOutputOption {
+	return func(output *telemetryv1alpha1.OtlpOutput) {
+		output.Timeout = timeout
+	}
+}
+
+func OTLPProxy(proxy *telemetryv1alpha1.OutputProxy) OTLPOutputOption {
+	return func(output *telemetryv1alpha1.OtlpOutput) {
+		output.Proxy = proxy
+	}
+}
+
+func OTLPRetry(retry *telemetryv1alpha1.OtlpRetry) OTLPOutputOption {
+	return func(output *telemetryv1alpha1.OtlpOutput) {
+		output.Retry = retry
+	}
+}
+
 type HTTPOutputOption func(output *telemetryv1alpha1.HTTPOutput)
 
 func HTTPClientTLSFromString(ca, cert, key string) HTTPOutputOption {
@@ -151,3 +188,9 @@ func HTTPDedot(dedot bool) HTTPOutputOption {
 		output.Dedot = dedot
 	}
 }
+
+func HTTPProxy(proxy *telemetryv1alpha1.OutputProxy) HTTPOutputOption {
+	return func(output *telemetryv1alpha1.HTTPOutput) {
+		output.Proxy = proxy
+	}
+}
@@ -13,11 +13,14 @@ import (
 type TracePipelineBuilder struct {
 	randSource rand.Source
 
-	name   string
-	labels map[string]string
+	name        string
+	labels      map[string]string
+	annotations map[string]string
 
 	statusConditions []metav1.Condition
+	inOTLP           *telemetryv1alpha1.TracePipelineOtlpInput
 	outOTLP          *telemetryv1alpha1.OtlpOutput
+	outKafka         *telemetryv1alpha1.KafkaOutput
 }
 
 func NewTracePipelineBuilder() *TracePipelineBuilder {
@@ -39,6 +42,11 @@ func (b *TracePipelineBuilder) WithLabels(labels map[string]string) *TracePipeli
 	return b
 }
 
+func (b *TracePipelineBuilder) WithAnnotations(annotations map[string]string) *TracePipelineBuilder {
+	b.annotations = annotations
+	return b
+}
+
 func (b *TracePipelineBuilder) WithStatusCondition(cond metav1.Condition) *TracePipelineBuilder {
 	b.statusConditions = append(b.statusConditions, cond)
 	return b
@@ -49,6 +57,15 @@ func (b *TracePipelineBuilder) WithStatusConditions(conds ...metav1.Condition) *
 	return b
 }
 
+// WithOTLPInputNamespaces selects the spans of the given namespaces only.
+func (b *TracePipelineBuilder) WithOTLPInputNamespaces(namespaces ...string) *TracePipelineBuilder {
+	b.inOTLP = &telemetryv1alpha1.TracePipelineOtlpInput{
+		Namespaces: &telemetryv1alpha1.TracePipelineInputNamespaceSelector{Include: namespaces},
+	}
+
+	return b
+}
+
 func (b *TracePipelineBuilder) WithOTLPOutput(opts ...OTLPOutputOption) *TracePipelineBuilder {
 	for _, opt := range opts {
 		opt(b.outOTLP)
@@ -57,6 +74,14 @@ func (b *TracePipelineBuilder) WithOTLPOutput(opts ...OTLPOutputOption) *TracePi
 	return b
 }
 
+// WithKafkaOutput replaces the default OTLP output with the given Kafka output.
+func (b *TracePipelineBuilder) WithKafkaOutput(output *telemetryv1alpha1.KafkaOutput) *TracePipelineBuilder {
+	b.outOTLP = nil
+	b.outKafka = output
+
+	return b
+}
+
 func (b *TracePipelineBuilder) Build() telemetryv1alpha1.TracePipeline {
 	name := b.name
 	if name == "" {
@@ -65,13 +90,18 @@ func (b *TracePipelineBuilder) Build() telemetryv1alpha1.TracePipeline {
 
 	pipeline := telemetryv1alpha1.TracePipeline{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:       name,
-			Generation: 1,
-			Labels:     b.labels,
+			Name:        name,
+			Generation:  1,
+			Labels:      b.labels,
+			Annotations: b.annotations,
 		},
 		Spec: telemetryv1alpha1.TracePipelineSpec{
+			Input: telemetryv1alpha1.TracePipelineInput{
+				Otlp: b.inOTLP,
+			},
 			Output: telemetryv1alpha1.TracePipelineOutput{
-				Otlp: b.outOTLP,
+				Otlp:  b.outOTLP,
+				Kafka: b.outKafka,
 			},
 		},
 		Status: telemetryv1alpha1.TracePipelineStatus{
@@ -15,9 +15,12 @@ import (
 )
 
 const (
-	FluentdProtocolHTTP = "fluentd-http"
-	OtlpProtocolGRPC    = telemetryv1alpha1.OtlpProtocolGRPC
-	OtlpProtocolHTTP    = telemetryv1alpha1.OtlpProtocolHTTP
+	FluentdProtocolHTTP   = "fluentd-http"
+	FluentBitProtocolLoki = "fluent-bit-loki"
+	FluentBitProtocolHost = "fluent-bit-host"
+	KafkaProtocolBroker   = "kafka-broker"
+	OtlpProtocolGRPC      = telemetryv1alpha1.OtlpProtocolGRPC
+	OtlpProtocolHTTP      = telemetryv1alpha1.OtlpProtocolHTTP
 )
 
 type Validator struct {
@@ -27,6 +30,7 @@ type Validator struct {
 var (
 	ErrValueResolveFailed = errors.New("failed to resolve value")
 	ErrPortMissing        = errors.New("missing port")
+	ErrHostMissing        = errors.New("missing host")
 	ErrUnsupportedScheme  = errors.New("missing or unsupported protocol scheme")
 )
 
@@ -63,10 +67,26 @@ func (v *Validator) Validate(ctx context.Context, endpoint *telemetryv1alpha1.Va
 		return err
 	}
 
-	if protocol == FluentdProtocolHTTP {
+	if protocol == FluentdProtocolHTTP || protocol == FluentBitProtocolHost {
 		return nil
 	}
 
+	if protocol == FluentBitProtocolLoki {
+		if err := validateSchemeHTTP(u.Scheme); err != nil {
+			return err
+		}
+
+		if u.Hostname() == "" {
+			return &EndpointInvalidError{Err: ErrHostMissing}
+		}
+
+		return nil
+	}
+
+	if protocol == KafkaProtocolBroker && u.Scheme != "" {
+		return &EndpointInvalidError{Err: ErrUnsupportedScheme}
+	}
+
 	var hostport = u.Host + u.Path
 	if err := validatePort(hostport, protocol == OtlpProtocolHTTP); err != nil {
 		return err
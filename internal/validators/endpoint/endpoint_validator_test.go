@@ -21,6 +21,7 @@ const (
 	errMsgPortInvalidSegmented    = "address %s: too many colons in address"
 	errMsgPortMissing             = "missing port"
 	errMsgUnsupportedScheme       = "missing or unsupported protocol scheme"
+	errMsgHostMissing             = "missing host"
 )
 
 var testScenarios = []struct {
@@ -433,6 +434,71 @@ func TestFluentdHttpEndpoints(t *testing.T) {
 	}
 }
 
+func TestFluentBitLokiEndpoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		errMsg   string
+	}{
+		{name: "https with port and path", endpoint: "https://loki.example.com:3100/loki/api/v1/push"},
+		{name: "http without port", endpoint: "http://loki.loki"},
+		{name: "without scheme", endpoint: "loki.loki:3100", errMsg: errMsgUnsupportedScheme},
+		{name: "unsupported scheme", endpoint: "grpc://loki.loki:3100", errMsg: errMsgUnsupportedScheme},
+		{name: "without host", endpoint: "http://:3100", errMsg: errMsgHostMissing},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().Build()
+			validator := Validator{
+				Client: fakeClient,
+			}
+
+			err := validator.Validate(
+				context.Background(),
+				&telemetryv1alpha1.ValueType{Value: test.endpoint},
+				FluentBitProtocolLoki)
+
+			if test.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, IsEndpointInvalidError(err))
+			require.EqualError(t, err, test.errMsg)
+		})
+	}
+}
+
+func TestFluentBitHostEndpoints(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().Build()
+	validator := Validator{
+		Client: fakeClient,
+	}
+
+	err := validator.Validate(context.Background(), &telemetryv1alpha1.ValueType{Value: "siem.example.com"}, FluentBitProtocolHost)
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), &telemetryv1alpha1.ValueType{}, FluentBitProtocolHost)
+	require.True(t, IsEndpointInvalidError(err))
+}
+
+func TestKafkaBrokerEndpoints(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().Build()
+	validator := Validator{
+		Client: fakeClient,
+	}
+
+	err := validator.Validate(context.Background(), &telemetryv1alpha1.ValueType{Value: "kafka.example.com:9093"}, KafkaProtocolBroker)
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), &telemetryv1alpha1.ValueType{Value: "kafka.example.com"}, KafkaProtocolBroker)
+	require.True(t, errors.Is(err, ErrPortMissing))
+
+	err = validator.Validate(context.Background(), &telemetryv1alpha1.ValueType{Value: "https://kafka.example.com:9093"}, KafkaProtocolBroker)
+	require.True(t, errors.Is(err, ErrUnsupportedScheme))
+}
+
 func TestMissingEndpoint(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().Build()
 	validator := Validator{
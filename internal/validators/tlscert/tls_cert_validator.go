@@ -38,9 +38,12 @@ var (
 )
 
 type TLSBundle struct {
-	Cert *telemetryv1alpha1.ValueType
-	Key  *telemetryv1alpha1.ValueType
-	CA   *telemetryv1alpha1.ValueType
+	Cert         *telemetryv1alpha1.ValueType
+	Key          *telemetryv1alpha1.ValueType
+	CA           *telemetryv1alpha1.ValueType
+	MinVersion   string
+	MaxVersion   string
+	CipherSuites []string
 }
 
 const twoWeeks = time.Hour * 24 * 7 * 2
@@ -101,6 +104,10 @@ func New(client client.Client) *Validator {
 }
 
 func (v *Validator) Validate(ctx context.Context, tls TLSBundle) error {
+	if err := validateProtocolOptions(tls); err != nil {
+		return err
+	}
+
 	// 1. Values Resolution
 	if (tls.Cert == nil) != (tls.Key == nil) {
 		return ErrMissingCertKeyPair
@@ -154,6 +161,40 @@ func (v *Validator) Validate(ctx context.Context, tls TLSBundle) error {
 	return nil
 }
 
+// Expiry returns the earliest expiry of the certificate and the CA certificates in the bundle.
+// It returns the zero time if the bundle holds no certificate, or if the certificates cannot be resolved or parsed.
+func (v *Validator) Expiry(ctx context.Context, tls TLSBundle) time.Time {
+	// The private key is not needed to determine the expiry
+	tls.Key = nil
+
+	certPEM, _, caPEM, err := resolveValues(ctx, v.client, tls)
+	if err != nil {
+		return time.Time{}
+	}
+
+	var certs []*x509.Certificate
+
+	if certPEM != nil {
+		if cert, err := parseCertificate(sanitizeValue(certPEM)); err == nil {
+			certs = append(certs, cert)
+		}
+	}
+
+	if cas, err := parseCA(sanitizeValue(caPEM)); err == nil {
+		certs = append(certs, cas...)
+	}
+
+	var expiry time.Time
+
+	for _, cert := range certs {
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+	}
+
+	return expiry
+}
+
 func sanitizeValue(valuePEM []byte) []byte {
 	return bytes.ReplaceAll(valuePEM, []byte("\\n"), []byte("\n"))
 }
@@ -253,6 +253,53 @@ func TestValidCertificatesAndPrivateKey(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestExpiry(t *testing.T) {
+	caExpiry := time.Date(2034, time.June, 2, 5, 34, 1, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		bundle   TLSBundle
+		expected time.Time
+	}{
+		{
+			name: "certificate expires before CA",
+			bundle: TLSBundle{
+				Cert: &telemetryv1alpha1.ValueType{Value: string(defaultCertData)},
+				Key:  &telemetryv1alpha1.ValueType{Value: string(defaultKeyData)},
+				CA:   &telemetryv1alpha1.ValueType{Value: string(defaultCaData)},
+			},
+			expected: certExpiry,
+		},
+		{
+			name: "CA only",
+			bundle: TLSBundle{
+				CA: &telemetryv1alpha1.ValueType{Value: string(defaultCaData)},
+			},
+			expected: caExpiry,
+		},
+		{
+			name: "no certificates",
+			bundle: TLSBundle{
+				MinVersion: "1.2",
+			},
+		},
+		{
+			name: "unresolvable certificate",
+			bundle: TLSBundle{
+				Cert: &telemetryv1alpha1.ValueType{ValueFrom: &telemetryv1alpha1.ValueFromSource{SecretKeyRef: &telemetryv1alpha1.SecretKeyRef{Name: "missing", Namespace: "default", Key: "cert"}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := New(fake.NewClientBuilder().Build())
+
+			require.Equal(t, test.expected, validator.Expiry(context.Background(), test.bundle))
+		})
+	}
+}
+
 func TestInvalidCertificate(t *testing.T) {
 	certData := []byte(`-----BEGIN CERTIFICATE-----
 MIICNjCCAZ+gAwIBAgIBADANBgkqhkiG9w0BAQ0FADA4MQswCQYDVQQGEwJ1czEL
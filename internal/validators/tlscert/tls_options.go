@@ -0,0 +1,69 @@
+package tlscert
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrUnknownTLSVersion        = errors.New("unknown TLS version")
+	ErrInvalidTLSVersionRange   = errors.New("minimum TLS version must not be greater than maximum TLS version")
+	ErrUnknownCipherSuite       = errors.New("unknown or insecure cipher suite")
+	ErrCipherSuitesNotSupported = errors.New("cipher suites cannot be configured if the minimum TLS version is 1.3")
+)
+
+// tlsVersions lists the supported TLS versions in ascending order.
+var tlsVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
+// cipherSuites maps the IANA names of the supported cipher suites, as used by the OpenTelemetry Collector,
+// to the OpenSSL names used by Fluent Bit. Only cipher suites with forward secrecy are supported.
+var cipherSuites = map[string]string{
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256":       "ECDHE-ECDSA-AES128-GCM-SHA256",
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":         "ECDHE-RSA-AES128-GCM-SHA256",
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384":       "ECDHE-ECDSA-AES256-GCM-SHA384",
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":         "ECDHE-RSA-AES256-GCM-SHA384",
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256": "ECDHE-ECDSA-CHACHA20-POLY1305",
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256":   "ECDHE-RSA-CHACHA20-POLY1305",
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":          "ECDHE-ECDSA-AES128-SHA",
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA":            "ECDHE-RSA-AES128-SHA",
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":          "ECDHE-ECDSA-AES256-SHA",
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":            "ECDHE-RSA-AES256-SHA",
+}
+
+// OpenSSLCipherSuiteName returns the OpenSSL name of a supported cipher suite, or an empty string if the cipher suite is not supported.
+func OpenSSLCipherSuiteName(ianaName string) string {
+	return cipherSuites[ianaName]
+}
+
+func validateProtocolOptions(tls TLSBundle) error {
+	minIndex := slices.Index(tlsVersions, tls.MinVersion)
+	if tls.MinVersion != "" && minIndex < 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownTLSVersion, tls.MinVersion)
+	}
+
+	maxIndex := slices.Index(tlsVersions, tls.MaxVersion)
+	if tls.MaxVersion != "" && maxIndex < 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownTLSVersion, tls.MaxVersion)
+	}
+
+	if minIndex >= 0 && maxIndex >= 0 && minIndex > maxIndex {
+		return ErrInvalidTLSVersionRange
+	}
+
+	if len(tls.CipherSuites) == 0 {
+		return nil
+	}
+
+	if tls.MinVersion == "1.3" {
+		return ErrCipherSuitesNotSupported
+	}
+
+	for _, cipherSuite := range tls.CipherSuites {
+		if _, ok := cipherSuites[cipherSuite]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownCipherSuite, cipherSuite)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package tlscert
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestProtocolOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		tls         TLSBundle
+		expectedErr error
+	}{
+		{
+			name: "versions and cipher suites",
+			tls: TLSBundle{
+				MinVersion:   "1.2",
+				MaxVersion:   "1.3",
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"},
+			},
+		},
+		{
+			name: "same minimum and maximum version",
+			tls:  TLSBundle{MinVersion: "1.3", MaxVersion: "1.3"},
+		},
+		{
+			name:        "unknown minimum version",
+			tls:         TLSBundle{MinVersion: "1.4"},
+			expectedErr: ErrUnknownTLSVersion,
+		},
+		{
+			name:        "unknown maximum version",
+			tls:         TLSBundle{MaxVersion: "TLSv1.2"},
+			expectedErr: ErrUnknownTLSVersion,
+		},
+		{
+			name:        "minimum version greater than maximum version",
+			tls:         TLSBundle{MinVersion: "1.3", MaxVersion: "1.2"},
+			expectedErr: ErrInvalidTLSVersionRange,
+		},
+		{
+			name:        "unknown cipher suite",
+			tls:         TLSBundle{CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_FOO"}},
+			expectedErr: ErrUnknownCipherSuite,
+		},
+		{
+			name:        "insecure cipher suite",
+			tls:         TLSBundle{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}},
+			expectedErr: ErrUnknownCipherSuite,
+		},
+		{
+			name:        "cipher suites with TLS 1.3 only",
+			tls:         TLSBundle{MinVersion: "1.3", CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}},
+			expectedErr: ErrCipherSuitesNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := Validator{
+				client: fake.NewClientBuilder().Build(),
+				now:    time.Now,
+			}
+
+			err := validator.Validate(context.Background(), test.tls)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestOpenSSLCipherSuiteName(t *testing.T) {
+	require.Equal(t, "ECDHE-RSA-AES128-GCM-SHA256", OpenSSLCipherSuiteName("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"))
+	require.Equal(t, "ECDHE-ECDSA-CHACHA20-POLY1305", OpenSSLCipherSuiteName("TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256"))
+	require.Empty(t, OpenSSLCipherSuiteName("TLS_FOO"))
+}
+
+func TestSupportedCipherSuitesAreSecure(t *testing.T) {
+	var secureCipherSuites []string
+	for _, cipherSuite := range tls.CipherSuites() {
+		secureCipherSuites = append(secureCipherSuites, cipherSuite.Name)
+	}
+
+	for cipherSuite := range cipherSuites {
+		require.Contains(t, secureCipherSuites, cipherSuite)
+	}
+}
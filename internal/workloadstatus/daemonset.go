@@ -27,7 +27,10 @@ func (dsp *DaemonSetProber) IsReady(ctx context.Context, name types.NamespacedNa
 	desired := ds.Status.DesiredNumberScheduled
 	ready := ds.Status.NumberReady
 
-	if updated == desired && ready >= desired {
+	// The status numbers only refer to the current spec once the controller observed it
+	observed := ds.Status.ObservedGeneration >= ds.Generation
+
+	if observed && updated == desired && ready >= desired {
 		return nil
 	}
 
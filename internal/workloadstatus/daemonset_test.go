@@ -265,3 +265,29 @@ func TestDaemonSetRollout(t *testing.T) {
 	err := sut.IsReady(context.Background(), types.NamespacedName{Name: "foo", Namespace: "telemetry-system"})
 	require.True(t, IsRolloutInProgressError(err))
 }
+
+func TestDaemonSetSpecNotObserved(t *testing.T) {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "telemetry-system", Generation: 2},
+		Spec: appsv1.DaemonSetSpec{Selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "foo"},
+		}},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 1,
+			NumberReady:            1,
+			UpdatedNumberScheduled: 1,
+			ObservedGeneration:     1,
+		},
+	}
+
+	podList := &corev1.PodList{
+		Items: []corev1.Pod{
+			testutils.NewPodBuilder("pod-0", "telemetry-system").WithLabels(map[string]string{"app": "foo"}).WithRunningStatus().Build(),
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithObjects(daemonSet).WithLists(podList).Build()
+	sut := DaemonSetProber{fakeClient}
+	err := sut.IsReady(context.Background(), types.NamespacedName{Name: "foo", Namespace: "telemetry-system"})
+	require.True(t, IsRolloutInProgressError(err))
+}
@@ -55,6 +55,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/telemetry"
 	"github.com/kyma-project/telemetry-manager/internal/resources/selfmonitor"
 	selfmonitorwebhook "github.com/kyma-project/telemetry-manager/internal/selfmonitor/webhook"
+	"github.com/kyma-project/telemetry-manager/internal/selftracing"
 	"github.com/kyma-project/telemetry-manager/internal/webhookcert"
 	logparserwebhook "github.com/kyma-project/telemetry-manager/webhook/logparser"
 	logpipelinewebhook "github.com/kyma-project/telemetry-manager/webhook/logpipeline"
@@ -92,10 +93,13 @@ const (
 	defaultSelfMonitorImage       = "europe-docker.pkg.dev/kyma-project/prod/tpi/telemetry-self-monitor:2.53.2-cc4f64c"
 
 	cacheSyncPeriod           = 1 * time.Minute
+	selfTracingFlushTimeout   = 5 * time.Second
 	telemetryNamespaceEnvVar  = "MANAGER_NAMESPACE"
 	telemetryNamespaceDefault = "default"
+	metricAgentName           = "telemetry-metric-agent"
 	metricOTLPServiceName     = "telemetry-otlp-metrics"
 	selfMonitorName           = "telemetry-self-monitor"
+	selfMonitorTokenName      = "telemetry-self-monitor-webhook-token"
 	traceOTLPServiceName      = "telemetry-otlp-traces"
 	webhookServerPort         = 9443
 	webhookServiceName        = "telemetry-manager-webhook"
@@ -123,6 +127,12 @@ func init() {
 // +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=metricpipelines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=metricpipelines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=metricpipelines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=namespacedtracepipelines,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=namespacedtracepipelines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=namespacedtracepipelines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=namespacedlogpipelines,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=namespacedlogpipelines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=telemetry.kyma-project.io,resources=namespacedlogpipelines/finalizers,verbs=update
 
 // +kubebuilder:rbac:groups=operator.kyma-project.io,namespace=system,resources=telemetries,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.kyma-project.io,namespace=system,resources=telemetries/status,verbs=get;update;patch
@@ -226,6 +236,9 @@ func run() error {
 
 	setupLog.Info("Starting Telemetry Manager", "version", version)
 
+	// Self-tracing is enabled by the overrides when the reconcilers load them, so only the flush on termination is handled here
+	defer shutdownSelfTracing()
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                  scheme,
 		Metrics:                 metricsserver.Options{BindAddress: ":8080"},
@@ -282,6 +295,10 @@ func run() error {
 		return fmt.Errorf("failed to enable log pipeline controller: %w", err)
 	}
 
+	if err := setupNamespacedPipelineControllers(mgr); err != nil {
+		return fmt.Errorf("failed to enable namespaced pipeline controllers: %w", err)
+	}
+
 	webhookConfig := createWebhookConfig()
 	selfMonitorConfig := createSelfMonitoringConfig()
 
@@ -311,6 +328,7 @@ func run() error {
 	})
 	mgr.GetWebhookServer().Register("/api/v2/alerts", selfmonitorwebhook.NewHandler(
 		mgr.GetClient(),
+		selfmonitorwebhook.WithTokenSecret(types.NamespacedName{Name: selfMonitorTokenName, Namespace: telemetryNamespace}),
 		selfmonitorwebhook.WithTracePipelineSubscriber(tracePipelineReconcileTriggerChan),
 		selfmonitorwebhook.WithMetricPipelineSubscriber(metricPipelineReconcileTriggerChan),
 		selfmonitorwebhook.WithLogPipelineSubscriber(logPipelineReconcileTriggerChan),
@@ -323,6 +341,15 @@ func run() error {
 	return nil
 }
 
+func shutdownSelfTracing() {
+	ctx, cancel := context.WithTimeout(context.Background(), selfTracingFlushTimeout)
+	defer cancel()
+
+	if err := selftracing.Global().Shutdown(ctx); err != nil {
+		setupLog.Error(err, "Failed to flush self-tracing spans")
+	}
+}
+
 func enableTelemetryModuleController(mgr manager.Manager, webhookConfig telemetry.WebhookConfig, selfMonitorConfig telemetry.SelfMonitorConfig) error {
 	setupLog.Info("Setting up telemetry controller")
 
@@ -378,6 +405,7 @@ func setupLogPipelineController(mgr manager.Manager, reconcileTriggerChan <-chan
 		mgr.GetClient(),
 		reconcileTriggerChan,
 		telemetrycontrollers.LogPipelineControllerConfig{
+			EventRecorder:      mgr.GetEventRecorderFor("logpipeline-controller"),
 			ExporterImage:      fluentBitExporterImage,
 			FluentBitImage:     fluentBitImage,
 			PriorityClassName:  highPriorityClassName,
@@ -417,6 +445,7 @@ func setupTracePipelineController(mgr manager.Manager, reconcileTriggerChan <-ch
 		mgr.GetClient(),
 		reconcileTriggerChan,
 		telemetrycontrollers.TracePipelineControllerConfig{
+			EventRecorder:                 mgr.GetEventRecorderFor("tracepipeline-controller"),
 			RestConfig:                    mgr.GetConfig(),
 			OTelCollectorImage:            otelCollectorImage,
 			SelfMonitorName:               selfMonitorName,
@@ -436,6 +465,22 @@ func setupTracePipelineController(mgr manager.Manager, reconcileTriggerChan <-ch
 	return nil
 }
 
+func setupNamespacedPipelineControllers(mgr manager.Manager) error {
+	setupLog.Info("Setting up namespacedtracepipeline controller")
+
+	if err := telemetrycontrollers.NewNamespacedTracePipelineController(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to setup namespacedtracepipeline controller: %w", err)
+	}
+
+	setupLog.Info("Setting up namespacedlogpipeline controller")
+
+	if err := telemetrycontrollers.NewNamespacedLogPipelineController(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to setup namespacedlogpipeline controller: %w", err)
+	}
+
+	return nil
+}
+
 func setupMetricPipelineController(mgr manager.Manager, reconcileTriggerChan <-chan event.GenericEvent) error {
 	setupLog.Info("Setting up metricpipeline controller")
 
@@ -443,6 +488,7 @@ func setupMetricPipelineController(mgr manager.Manager, reconcileTriggerChan <-c
 		mgr.GetClient(),
 		reconcileTriggerChan,
 		telemetrycontrollers.MetricPipelineControllerConfig{
+			EventRecorder:                  mgr.GetEventRecorderFor("metricpipeline-controller"),
 			MetricAgentPriorityClassName:   highPriorityClassName,
 			MetricGatewayPriorityClassName: normalPriorityClassName,
 			MetricGatewayServiceName:       metricOTLPServiceName,
@@ -489,14 +535,9 @@ func setNamespaceFieldSelector() fields.Selector {
 }
 
 func createLogPipelineValidator(client client.Client) *logpipelinewebhook.ValidatingWebhookHandler {
-	// TODO: Align max log pipeline enforcement with the method used in the TracePipeline/MetricPipeline controllers,
-	// replacing the current validating webhook approach.
-	const maxLogPipelines = 5
-
 	return logpipelinewebhook.NewValidatingWebhookHandler(
 		client,
 		validation.NewVariablesValidator(client),
-		validation.NewMaxPipelinesValidator(maxLogPipelines),
 		validation.NewFilesValidator(),
 		admission.NewDecoder(scheme),
 	)
@@ -511,8 +552,9 @@ func createLogParserValidator(client client.Client) *logparserwebhook.Validating
 func createSelfMonitoringConfig() telemetry.SelfMonitorConfig {
 	return telemetry.SelfMonitorConfig{
 		Config: selfmonitor.Config{
-			BaseName:  selfMonitorName,
-			Namespace: telemetryNamespace,
+			BaseName:        selfMonitorName,
+			Namespace:       telemetryNamespace,
+			MetricAgentName: metricAgentName,
 			Deployment: selfmonitor.DeploymentConfig{
 				Image:             selfMonitorImage,
 				PriorityClassName: normalPriorityClassName,
@@ -520,6 +562,10 @@ func createSelfMonitoringConfig() telemetry.SelfMonitorConfig {
 		},
 		WebhookScheme: "https",
 		WebhookURL:    fmt.Sprintf("%s.%s.svc", webhookServiceName, telemetryNamespace),
+		WebhookTokenSecretName: types.NamespacedName{
+			Name:      selfMonitorTokenName,
+			Namespace: telemetryNamespace,
+		},
 	}
 }
 
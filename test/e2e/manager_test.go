@@ -123,6 +123,26 @@ var _ = Describe(suite.ID(), func() {
 			Expect(crd.Spec.Scope).To(Equal(apiextensionsv1.ClusterScoped))
 		})
 
+		It("Should have NamespacedTracePipelines CRD", Label(suite.LabelTraces), func() {
+			var crd apiextensionsv1.CustomResourceDefinition
+			key := types.NamespacedName{
+				Name: "namespacedtracepipelines.telemetry.kyma-project.io",
+			}
+			err := k8sClient.Get(ctx, key, &crd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crd.Spec.Scope).To(Equal(apiextensionsv1.NamespaceScoped))
+		})
+
+		It("Should have NamespacedLogPipelines CRD", Label(suite.LabelLogs), func() {
+			var crd apiextensionsv1.CustomResourceDefinition
+			key := types.NamespacedName{
+				Name: "namespacedlogpipelines.telemetry.kyma-project.io",
+			}
+			err := k8sClient.Get(ctx, key, &crd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crd.Spec.Scope).To(Equal(apiextensionsv1.NamespaceScoped))
+		})
+
 		It("Should have MetricPipelines CRD", Label(suite.LabelMetrics), Label(suite.LabelSetA), func() {
 			var crd apiextensionsv1.CustomResourceDefinition
 			key := types.NamespacedName{
@@ -38,25 +38,22 @@ const (
 // +kubebuilder:webhook:path=/validate-logpipeline,mutating=false,failurePolicy=fail,sideEffects=None,groups=telemetry.kyma-project.io,resources=logpipelines,verbs=create;update,versions=v1alpha1,name=vlogpipeline.kb.io,admissionReviewVersions=v1
 type ValidatingWebhookHandler struct {
 	client.Client
-	variablesValidator    validation.VariablesValidator
-	maxPipelinesValidator validation.MaxPipelinesValidator
-	fileValidator         validation.FilesValidator
-	decoder               admission.Decoder
+	variablesValidator validation.VariablesValidator
+	fileValidator      validation.FilesValidator
+	decoder            admission.Decoder
 }
 
 func NewValidatingWebhookHandler(
 	client client.Client,
 	variablesValidator validation.VariablesValidator,
-	maxPipelinesValidator validation.MaxPipelinesValidator,
 	fileValidator validation.FilesValidator,
 	decoder admission.Decoder,
 ) *ValidatingWebhookHandler {
 	return &ValidatingWebhookHandler{
-		Client:                client,
-		variablesValidator:    variablesValidator,
-		maxPipelinesValidator: maxPipelinesValidator,
-		decoder:               decoder,
-		fileValidator:         fileValidator,
+		Client:             client,
+		variablesValidator: variablesValidator,
+		decoder:            decoder,
+		fileValidator:      fileValidator,
 	}
 }
 
@@ -112,11 +109,6 @@ func (v *ValidatingWebhookHandler) validateLogPipeline(ctx context.Context, logP
 		return err
 	}
 
-	if err := v.maxPipelinesValidator.Validate(logPipeline, &logPipelines); err != nil {
-		log.Error(err, "Maximum number of log pipelines reached")
-		return err
-	}
-
 	if err := logPipeline.Validate(); err != nil {
 		log.Error(err, "Failed to validate Fluent Bit input")
 		return err
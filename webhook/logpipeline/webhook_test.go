@@ -24,12 +24,10 @@ func TestHandle(t *testing.T) {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = telemetryv1alpha1.AddToScheme(scheme)
 
-	t.Run("should execute validations for max pipelines, variables, files", func(t *testing.T) {
-		maxPipelinesValidatorMock := &logpipelinevalidationmocks.MaxPipelinesValidator{}
+	t.Run("should execute validations for variables, files", func(t *testing.T) {
 		variableValidatorMock := &logpipelinevalidationmocks.VariablesValidator{}
 		fileValidatorMock := &logpipelinevalidationmocks.FilesValidator{}
 
-		maxPipelinesValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 		variableValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 		fileValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 
@@ -42,22 +40,19 @@ func TestHandle(t *testing.T) {
 			AdmissionRequest: admissionRequest,
 		}
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects().Build()
-		logPipelineValidatingWebhookHandler := NewValidatingWebhookHandler(fakeClient, variableValidatorMock, maxPipelinesValidatorMock, fileValidatorMock, admission.NewDecoder(clientgoscheme.Scheme))
+		logPipelineValidatingWebhookHandler := NewValidatingWebhookHandler(fakeClient, variableValidatorMock, fileValidatorMock, admission.NewDecoder(clientgoscheme.Scheme))
 
 		response := logPipelineValidatingWebhookHandler.Handle(context.Background(), request)
 		require.True(t, response.Allowed)
 
 		variableValidatorMock.AssertExpectations(t)
-		maxPipelinesValidatorMock.AssertExpectations(t)
 		fileValidatorMock.AssertExpectations(t)
 	})
 
 	t.Run("should execute validations for API semantic", func(t *testing.T) {
-		maxPipelinesValidatorMock := &logpipelinevalidationmocks.MaxPipelinesValidator{}
 		variableValidatorMock := &logpipelinevalidationmocks.VariablesValidator{}
 		fileValidatorMock := &logpipelinevalidationmocks.FilesValidator{}
 
-		maxPipelinesValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 		variableValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 		fileValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 
@@ -70,7 +65,7 @@ func TestHandle(t *testing.T) {
 			AdmissionRequest: admissionRequest,
 		}
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects().Build()
-		logPipelineValidatingWebhookHandler := NewValidatingWebhookHandler(fakeClient, variableValidatorMock, maxPipelinesValidatorMock, fileValidatorMock, admission.NewDecoder(clientgoscheme.Scheme))
+		logPipelineValidatingWebhookHandler := NewValidatingWebhookHandler(fakeClient, variableValidatorMock, fileValidatorMock, admission.NewDecoder(clientgoscheme.Scheme))
 
 		response := logPipelineValidatingWebhookHandler.Handle(context.Background(), request)
 		require.False(t, response.Allowed)
@@ -80,11 +75,9 @@ func TestHandle(t *testing.T) {
 	})
 
 	t.Run("should return a warning when a custom plugin is used", func(t *testing.T) {
-		maxPipelinesValidatorMock := &logpipelinevalidationmocks.MaxPipelinesValidator{}
 		variableValidatorMock := &logpipelinevalidationmocks.VariablesValidator{}
 		fileValidatorMock := &logpipelinevalidationmocks.FilesValidator{}
 
-		maxPipelinesValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 		variableValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 		fileValidatorMock.On("Validate", mock.Anything, mock.Anything).Return(nil).Times(1)
 
@@ -97,7 +90,7 @@ func TestHandle(t *testing.T) {
 			AdmissionRequest: admissionRequest,
 		}
 		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects().Build()
-		logPipelineValidatingWebhookHandler := NewValidatingWebhookHandler(fakeClient, variableValidatorMock, maxPipelinesValidatorMock, fileValidatorMock, admission.NewDecoder(clientgoscheme.Scheme))
+		logPipelineValidatingWebhookHandler := NewValidatingWebhookHandler(fakeClient, variableValidatorMock, fileValidatorMock, admission.NewDecoder(clientgoscheme.Scheme))
 
 		response := logPipelineValidatingWebhookHandler.Handle(context.Background(), request)
 		require.True(t, response.Allowed)